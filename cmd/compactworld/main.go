@@ -0,0 +1,43 @@
+// compactworld packs a vanilla world folder into a compact world file, for
+// small maps served read-only.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/keyboard-slayer/minecraft-server/internal/world"
+)
+
+func main() {
+	dir := flag.String("world", "", "vanilla world folder to pack")
+	out := flag.String("out", "world.mcw", "compact world file to write")
+	flag.Parse()
+
+	if err := run(*dir, *out); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(dir string, out string) error {
+	anvil, err := world.OpenAnvil(dir, -64, 384)
+	if err != nil {
+		return err
+	}
+
+	defer anvil.Close()
+
+	positions, err := anvil.Chunks()
+	if err != nil {
+		return err
+	}
+
+	if err := world.WriteCompact(out, anvil, positions); err != nil {
+		return err
+	}
+
+	fmt.Printf("Packed %d chunks into %s\n", len(positions), out)
+	return nil
+}
@@ -0,0 +1,360 @@
+// gendata turns the reports of the vanilla data generator into the block
+// and registry tables of the world package. The reports are made with
+//
+//	java -DbundlerMainClass=net.minecraft.data.Main -jar server.jar --reports
+//
+// which writes them to generated/reports. The game reports neither how the
+// blocks break and light up nor how items stack, so these are read from a
+// clone of minecraft-data:
+//
+//	git clone https://github.com/PrismarineJS/minecraft-data generated/minecraft-data
+//	go generate ./internal/world
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+)
+
+type blockState struct {
+	ID         int               `json:"id"`
+	Default    bool              `json:"default"`
+	Properties map[string]string `json:"properties"`
+}
+
+type block struct {
+	name       string
+	properties []property
+	states     []blockState
+}
+
+type property struct {
+	name   string
+	values []string
+}
+
+type registryEntry struct {
+	ProtocolID int `json:"protocol_id"`
+}
+
+type registry struct {
+	Entries map[string]registryEntry `json:"entries"`
+}
+
+// keys returns the keys of a JSON object in the order they are written,
+// which is the order of the block properties.
+func keys(data []byte) ([]string, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	if _, err := decoder.Token(); err != nil {
+		return nil, err
+	}
+
+	result := make([]string, 0)
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, token.(string))
+
+		var skip json.RawMessage
+		if err := decoder.Decode(&skip); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func readBlocks(path string) ([]block, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var report map[string]struct {
+		Properties json.RawMessage `json:"properties"`
+		States     []blockState    `json:"states"`
+	}
+
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, err
+	}
+
+	blocks := make([]block, 0, len(report))
+	for name, entry := range report {
+		b := block{name: name, states: entry.States}
+
+		if entry.Properties != nil {
+			var values map[string][]string
+			if err := json.Unmarshal(entry.Properties, &values); err != nil {
+				return nil, err
+			}
+
+			order, err := keys(entry.Properties)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, k := range order {
+				b.properties = append(b.properties, property{k, values[k]})
+			}
+		}
+
+		if len(b.states) == 0 {
+			return nil, fmt.Errorf("Block %s has no state", name)
+		}
+
+		slices.SortFunc(b.states, func(a blockState, b blockState) int { return a.ID - b.ID })
+		blocks = append(blocks, b)
+	}
+
+	slices.SortFunc(blocks, func(a block, b block) int { return a.states[0].ID - b.states[0].ID })
+	return blocks, nil
+}
+
+// check makes sure the states of the blocks can be rebuilt by the world
+// package: IDs following each other, the last property changing the
+// fastest.
+func check(blocks []block) error {
+	next := 0
+
+	for _, b := range blocks {
+		for i, state := range b.states {
+			if state.ID != next {
+				return fmt.Errorf("Block %s: expected state %d, got %d", b.name, next, state.ID)
+			}
+
+			rest := i
+			for j := len(b.properties) - 1; j >= 0; j-- {
+				p := b.properties[j]
+				if state.Properties[p.name] != p.values[rest%len(p.values)] {
+					return fmt.Errorf("Block %s: state %d isn't in property order", b.name, state.ID)
+				}
+
+				rest /= len(p.values)
+			}
+
+			next += 1
+		}
+	}
+
+	return nil
+}
+
+func defaults(b block) ([]int, error) {
+	for _, state := range b.states {
+		if !state.Default {
+			continue
+		}
+
+		result := make([]int, len(b.properties))
+		for i, p := range b.properties {
+			result[i] = slices.Index(p.values, state.Properties[p.name])
+		}
+
+		return result, nil
+	}
+
+	return nil, fmt.Errorf("Block %s has no default state", b.name)
+}
+
+func writeBlocks(w io.Writer, blocks []block) error {
+	fmt.Fprintln(w, "var blockDefinitions = []blockDefinition{")
+
+	for _, b := range blocks {
+		if len(b.properties) == 0 {
+			fmt.Fprintf(w, "simple(%q),\n", b.name)
+			continue
+		}
+
+		def, err := defaults(b)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(w, "withProperties(%q, %#v,\n", b.name, def)
+		for _, p := range b.properties {
+			fmt.Fprintf(w, "property{%q, %#v},\n", p.name, p.values)
+		}
+		fmt.Fprintln(w, "),")
+	}
+
+	fmt.Fprintln(w, "}")
+	return nil
+}
+
+func readRegistries(path string) (map[string][]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var report map[string]registry
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, err
+	}
+
+	registries := make(map[string][]string)
+	for name, reg := range report {
+		entries := make([]string, len(reg.Entries))
+
+		for entry, value := range reg.Entries {
+			if value.ProtocolID < 0 || value.ProtocolID >= len(entries) || entries[value.ProtocolID] != "" {
+				return nil, fmt.Errorf("Registry %s: bad protocol ID %d for %s", name, value.ProtocolID, entry)
+			}
+
+			entries[value.ProtocolID] = entry
+		}
+
+		registries[name] = entries
+	}
+
+	return registries, nil
+}
+
+func writeRegistries(w io.Writer, registries map[string][]string) {
+	names := make([]string, 0, len(registries))
+	for name := range registries {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	fmt.Fprintln(w, "var registries = map[string][]string{")
+	for _, name := range names {
+		fmt.Fprintf(w, "%q: {\n", name)
+		for _, entry := range registries[name] {
+			fmt.Fprintf(w, "%q,\n", entry)
+		}
+		fmt.Fprintln(w, "},")
+	}
+	fmt.Fprintln(w, "}")
+}
+
+func writeSource(path string, pkg string, origin string, body func(w io.Writer) error) error {
+	var buffer bytes.Buffer
+
+	fmt.Fprintf(&buffer, "// Code generated by gendata from %s. DO NOT EDIT.\n", origin)
+	fmt.Fprintln(&buffer)
+	fmt.Fprintf(&buffer, "package %s\n\n", pkg)
+
+	if err := body(&buffer); err != nil {
+		return err
+	}
+
+	source, err := format.Source(buffer.Bytes())
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, source, 0o644)
+}
+
+func readReports(reports string) ([]block, map[string][]string, error) {
+	blocks, err := readBlocks(filepath.Join(reports, "blocks.json"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	registries, err := readRegistries(filepath.Join(reports, "registries.json"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return blocks, registries, nil
+}
+
+// writeTables writes the block and registry tables from the reports.
+func writeTables(reports string, out string, pkg string) error {
+	blocks, registries, err := readReports(reports)
+	if err != nil {
+		return err
+	}
+
+	if err := check(blocks); err != nil {
+		return err
+	}
+
+	err = writeSource(filepath.Join(out, "blocks_gen.go"), pkg, "the vanilla reports", func(w io.Writer) error {
+		return writeBlocks(w, blocks)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return writeSource(filepath.Join(out, "registries_gen.go"), pkg, "the vanilla reports", func(w io.Writer) error {
+		writeRegistries(w, registries)
+		return nil
+	})
+}
+
+// writeAttributes writes what minecraft-data tells of the blocks and items
+// beyond the reports.
+func writeAttributes(minecraftData string, out string, pkg string) error {
+	data, err := readMinecraftData(minecraftData)
+	if err != nil {
+		return err
+	}
+
+	err = writeSource(filepath.Join(out, "hardness_gen.go"), pkg, "minecraft-data", func(w io.Writer) error {
+		writeHardness(w, data.hardnesses, data.passable)
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	err = writeSource(filepath.Join(out, "light_gen.go"), pkg, "minecraft-data", func(w io.Writer) error {
+		writeLight(w, data.lights)
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return writeSource(filepath.Join(out, "items_gen.go"), pkg, "minecraft-data", func(w io.Writer) error {
+		writeStackSizes(w, data.stackSizes)
+		return nil
+	})
+}
+
+func run(reports string, minecraftData string, out string, pkg string) error {
+	// Running the server jar takes Java, the tables already written are
+	// kept without it
+	if _, err := os.Stat(reports); err == nil {
+		if err := writeTables(reports, out, pkg); err != nil {
+			return err
+		}
+	} else if os.IsNotExist(err) {
+		fmt.Fprintf(os.Stderr, "No reports in %s, keeping the block and registry tables\n", reports)
+	} else {
+		return err
+	}
+
+	return writeAttributes(minecraftData, out, pkg)
+}
+
+func main() {
+	reports := flag.String("reports", "generated/reports", "folder of the vanilla reports")
+	minecraftData := flag.String("minecraft-data", "generated/minecraft-data/data/pc/1.21.8", "version folder of minecraft-data")
+	out := flag.String("out", ".", "folder to write the tables to")
+	pkg := flag.String("package", "world", "package of the tables")
+	flag.Parse()
+
+	if err := run(*reports, *minecraftData, *out, *pkg); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,270 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// minecraft-data (github.com/PrismarineJS/minecraft-data) describes every
+// version in data/pc/<version>, with the properties of the blocks and
+// items the vanilla reports leave out.
+
+type dataBlock struct {
+	ID           int             `json:"id"`
+	Name         string          `json:"name"`
+	Hardness     float64         `json:"hardness"`
+	Material     string          `json:"material"`
+	HarvestTools map[string]bool `json:"harvestTools"`
+	BoundingBox  string          `json:"boundingBox"`
+	EmitLight    int             `json:"emitLight"`
+	FilterLight  int             `json:"filterLight"`
+}
+
+// hardness is how a block breaks, as the Hardness of the world package.
+type hardness struct {
+	name         string
+	value        float64
+	tool         string
+	tier         int
+	requiresTool bool
+}
+
+// light is the light a block emits and absorbs, in its default state.
+type light struct {
+	name     string
+	emission int
+	opacity  int
+}
+
+// Tools and tiers as named by the world package
+var (
+	tools = map[string]string{
+		"pickaxe": "Pickaxe",
+		"axe":     "Axe",
+		"shovel":  "Shovel",
+		"hoe":     "Hoe",
+	}
+
+	tiers = []string{"WoodTier", "StoneTier", "IronTier", "DiamondTier", "NetheriteTier"}
+
+	materialTiers = map[string]int{
+		"wooden":    0,
+		"golden":    0,
+		"stone":     1,
+		"iron":      2,
+		"diamond":   3,
+		"netherite": 4,
+	}
+)
+
+type dataEntry struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	StackSize int    `json:"stackSize"`
+}
+
+// stackSize is how many of an item fit in a slot.
+type stackSize struct {
+	name string
+	size int
+}
+
+func readJSON(path string, v any) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, v)
+}
+
+func namespaced(name string) string {
+	return "minecraft:" + name
+}
+
+// dataHardness returns how a block breaks. The tool mining it faster is
+// the one of its material, and the tier the lowest of the tools it needs.
+func dataHardness(b dataBlock, items []string) (hardness, error) {
+	h := hardness{name: namespaced(b.Name), value: b.Hardness, tier: len(tiers)}
+
+	for _, part := range strings.Split(b.Material, ";") {
+		if tool, ok := strings.CutPrefix(part, "mineable/"); ok {
+			h.tool = tools[tool]
+		}
+	}
+
+	for id := range b.HarvestTools {
+		i, err := strconv.Atoi(id)
+		if err != nil || i < 0 || i >= len(items) {
+			return hardness{}, fmt.Errorf("Block %s: unknown tool %s", b.Name, id)
+		}
+
+		material, tool, _ := strings.Cut(strings.TrimPrefix(items[i], "minecraft:"), "_")
+		if tier, ok := materialTiers[material]; ok && tools[tool] != "" {
+			h.tool = tools[tool]
+			h.tier = min(h.tier, tier)
+		}
+
+		h.requiresTool = true
+	}
+
+	if h.tier == len(tiers) {
+		h.tier = 0
+	}
+
+	return h, nil
+}
+
+func readDataBlocks(dir string, items []string, data *attributes) error {
+	var entries []dataBlock
+	if err := readJSON(filepath.Join(dir, "blocks.json"), &entries); err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		h, err := dataHardness(entry, items)
+		if err != nil {
+			return err
+		}
+
+		if entry.EmitLight < 0 || entry.EmitLight > 15 || entry.FilterLight < 0 || entry.FilterLight > 15 {
+			return fmt.Errorf("Block %s: bad light levels", entry.Name)
+		}
+
+		data.hardnesses = append(data.hardnesses, h)
+		data.lights = append(data.lights, light{namespaced(entry.Name), entry.EmitLight, entry.FilterLight})
+
+		switch entry.BoundingBox {
+		case "empty":
+			data.passable = append(data.passable, namespaced(entry.Name))
+		case "block":
+		default:
+			return fmt.Errorf("Block %s: unknown bounding box %s", entry.Name, entry.BoundingBox)
+		}
+	}
+
+	return nil
+}
+
+func readDataEntries(path string) ([]string, error) {
+	var entries []dataEntry
+	if err := readJSON(path, &entries); err != nil {
+		return nil, err
+	}
+
+	names := make([]string, len(entries))
+	for _, entry := range entries {
+		if entry.ID < 0 || entry.ID >= len(entries) || names[entry.ID] != "" {
+			return nil, fmt.Errorf("%s: bad ID %d for %s", path, entry.ID, entry.Name)
+		}
+
+		names[entry.ID] = namespaced(entry.Name)
+	}
+
+	return names, nil
+}
+
+func readStackSizes(path string) ([]stackSize, error) {
+	var entries []dataEntry
+	if err := readJSON(path, &entries); err != nil {
+		return nil, err
+	}
+
+	sizes := make([]stackSize, len(entries))
+	for i, entry := range entries {
+		if entry.StackSize <= 0 {
+			return nil, fmt.Errorf("Item %s: bad stack size %d", entry.Name, entry.StackSize)
+		}
+
+		sizes[i] = stackSize{namespaced(entry.Name), entry.StackSize}
+	}
+
+	return sizes, nil
+}
+
+// attributes are the properties of the blocks and items read from
+// minecraft-data.
+type attributes struct {
+	hardnesses []hardness
+	lights     []light
+	stackSizes []stackSize
+	// passable are the blocks without collision
+	passable []string
+}
+
+// readMinecraftData reads how the blocks break and light up, and how the
+// items stack, from a version folder of minecraft-data.
+func readMinecraftData(dir string) (attributes, error) {
+	items, err := readDataEntries(filepath.Join(dir, "items.json"))
+	if err != nil {
+		return attributes{}, err
+	}
+
+	var data attributes
+	if err := readDataBlocks(dir, items, &data); err != nil {
+		return attributes{}, err
+	}
+
+	data.stackSizes, err = readStackSizes(filepath.Join(dir, "items.json"))
+	if err != nil {
+		return attributes{}, err
+	}
+
+	return data, nil
+}
+
+func writeHardness(w io.Writer, hardnesses []hardness, passable []string) {
+	fmt.Fprintln(w, "var hardness = map[string]Hardness{")
+
+	for _, h := range hardnesses {
+		fields := []string{"Value: " + strconv.FormatFloat(h.value, 'g', -1, 32)}
+		if h.tool != "" {
+			fields = append(fields, "Tool: "+h.tool)
+		}
+
+		if h.tier != 0 {
+			fields = append(fields, "Tier: "+tiers[h.tier])
+		}
+
+		if h.requiresTool {
+			fields = append(fields, "RequiresTool: true")
+		}
+
+		fmt.Fprintf(w, "%q: {%s},\n", h.name, strings.Join(fields, ", "))
+	}
+
+	fmt.Fprintln(w, "}")
+	fmt.Fprintln(w)
+
+	fmt.Fprintln(w, "// passable blocks have no collision, players walking through them")
+	fmt.Fprintln(w, "var passable = map[string]bool{")
+	for _, name := range passable {
+		fmt.Fprintf(w, "%q: true,\n", name)
+	}
+	fmt.Fprintln(w, "}")
+}
+
+func writeStackSizes(w io.Writer, sizes []stackSize) {
+	fmt.Fprintln(w, "var stackSizes = map[string]int{")
+
+	for _, s := range sizes {
+		fmt.Fprintf(w, "%q: %d,\n", s.name, s.size)
+	}
+
+	fmt.Fprintln(w, "}")
+}
+
+func writeLight(w io.Writer, lights []light) {
+	fmt.Fprintln(w, "var defaultLight = map[string]lightProperties{")
+
+	for _, l := range lights {
+		fmt.Fprintf(w, "%q: {emission: %d, opacity: %d},\n", l.name, l.emission, l.opacity)
+	}
+
+	fmt.Fprintln(w, "}")
+}
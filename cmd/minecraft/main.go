@@ -1,8 +1,12 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"os"
+	"os/signal"
+	"strings"
+	"syscall"
 
 	"log/slog"
 
@@ -11,6 +15,17 @@ import (
 )
 
 func main() {
+	worldDir := flag.String("world", "", "vanilla world folder or compact world file to serve")
+	storage := flag.String("storage", "", "storage of the world: anvil, memory or compact")
+	levelType := flag.String("level-type", "flat", "generator of the missing chunks")
+	settings := flag.String("generator-settings", "", "preset of the generator")
+	seed := flag.String("seed", "", "seed of a new world")
+	dimensions := flag.Bool("dimensions", false, "also load the nether and the end")
+	operators := flag.String("operators", "", "comma separated names or UUIDs of the operators")
+	gameMode := flag.String("gamemode", "survival", "game mode of the new players")
+	offline := flag.Bool("offline", false, "trust the players without asking the session server")
+	flag.Parse()
+
 	handler := log.NewWithOptions(os.Stderr, log.Options{
 		ReportCaller: true,
 		Level:        log.DebugLevel,
@@ -20,11 +35,62 @@ func main() {
 	logger := slog.New(handler)
 	slog.SetDefault(logger)
 
-	serv, err := minecraft.New(6969)
+	cfg := minecraft.DefaultServerConfig()
+	cfg.Worlds[0] = minecraft.WorldConfig{
+		Name:              "minecraft:overworld",
+		Dir:               *worldDir,
+		Storage:           *storage,
+		LevelType:         *levelType,
+		GeneratorSettings: *settings,
+		Seed:              *seed,
+	}
+
+	mode, err := minecraft.ParseGameMode(*gameMode)
 	if err != nil {
 		fmt.Println("Error starting server: ", err)
 		os.Exit(1)
 	}
 
+	cfg.DefaultGameMode = mode
+
+	if *offline {
+		cfg.SessionServer = ""
+	}
+
+	if *operators != "" {
+		cfg.Operators = strings.Split(*operators, ",")
+	}
+
+	if *dimensions {
+		for _, name := range []string{"minecraft:the_nether", "minecraft:the_end"} {
+			world := minecraft.WorldConfig{Name: name, LevelType: "void", Seed: *seed}
+
+			// A compact file only holds the overworld
+			if *storage == "" || *storage == minecraft.AnvilStorage {
+				world.Dir = *worldDir
+				world.Storage = *storage
+			}
+
+			cfg.Worlds = append(cfg.Worlds, world)
+		}
+	}
+
+	serv, err := minecraft.New(cfg)
+	if err != nil {
+		fmt.Println("Error starting server: ", err)
+		os.Exit(1)
+	}
+
+	// Save the world before exiting
+	go func() {
+		signals := make(chan os.Signal, 1)
+		signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+		<-signals
+
+		if err := serv.Close(); err != nil {
+			slog.Error("Couldn't stop the server", "error", err)
+		}
+	}()
+
 	serv.Serve()
 }
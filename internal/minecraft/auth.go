@@ -0,0 +1,92 @@
+package minecraft
+
+import (
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Time the session server has to answer before the login fails
+const sessionTimeout = 10 * time.Second
+
+// sessionProfile is a player as known by the session server, the
+// properties holding the textures of their skin and cape.
+type sessionProfile struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Properties []Property `json:"properties"`
+}
+
+// serverHash is the hash the client gives to the session server when
+// joining, the SHA-1 of the secret and the public key written as a signed
+// hexadecimal number.
+func serverHash(secret []byte, key []byte) string {
+	hash := sha1.New()
+	hash.Write(secret)
+	hash.Write(key)
+
+	sum := hash.Sum(nil)
+
+	n := new(big.Int).SetBytes(sum)
+	if sum[0]&0x80 != 0 {
+		// Two's complement, the sum being negative
+		n.Sub(n, new(big.Int).Lsh(big.NewInt(1), uint(len(sum)*8)))
+	}
+
+	return n.Text(16)
+}
+
+// hasJoined asks the session server whether name joined the server with
+// hash, returning their profile.
+func hasJoined(server string, name string, hash string) (sessionProfile, error) {
+	query := url.Values{"username": {name}, "serverId": {hash}}
+
+	client := http.Client{Timeout: sessionTimeout}
+	response, err := client.Get(server + "/session/minecraft/hasJoined?" + query.Encode())
+	if err != nil {
+		return sessionProfile{}, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return sessionProfile{}, fmt.Errorf("Session server answered %s", response.Status)
+	}
+
+	var profile sessionProfile
+	if err := json.NewDecoder(response.Body).Decode(&profile); err != nil {
+		return sessionProfile{}, err
+	}
+
+	return profile, nil
+}
+
+// authenticate checks with the session server that the player is who they
+// claim to be, taking their UUID and skin from their profile.
+func (self *client) authenticate(server string, secret []byte) error {
+	key, err := self.publicKey()
+	if err != nil {
+		return err
+	}
+
+	profile, err := hasJoined(server, self.info.name, serverHash(secret, key))
+	if err != nil {
+		return err
+	}
+
+	id, err := uuid.Parse(profile.ID)
+	if err != nil {
+		return err
+	}
+
+	self.info.uuid = id
+	self.info.name = profile.Name
+	self.info.properties = profile.Properties
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package minecraft
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestServerHash(t *testing.T) {
+	cases := map[string]string{
+		"Notch": "4ed1f46bbe04bc756bcb17c0c7ce3e4632f06a48",
+		"jeb_":  "-7c9d5b0044c130109a5d7b5fb5c317c02b4e28c1",
+		"simon": "88e16a1019277b15d58faf0541e11910eb756f6",
+	}
+
+	for name, want := range cases {
+		if got := serverHash([]byte(name), nil); got != want {
+			t.Fatalf("%s hashes to %s instead of %s", name, got, want)
+		}
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := &client{key: key}
+	c.info.name = "notch"
+
+	secret := []byte("0123456789abcdef")
+	public, err := c.publicKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hash := serverHash(secret, public)
+
+	session := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if r.URL.Path != "/session/minecraft/hasJoined" || query.Get("username") != "notch" || query.Get("serverId") != hash {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		fmt.Fprint(w, `{"id":"069a79f444e94726a5befca90e38aaf5","name":"Notch","properties":[{"name":"textures","value":"e30=","signature":"c2ln"}]}`)
+	}))
+	defer session.Close()
+
+	if err := c.authenticate(session.URL, secret); err != nil {
+		t.Fatal(err)
+	}
+
+	if c.info.uuid.String() != "069a79f4-44e9-4726-a5be-fca90e38aaf5" || c.info.name != "Notch" {
+		t.Fatalf("Authenticated as %s %s", c.info.name, c.info.uuid)
+	}
+
+	if len(c.info.properties) != 1 || c.info.properties[0] != (Property{"textures", "e30=", "c2ln"}) {
+		t.Fatalf("Skin properties are %+v", c.info.properties)
+	}
+
+	// A client that didn't join the session isn't let in
+	if err := c.authenticate(session.URL, []byte("fedcba9876543210")); err == nil {
+		t.Fatal("Authenticated with the wrong secret")
+	}
+}
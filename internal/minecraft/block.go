@@ -0,0 +1,459 @@
+package minecraft
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/keyboard-slayer/minecraft-server/internal/world"
+)
+
+// Status of player_action, the others dropping and using items
+const (
+	startDigging  = 0
+	cancelDigging = 1
+	finishDigging = 2
+)
+
+// Faces of the blocks clicked by the players
+const (
+	downFace = iota
+	upFace
+	northFace
+	southFace
+	westFace
+	eastFace
+)
+
+var faceOffsets = [][3]int{
+	downFace:  {0, -1, 0},
+	upFace:    {0, 1, 0},
+	northFace: {0, 0, -1},
+	southFace: {0, 0, 1},
+	westFace:  {-1, 0, 0},
+	eastFace:  {1, 0, 0},
+}
+
+// Reach of the players, past which their actions are refused, plus the
+// leeway given by the vanilla server
+const (
+	blockReach         = 4.5
+	creativeBlockReach = 5.0
+	reachLeeway        = 1.0
+)
+
+// Size of the players, smaller when sneaking, and the part of a block
+// they may have left to break when they finish digging it
+const (
+	eyeHeight       = 1.62
+	sneakEyeHeight  = 1.27
+	playerWidth     = 0.6
+	playerHeight    = 1.8
+	sneakingHeight  = 1.5
+	finishTolerance = 0.7
+)
+
+// level_event of a block broken, played to the players around
+const blockBreakEvent = 2001
+
+// digging is the block a player started to break in survival.
+type digging struct {
+	x, y, z int
+	state   uint16
+	started time.Time
+}
+
+// unpackPosition reverses position.
+func unpackPosition(v int64) (int, int, int) {
+	return int(v >> 38), int(v << 52 >> 52), int(v << 26 >> 38)
+}
+
+// toolOf returns the kind, the mining speed and the tier of a tool item.
+func toolOf(item string) (world.Tool, float32, int) {
+	name := strings.TrimPrefix(item, "minecraft:")
+
+	material, kind, ok := strings.Cut(name, "_")
+	if !ok {
+		return world.AnyTool, 1, world.WoodTier
+	}
+
+	var tool world.Tool
+	switch kind {
+	case "pickaxe":
+		tool = world.Pickaxe
+	case "axe":
+		tool = world.Axe
+	case "shovel":
+		tool = world.Shovel
+	case "hoe":
+		tool = world.Hoe
+	default:
+		return world.AnyTool, 1, world.WoodTier
+	}
+
+	switch material {
+	case "wooden":
+		return tool, 2, world.WoodTier
+	case "stone":
+		return tool, 4, world.StoneTier
+	case "iron":
+		return tool, 6, world.IronTier
+	case "diamond":
+		return tool, 8, world.DiamondTier
+	case "netherite":
+		return tool, 9, world.NetheriteTier
+	case "golden":
+		return tool, 12, world.WoodTier
+	}
+
+	return world.AnyTool, 1, world.WoodTier
+}
+
+// destroyProgress returns the part of a block broken every tick, following
+// the vanilla formula: the speed of the tool over the hardness, five times
+// slower off the ground and over three times slower without the right tool.
+func destroyProgress(state uint16, item string, onGround bool) float32 {
+	s, ok := world.State(state)
+	if !ok {
+		return 0
+	}
+
+	h := world.BlockHardness(s.Name)
+	if h.Unbreakable() {
+		return 0
+	}
+
+	if h.Value == 0 {
+		return 1
+	}
+
+	tool, speed, tier := toolOf(item)
+	matches := h.Tool != world.AnyTool && tool == h.Tool
+	if !matches {
+		speed = 1
+	}
+
+	if !onGround {
+		speed /= 5
+	}
+
+	if h.RequiresTool && !(matches && tier >= h.Tier) {
+		return speed / h.Value / 100
+	}
+
+	return speed / h.Value / 30
+}
+
+// canReach reports whether the block at x, y, z is within the reach of the
+// player, measured from their eyes to the closest point of the block.
+func (self *client) canReach(x int, y int, z int) bool {
+	self.moveLock.Lock()
+	loc, sneaking := self.loc, self.sneaking
+	self.moveLock.Unlock()
+
+	eye := eyeHeight
+	if sneaking {
+		eye = sneakEyeHeight
+	}
+
+	reach := blockReach
+	if self.gameMode() == Creative {
+		reach = creativeBlockReach
+	}
+
+	distance := func(v float64, low int) float64 {
+		return v - min(max(v, float64(low)), float64(low+1))
+	}
+
+	dx, dy, dz := distance(loc.X, x), distance(loc.Y+eye, y), distance(loc.Z, z)
+	return dx*dx+dy*dy+dz*dz < (reach+reachLeeway)*(reach+reachLeeway)
+}
+
+// inWorld reports whether y is within the height of the world.
+func (self *client) inWorld(y int) bool {
+	kind := self.dimension.Load().kind
+	return y >= kind.MinY && y < kind.MinY+kind.Height
+}
+
+// canBuild reports whether the game mode lets the player change blocks.
+func (self *client) canBuild() bool {
+	mode := self.gameMode()
+	return mode == Survival || mode == Creative
+}
+
+func readPlayerAction(c *client, data []byte) error {
+	m, err := readFromBuffer(data,
+		factoryPair{"status", intFactory},
+		factoryPair{"position", longFactory},
+		factoryPair{"face", byteFactory},
+		factoryPair{"sequence", intFactory},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	status := m["status"].(int)
+	if status != startDigging && status != cancelDigging && status != finishDigging {
+		return nil
+	}
+
+	x, y, z := unpackPosition(m["position"].(int64))
+	if err := c.dig(status, x, y, z); err != nil {
+		return err
+	}
+
+	return c.acknowledge(m["sequence"].(int))
+}
+
+// acknowledge tells the client the server is done with the blocks it
+// predicted up to sequence, so it shows the blocks sent by the server.
+func (self *client) acknowledge(sequence int) error {
+	// block_changed_ack
+	return self.send(0x04, sequence)
+}
+
+// refuse sends back the block the client changed on its own.
+func (self *client) refuse(x int, y int, z int) error {
+	state, err := self.dimension.Load().world.Block(x, y, z)
+	if err != nil {
+		return err
+	}
+
+	return self.sendBlockUpdate(x, y, z, state)
+}
+
+func (self *client) dig(status int, x int, y int, z int) error {
+	if !self.canBuild() || !self.inWorld(y) || !self.canReach(x, y, z) {
+		self.digging = nil
+		return self.refuse(x, y, z)
+	}
+
+	state, err := self.dimension.Load().world.Block(x, y, z)
+	if err != nil {
+		return err
+	}
+
+	onGround := self.location().OnGround
+
+	switch status {
+	case startDigging:
+		if world.IsAir(state) {
+			return nil
+		}
+
+		if self.gameMode() == Creative {
+			// Swords can't break blocks in creative
+			if strings.HasSuffix(self.heldItem(), "_sword") {
+				return self.refuse(x, y, z)
+			}
+
+			return self.breakBlock(x, y, z, state)
+		}
+
+		if destroyProgress(state, self.heldItem(), onGround) >= 1 {
+			return self.breakBlock(x, y, z, state)
+		}
+
+		self.digging = &digging{x: x, y: y, z: z, state: state, started: time.Now()}
+
+	case cancelDigging:
+		self.digging = nil
+
+	case finishDigging:
+		started := self.digging
+		self.digging = nil
+
+		if started == nil || started.x != x || started.y != y || started.z != z || started.state != state {
+			return self.refuse(x, y, z)
+		}
+
+		ticks := float32(time.Since(started.started) / tickInterval)
+		if destroyProgress(state, self.heldItem(), onGround)*(ticks+1) < finishTolerance {
+			self.logger.Warn("Broke a block too quickly", "x", x, "y", y, "z", z)
+			return self.refuse(x, y, z)
+		}
+
+		return self.breakBlock(x, y, z, state)
+	}
+
+	return nil
+}
+
+// breakBlock replaces a block broken by the player with air, the other
+// players around seeing it break.
+func (self *client) breakBlock(x int, y int, z int, state uint16) error {
+	d := self.dimension.Load()
+	if err := self.server.SetBlock(d.name, x, y, z, world.Air); err != nil {
+		return err
+	}
+
+	for _, c := range self.server.viewers(d, int32(x>>4), int32(z>>4)) {
+		if c == self {
+			continue
+		}
+
+		// level_event
+		if err := c.send(0x28, int32(blockBreakEvent), position(x, y, z), int32(state), false); err != nil {
+			c.logger.Error("Couldn't send block break", "error", err)
+		}
+	}
+
+	return nil
+}
+
+func readUseItemOn(c *client, data []byte) error {
+	m, err := readFromBuffer(data,
+		factoryPair{"hand", intFactory},
+		factoryPair{"position", longFactory},
+		factoryPair{"face", intFactory},
+		factoryPair{"cursorX", floatFactory},
+		factoryPair{"cursorY", floatFactory},
+		factoryPair{"cursorZ", floatFactory},
+		factoryPair{"inside", byteFactory},
+		factoryPair{"worldBorder", byteFactory},
+		factoryPair{"sequence", intFactory},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	face := m["face"].(int)
+	if face < 0 || face >= len(faceOffsets) {
+		return fmt.Errorf("Invalid face %d", face)
+	}
+
+	x, y, z := unpackPosition(m["position"].(int64))
+	if err := c.place(m["hand"].(int), x, y, z, face, m["cursorY"].(float32)); err != nil {
+		return err
+	}
+
+	return c.acknowledge(m["sequence"].(int))
+}
+
+// place puts the block of the item in hand against the face of the block
+// at x, y, z, or in its place when it is replaceable.
+func (self *client) place(hand int, x int, y int, z int, face int, cursorY float32) error {
+	item := self.handItem(hand)
+	if _, ok := world.DefaultState(item); !ok {
+		return nil
+	}
+
+	offset := faceOffsets[face]
+	tx, ty, tz := x+offset[0], y+offset[1], z+offset[2]
+
+	refuse := func() error {
+		if err := self.refuse(x, y, z); err != nil {
+			return err
+		}
+
+		return self.refuse(tx, ty, tz)
+	}
+
+	if !self.canBuild() || !self.inWorld(y) || !self.canReach(x, y, z) {
+		return refuse()
+	}
+
+	d := self.dimension.Load()
+	clicked, err := d.world.Block(x, y, z)
+	if err != nil {
+		return err
+	}
+
+	if world.Replaceable(clicked) {
+		tx, ty, tz = x, y, z
+	}
+
+	if !self.inWorld(ty) {
+		kind := d.kind
+		if err := self.sendSystem(fmt.Sprintf("Height limit for building is %d", kind.MinY+kind.Height-1), true); err != nil {
+			return err
+		}
+
+		return refuse()
+	}
+
+	target, err := d.world.Block(tx, ty, tz)
+	if err != nil {
+		return err
+	}
+
+	if !world.Replaceable(target) {
+		return refuse()
+	}
+
+	state := self.placementState(item, face, cursorY)
+	if world.Solid(state) && self.server.occupied(d, tx, ty, tz) {
+		return refuse()
+	}
+
+	if err := self.server.SetBlock(d.name, tx, ty, tz, state); err != nil {
+		return err
+	}
+
+	self.useItem(hand)
+	return nil
+}
+
+// placementState orients the block placed by the player, for the blocks
+// with an axis, a facing or a half.
+func (self *client) placementState(name string, face int, cursorY float32) uint16 {
+	props := make(map[string]string)
+
+	// Properties the block doesn't have, or not with that value, are left
+	// to their default
+	set := func(key string, value string) {
+		trial := maps.Clone(props)
+		trial[key] = value
+
+		if _, ok := world.StateID(name, trial); ok {
+			props = trial
+		}
+	}
+
+	set("axis", []string{"y", "y", "z", "z", "x", "x"}[face])
+
+	// Blocks face the player, the opposite of where they look
+	yaw := float64(self.location().Yaw)
+	look := int(math.Floor(yaw/90+0.5)) & 3
+	set("facing", []string{"north", "east", "south", "west"}[look])
+
+	if face == downFace || (face != upFace && cursorY > 0.5) {
+		set("half", "top")
+		set("type", "top")
+	}
+
+	state, _ := world.StateID(name, props)
+	return state
+}
+
+// occupied reports whether a player of the world d stands in the block at
+// x, y, z.
+func (self *Server) occupied(d *dimension, x int, y int, z int) bool {
+	for _, c := range self.listed() {
+		if c.dimension.Load() != d {
+			continue
+		}
+
+		c.moveLock.Lock()
+		loc, sneaking := c.loc, c.sneaking
+		c.moveLock.Unlock()
+
+		height := playerHeight
+		if sneaking {
+			height = sneakingHeight
+		}
+
+		half := playerWidth / 2
+		if loc.X+half > float64(x) && loc.X-half < float64(x+1) &&
+			loc.Y+height > float64(y) && loc.Y < float64(y+1) &&
+			loc.Z+half > float64(z) && loc.Z-half < float64(z+1) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,44 @@
+package minecraft
+
+import (
+	"math"
+	"testing"
+
+	"github.com/keyboard-slayer/minecraft-server/internal/world"
+)
+
+func TestDestroyProgress(t *testing.T) {
+	cases := []struct {
+		block    string
+		item     string
+		onGround bool
+		progress float32
+	}{
+		{"stone", "", true, 1.0 / 1.5 / 100},
+		{"stone", "minecraft:wooden_pickaxe", true, 2 / 1.5 / 30},
+		{"stone", "minecraft:wooden_pickaxe", false, 2.0 / 5 / 1.5 / 30},
+		// A shovel is no better than a hand on stone
+		{"stone", "minecraft:diamond_shovel", true, 1.0 / 1.5 / 100},
+		{"dirt", "", true, 1.0 / 0.5 / 30},
+		{"dirt", "minecraft:iron_shovel", true, 6.0 / 0.5 / 30},
+		// Below the tier needed, the tool only speeds up the breaking
+		{"obsidian", "minecraft:iron_pickaxe", true, 6.0 / 50 / 100},
+		{"obsidian", "minecraft:diamond_pickaxe", true, 8.0 / 50 / 30},
+		{"torch", "", false, 1},
+		{"reinforced_deepslate", "", true, 1.0 / 55 / 30},
+		{"bedrock", "minecraft:netherite_pickaxe", true, 0},
+		{"water", "", true, 0},
+	}
+
+	for _, c := range cases {
+		state, ok := world.DefaultState("minecraft:" + c.block)
+		if !ok {
+			t.Fatalf("Unknown block %s", c.block)
+		}
+
+		got := destroyProgress(state, c.item, c.onGround)
+		if math.Abs(float64(got-c.progress)) > 1e-6 {
+			t.Fatalf("%s with %q: progress %f instead of %f", c.block, c.item, got, c.progress)
+		}
+	}
+}
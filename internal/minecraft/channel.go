@@ -0,0 +1,148 @@
+package minecraft
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// ChannelHandler receives the data of a serverbound custom_payload sent on
+// the channel it was registered for.
+type ChannelHandler func(s *Session, data []byte) error
+
+type channelRegistry struct {
+	lock     sync.RWMutex
+	handlers map[string]ChannelHandler
+}
+
+func newChannelRegistry() *channelRegistry {
+	return &channelRegistry{
+		handlers: make(map[string]ChannelHandler),
+	}
+}
+
+func (self *channelRegistry) register(name string, handler ChannelHandler) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	self.handlers[name] = handler
+}
+
+func (self *channelRegistry) names() []string {
+	self.lock.RLock()
+	defer self.lock.RUnlock()
+
+	names := make([]string, 0, len(self.handlers))
+	for name := range self.handlers {
+		if !strings.HasPrefix(name, "minecraft:") {
+			names = append(names, name)
+		}
+	}
+
+	slices.Sort(names)
+	return names
+}
+
+func (self *channelRegistry) dispatch(c *client, channel string, data []byte) error {
+	self.lock.RLock()
+	handler, ok := self.handlers[channel]
+	self.lock.RUnlock()
+
+	if !ok {
+		c.logger.Debug("Unhandled plugin channel", "channel", channel, "data", data)
+		return nil
+	}
+
+	return handler(c.session(), data)
+}
+
+// RegisterChannel installs the handler called for every custom_payload the
+// clients send on channel, in both the Config and Play states. Registering
+// a channel twice replaces the previous handler.
+func (self *Server) RegisterChannel(name string, handler ChannelHandler) {
+	self.channels.register(name, handler)
+}
+
+func (self *Server) registerDefaultChannels() {
+	self.channels.register("minecraft:brand", func(s *Session, data []byte) error {
+		m, err := readFromBuffer(data, factoryPair{"brand", bytesFactory})
+		if err != nil {
+			return err
+		}
+
+		s.c.info.brand = string(m["brand"].([]byte))
+		s.c.logger.Debug("", "brand", s.c.info.brand)
+
+		return nil
+	})
+
+	self.channels.register("minecraft:register", func(s *Session, data []byte) error {
+		for _, name := range splitChannels(data) {
+			if !slices.Contains(s.c.info.channels, name) {
+				s.c.info.channels = append(s.c.info.channels, name)
+			}
+		}
+
+		s.c.logger.Debug("", "channels", s.c.info.channels)
+		return nil
+	})
+
+	self.channels.register("minecraft:unregister", func(s *Session, data []byte) error {
+		for _, name := range splitChannels(data) {
+			s.c.info.channels = slices.DeleteFunc(s.c.info.channels, func(other string) bool {
+				return other == name
+			})
+		}
+
+		s.c.logger.Debug("", "channels", s.c.info.channels)
+		return nil
+	})
+}
+
+// minecraft:register and minecraft:unregister carry NUL separated channel
+// names spanning the whole payload.
+func splitChannels(data []byte) []string {
+	names := make([]string, 0)
+
+	for _, name := range strings.Split(string(data), "\x00") {
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+func (self *client) sendPayload(channel string, contents ...any) error {
+	data, err := marshal(contents...)
+	if err != nil {
+		return err
+	}
+
+	switch self.state.Load() {
+	case Config:
+		// custom_payload
+		return self.send(0x01, channel, raw(data))
+	case Play:
+		// custom_payload
+		return self.send(0x18, channel, raw(data))
+	default:
+		return fmt.Errorf("Can't send plugin messages in state %s", self.state.Load().string())
+	}
+}
+
+// sendBrand announces the server brand along with the plugin channels that
+// have a handler registered.
+func (self *client) sendBrand() error {
+	if err := self.sendPayload("minecraft:brand", self.server.config().Brand); err != nil {
+		return err
+	}
+
+	names := self.server.channels.names()
+	if len(names) == 0 {
+		return nil
+	}
+
+	return self.sendPayload("minecraft:register", raw(strings.Join(names, "\x00")))
+}
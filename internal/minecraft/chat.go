@@ -0,0 +1,282 @@
+package minecraft
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+	"unicode/utf8"
+)
+
+// Longest message or command the client can send
+const maxChatLength = 256
+
+// Vanilla chat types, in the order of the registry
+var chatTypes = []string{
+	"minecraft:chat",
+	"minecraft:emote_command",
+	"minecraft:msg_command_incoming",
+	"minecraft:msg_command_outgoing",
+	"minecraft:say_command",
+	"minecraft:team_msg_command_incoming",
+	"minecraft:team_msg_command_outgoing",
+}
+
+const chatType = 0
+
+// sendChatTypes sends the chat_type registry, the decorations of the
+// messages coming from the core pack of the client.
+func (self *client) sendChatTypes() error {
+	return self.sendRegistry("minecraft:chat_type", chatTypes, nil)
+}
+
+// ChatHandler runs on the player goroutine for every chat message before
+// it is broadcast. Returning false drops the message.
+type ChatHandler func(s *Session, message string) (bool, error)
+
+// OnChat registers a handler run for every chat message sent by a player.
+func (self *Server) OnChat(handler ChatHandler) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	self.chatHandlers = append(self.chatHandlers, handler)
+}
+
+// chatLog numbers the messages sent to and by a player, as the client
+// expects them in order.
+type chatLog struct {
+	lock     sync.Mutex
+	received int
+	sent     int
+}
+
+// reset numbers the messages from zero again, as the client does when it
+// comes back from reconfiguration.
+func (self *chatLog) reset() {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	self.received = 0
+	self.sent = 0
+}
+
+// validChat reports whether a message only has characters the vanilla
+// server allows in chat.
+func validChat(message string) bool {
+	if len(message) > maxChatLength*4 || utf8.RuneCountInString(message) > maxChatLength {
+		return false
+	}
+
+	for _, r := range message {
+		if r == '§' || r < ' ' || r == 0x7f {
+			return false
+		}
+	}
+
+	return true
+}
+
+// stripFormatting removes the § formatting codes, for the players who
+// turned chat colors off.
+func stripFormatting(message string) string {
+	var builder strings.Builder
+
+	skip := false
+	for _, r := range message {
+		if skip {
+			skip = false
+			continue
+		}
+
+		if r == '§' {
+			skip = true
+			continue
+		}
+
+		builder.WriteRune(r)
+	}
+
+	return builder.String()
+}
+
+func readChat(c *client, data []byte) error {
+	m, err := readFromBuffer(data,
+		factoryPair{"message", bytesFactory},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	// The timestamp, the salt, the signature and the acknowledgements are
+	// left alone as secure chat isn't enforced
+	message := string(m["message"].([]byte))
+
+	if !validChat(message) {
+		c.disconnect("Illegal characters in chat")
+		return errors.New("Illegal characters in chat")
+	}
+
+	if c.settings().chat == hidden {
+		return c.sendSystem("Chat disabled in client options.", false)
+	}
+
+	c.server.lock.RLock()
+	handlers := c.server.chatHandlers
+	c.server.lock.RUnlock()
+
+	for _, handler := range handlers {
+		ok, err := handler(c.session(), message)
+		if err != nil {
+			return err
+		}
+
+		if !ok {
+			return nil
+		}
+	}
+
+	c.logger.Info("Chat", "message", message)
+
+	return c.server.broadcastChat(c, message)
+}
+
+func readChatCommand(c *client, data []byte) error {
+	m, err := readFromBuffer(data,
+		factoryPair{"command", bytesFactory},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	command := string(m["command"].([]byte))
+
+	if !validChat(command) {
+		c.disconnect("Illegal characters in chat")
+		return errors.New("Illegal characters in chat")
+	}
+
+	if c.settings().chat == hidden {
+		return c.sendSystem("Chat disabled in client options.", false)
+	}
+
+	c.logger.Info("Command", "command", command)
+
+	return c.execute(command)
+}
+
+// chatData is given to the chat format template.
+type chatData struct {
+	Name    string
+	World   string
+	Message string
+}
+
+func parseChatFormat(format string) (*template.Template, error) {
+	return template.New("chat").Parse(format)
+}
+
+// broadcastChat sends a chat message of sender to every player accepting
+// chat, formatted by the server when a format is set and decorated by the
+// client with the chat type otherwise.
+func (self *Server) broadcastChat(sender *client, message string) error {
+	format := self.config().ChatFormat
+
+	formatted := ""
+	if format != "" {
+		tmpl, err := parseChatFormat(format)
+		if err != nil {
+			return err
+		}
+
+		data := chatData{Name: sender.info.name, Message: message}
+		if d := sender.dimension.Load(); d != nil {
+			data.World = d.name
+		}
+
+		var builder strings.Builder
+		if err := tmpl.Execute(&builder, data); err != nil {
+			return err
+		}
+
+		formatted = builder.String()
+	}
+
+	sender.chat.lock.Lock()
+	index := sender.chat.sent
+	sender.chat.sent += 1
+	sender.chat.lock.Unlock()
+
+	now := time.Now().UnixMilli()
+
+	self.inPlay(func(c *client) error {
+		if c.settings().chat != enabled {
+			return nil
+		}
+
+		if format != "" {
+			return c.sendSystem(formatted, false)
+		}
+
+		return c.sendPlayerChat(sender, index, message, now)
+	})
+
+	return nil
+}
+
+func (self *client) sendPlayerChat(sender *client, index int, message string, timestamp int64) error {
+	// The lock is held until the message is sent, for the messages to
+	// reach the client in the order they are numbered
+	self.chat.lock.Lock()
+	defer self.chat.lock.Unlock()
+
+	global := self.chat.received
+	self.chat.received += 1
+
+	// Unsigned, without previous messages, unfiltered and without target
+	// player_chat
+	return self.send(0x3a,
+		global, sender.info.uuid, index, false,
+		message, timestamp, int64(0), 0,
+		false, 0, chatType+1, text(sender.info.name), false,
+	)
+}
+
+// sendSystem sends a message from the server, shown above the hotbar when
+// overlay is set. Players hiding chat only get the overlay ones.
+func (self *client) sendSystem(message string, overlay bool) error {
+	mode := self.settings().chat
+	if mode == hidden && !overlay {
+		return nil
+	}
+
+	if !self.settings().chatColors {
+		message = stripFormatting(message)
+	}
+
+	// system_chat
+	return self.send(0x72, text(message), overlay)
+}
+
+// Broadcast sends a system message to every player who doesn't hide chat.
+func (self *Server) Broadcast(message string) {
+	self.inPlay(func(c *client) error {
+		return c.sendSystem(message, false)
+	})
+}
+
+// SetChatFormat replaces the template of the chat messages, see
+// ServerConfig.ChatFormat.
+func (self *Server) SetChatFormat(format string) error {
+	if _, err := parseChatFormat(format); err != nil {
+		return err
+	}
+
+	self.lock.Lock()
+	self.cfg.ChatFormat = format
+	self.lock.Unlock()
+
+	return nil
+}
@@ -0,0 +1,247 @@
+package minecraft
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/beito123/nbt"
+	"github.com/keyboard-slayer/minecraft-server/internal/world"
+)
+
+// Bits per entry of the direct palettes, the base 2 logarithm of the
+// registry sizes rounded up.
+const (
+	blockStateBits = 15
+	biomeBits      = 7
+)
+
+// Heightmap types, as known by the client
+const (
+	worldSurface    = 1
+	motionBlocking  = 4
+	noLeavesBlocker = 5
+)
+
+func appendLongs(buffer []byte, longs []int64) []byte {
+	for _, l := range longs {
+		buffer = binary.BigEndian.AppendUint64(buffer, uint64(l))
+	}
+
+	return buffer
+}
+
+// encodePalettedContainer picks the smallest palette for values: a single
+// value, an indirect palette between minBits and maxBits per entry, or
+// the registry IDs themselves with directBits per entry.
+func encodePalettedContainer(values []uint16, minBits int, maxBits int, directBits int) []byte {
+	palette := make([]uint16, 0)
+	indices := make(map[uint16]uint16)
+
+	for _, v := range values {
+		if _, ok := indices[v]; !ok {
+			indices[v] = uint16(len(palette))
+			palette = append(palette, v)
+		}
+	}
+
+	if len(palette) == 1 {
+		return append([]byte{0}, writeVarInt(int(palette[0]))...)
+	}
+
+	n := max(world.BitsFor(len(palette)), minBits)
+
+	if n > maxBits {
+		buffer := []byte{byte(directBits)}
+		return appendLongs(buffer, world.Pack(values, directBits))
+	}
+
+	buffer := []byte{byte(n)}
+	buffer = append(buffer, writeVarInt(len(palette))...)
+	for _, v := range palette {
+		buffer = append(buffer, writeVarInt(int(v))...)
+	}
+
+	packed := make([]uint16, len(values))
+	for i, v := range values {
+		packed[i] = indices[v]
+	}
+
+	return appendLongs(buffer, world.Pack(packed, n))
+}
+
+func encodeSection(s *world.Section) []byte {
+	count := s.BlockCount()
+	buffer := []byte{byte(count >> 8), byte(count)}
+
+	blocks := s.Blocks
+	for i, state := range blocks {
+		blocks[i] = world.SentState(state)
+	}
+
+	buffer = append(buffer, encodePalettedContainer(blocks[:], 4, 8, blockStateBits)...)
+	buffer = append(buffer, encodePalettedContainer(s.Biomes[:], 1, 3, biomeBits)...)
+
+	return buffer
+}
+
+func encodeHeightmaps(chunk *world.Chunk) []byte {
+	kinds := []struct {
+		id   int
+		kind world.HeightmapKind
+	}{
+		{worldSurface, world.WorldSurface},
+		{motionBlocking, world.MotionBlocking},
+		{noLeavesBlocker, world.MotionBlockingNoLeaves},
+	}
+
+	buffer := writeVarInt(len(kinds))
+	for _, k := range kinds {
+		heights := chunk.Heightmap(k.kind)
+		values := make([]uint16, len(heights))
+		for i, h := range heights {
+			values[i] = uint16(h)
+		}
+
+		longs := world.Pack(values, world.BitsFor(chunk.Height()+1))
+
+		buffer = append(buffer, writeVarInt(k.id)...)
+		buffer = append(buffer, writeVarInt(len(longs))...)
+		buffer = appendLongs(buffer, longs)
+	}
+
+	return buffer
+}
+
+// networkNBT encodes tag the way NBT is sent since 1.20.2, without the name
+// of the root tag.
+func networkNBT(tag nbt.Tag) ([]byte, error) {
+	if tag == nil {
+		return []byte{nbt.IDTagEnd}, nil
+	}
+
+	stream := nbt.NewStream(nbt.BigEndian)
+	if err := stream.Stream.PutByte(tag.ID()); err != nil {
+		return []byte{}, err
+	}
+
+	if err := world.Writable(tag).Write(stream); err != nil {
+		return []byte{}, err
+	}
+
+	return stream.Bytes(), nil
+}
+
+func encodeBlockEntities(chunk *world.Chunk) ([]byte, error) {
+	buffer := writeVarInt(len(chunk.BlockEntities))
+
+	for _, e := range chunk.BlockEntities {
+		data, err := networkNBT(e.Data)
+		if err != nil {
+			return []byte{}, err
+		}
+
+		buffer = append(buffer, byte((e.X&15)<<4|(e.Z&15)), byte(int16(e.Y)>>8), byte(e.Y))
+		buffer = append(buffer, writeVarInt(e.Type)...)
+		buffer = append(buffer, data...)
+	}
+
+	return buffer, nil
+}
+
+func encodeBitSet(set []int64) []byte {
+	buffer := writeVarInt(len(set))
+	return appendLongs(buffer, set)
+}
+
+// encodeLight encodes the light of the sections, the client expecting one
+// more section below and above the chunk which are left unknown.
+func encodeLight(sections []*world.Section) []byte {
+	longs := (len(sections) + 2 + 63) / 64
+	skyMask := make([]int64, longs)
+	blockMask := make([]int64, longs)
+	emptySkyMask := make([]int64, longs)
+	emptyBlockMask := make([]int64, longs)
+
+	sky := make([][]byte, 0)
+	block := make([][]byte, 0)
+
+	for i, s := range sections {
+		bit := i + 1
+
+		if s.SkyLight != nil {
+			skyMask[bit/64] |= 1 << (bit % 64)
+			sky = append(sky, s.SkyLight)
+		} else {
+			emptySkyMask[bit/64] |= 1 << (bit % 64)
+		}
+
+		if s.BlockLight != nil {
+			blockMask[bit/64] |= 1 << (bit % 64)
+			block = append(block, s.BlockLight)
+		} else {
+			emptyBlockMask[bit/64] |= 1 << (bit % 64)
+		}
+	}
+
+	buffer := encodeBitSet(skyMask)
+	buffer = append(buffer, encodeBitSet(blockMask)...)
+	buffer = append(buffer, encodeBitSet(emptySkyMask)...)
+	buffer = append(buffer, encodeBitSet(emptyBlockMask)...)
+
+	for _, arrays := range [][][]byte{sky, block} {
+		buffer = append(buffer, writeVarInt(len(arrays))...)
+		for _, light := range arrays {
+			buffer = append(buffer, writeVarInt(len(light))...)
+			buffer = append(buffer, light...)
+		}
+	}
+
+	return buffer
+}
+
+func (self *client) sendChunk(chunk *world.Chunk) error {
+	if self.state.Load() != Play {
+		return fmt.Errorf("Can't send chunks in state %s", self.state.Load().string())
+	}
+
+	sections := make([]byte, 0)
+	for _, s := range chunk.Sections {
+		sections = append(sections, encodeSection(s)...)
+	}
+
+	entities, err := encodeBlockEntities(chunk)
+	if err != nil {
+		return err
+	}
+
+	// level_chunk_with_light
+	return self.send(0x27,
+		chunk.X, chunk.Z,
+		raw(encodeHeightmaps(chunk)), sections, raw(entities),
+		raw(encodeLight(chunk.Sections)),
+	)
+}
+
+func (self *client) forgetChunk(x int32, z int32) error {
+	if self.state.Load() != Play {
+		return fmt.Errorf("Can't forget chunks in state %s", self.state.Load().string())
+	}
+
+	// forget_level_chunk
+	return self.send(0x21, z, x)
+}
+
+// position packs block coordinates the way the protocol sends them.
+func position(x int, y int, z int) int64 {
+	return int64(x&0x3FFFFFF)<<38 | int64(z&0x3FFFFFF)<<12 | int64(y&0xFFF)
+}
+
+func (self *client) sendBlockUpdate(x int, y int, z int, state uint16) error {
+	// block_update
+	return self.send(0x08, position(x, y, z), int(world.SentState(state)))
+}
+
+func (self *client) sendLightUpdate(chunk *world.Chunk) error {
+	// light_update
+	return self.send(0x2a, int(chunk.X), int(chunk.Z), raw(encodeLight(chunk.Sections)))
+}
@@ -6,6 +6,9 @@ import (
 	"math"
 	"net"
 	"os"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"log/slog"
 
@@ -59,14 +62,20 @@ type userConfig struct {
 }
 
 type userInfo struct {
-	name string
-	uuid uuid.UUID
-	cfg  userConfig
+	name        string
+	uuid        uuid.UUID
+	cfg         userConfig
+	brand       string
+	channels    []string
+	transferred bool
+	// properties of the profile, such as the skin, sent back on login
+	properties []Property
 }
 
 type client struct {
 	id       int
 	teleport int
+	server   *Server
 	logger   *slog.Logger
 	socket   net.Conn
 	reader   *bufio.Reader
@@ -75,10 +84,41 @@ type client struct {
 	rng      []byte
 	enc      cipher.Stream
 	dec      cipher.Stream
-	state    State
+	state    *stateValue
+	cookies  *cookieJar
+	lock     *sync.Mutex
+	cfgLock  *sync.RWMutex
+	// view streams the chunks around the player in Play. Moving the
+	// player to another world replaces it from any goroutine, so it is
+	// read with currentView.
+	viewLock *sync.Mutex
+	view     *chunkView
+	// dimension is the world the player is in, nil until they join. It is
+	// changed from any goroutine moving the player to another world.
+	dimension *atomic.Pointer[dimension]
+	// loc is where the player is, and awaiting the teleport it didn't
+	// confirm yet, zero when there is none
+	moveLock      *sync.Mutex
+	loc           Location
+	awaiting      int
+	awaitingSince time.Time
+	sneaking      bool
+	sprinting     bool
+	mode          GameMode
+	// previousMode is -1 until the game mode changes
+	previousMode int
+	abilities    Abilities
+	stats        stats
+	// digging is only used by the player goroutine
+	digging   *digging
+	inventory *inventory
+	tracker   *tracker
+	alive     *keepAlive
+	tab       *tabEntry
+	chat      *chatLog
 }
 
-func newClient(socket net.Conn, id int) (client, error) {
+func newClient(server *Server, socket net.Conn, id int) (client, error) {
 	handler := log.NewWithOptions(os.Stderr, log.Options{
 		ReportCaller: true,
 		Level:        log.DebugLevel,
@@ -95,16 +135,33 @@ func newClient(socket net.Conn, id int) (client, error) {
 	rng := make([]byte, 64)
 	rand.Read(rng)
 
+	mode := server.config().DefaultGameMode
+
 	return client{
-		id:       id,
-		teleport: 0,
-		logger:   logger,
-		reader:   bufio.NewReader(socket),
-		info:     userInfo{},
-		socket:   socket,
-		key:      key,
-		rng:      rng,
-		state:    Handshaking,
+		id:           id,
+		teleport:     0,
+		server:       server,
+		logger:       logger,
+		reader:       bufio.NewReader(socket),
+		info:         userInfo{},
+		socket:       socket,
+		key:          key,
+		rng:          rng,
+		state:        &stateValue{},
+		cookies:      newCookieJar(),
+		lock:         &sync.Mutex{},
+		cfgLock:      &sync.RWMutex{},
+		moveLock:     &sync.Mutex{},
+		viewLock:     &sync.Mutex{},
+		dimension:    &atomic.Pointer[dimension]{},
+		alive:        &keepAlive{},
+		tab:          &tabEntry{},
+		tracker:      newTracker(),
+		chat:         &chatLog{},
+		mode:         mode,
+		abilities:    DefaultAbilities(mode),
+		inventory:    &inventory{},
+		previousMode: -1,
 	}, nil
 }
 
@@ -122,7 +179,15 @@ func (self *client) teleportId() int {
 	return self.teleport
 }
 
-func (self client) send(protocol int, contents ...any) error {
+// raw is appended to a packet as is, without a length prefix, for fields
+// that span the remaining bytes of the packet.
+type raw []byte
+
+// text is sent as a plain text component, which the network NBT encodes as
+// a nameless string tag.
+type text string
+
+func marshal(contents ...any) ([]byte, error) {
 	payload := make([]byte, 0)
 
 	for i := range contents {
@@ -146,6 +211,14 @@ func (self client) send(protocol int, contents ...any) error {
 			length := writeVarInt(len(v))
 			payload = append(payload, length...)
 			payload = append(payload, v...)
+		case raw:
+			payload = append(payload, v...)
+		case text:
+			bytes := []byte(v)
+			payload = append(payload, nbt.IDTagString, byte(len(bytes)>>8), byte(len(bytes)))
+			payload = append(payload, bytes...)
+		case byte:
+			payload = append(payload, v)
 		case float32:
 			bytes := make([]byte, 4)
 			binary.BigEndian.PutUint32(bytes, math.Float32bits(v))
@@ -173,24 +246,38 @@ func (self client) send(protocol int, contents ...any) error {
 			} else {
 				payload = append(payload, byte(0))
 			}
+		case int16:
+			payload = binary.BigEndian.AppendUint16(payload, uint16(v))
 		case nbt.Tag:
-			stream := nbt.NewStream(nbt.BigEndian)
-			if err := stream.WriteTag(v); err != nil {
-				return err
+			bytes, err := networkNBT(v)
+			if err != nil {
+				return []byte{}, err
 			}
 
-			bytes := stream.Bytes()
 			payload = append(payload, bytes...)
 
 		default:
-			return errors.New("Got an unknown type")
+			return []byte{}, errors.New("Got an unknown type")
 		}
 
 	}
 
+	return payload, nil
+}
+
+func (self *client) send(protocol int, contents ...any) error {
+	payload, err := marshal(contents...)
+	if err != nil {
+		return err
+	}
+
 	payloadWithProt := append(writeVarInt(protocol), payload...)
 	final := append(writeVarInt(len(payloadWithProt)), payloadWithProt...)
 
+	// Sessions may send from other goroutines, and the cipher is stateful
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
 	if self.enc == nil {
 		self.socket.Write(final)
 	} else {
@@ -202,8 +289,10 @@ func (self client) send(protocol int, contents ...any) error {
 	return nil
 }
 
-func (self client) close() {
+func (self *client) close() {
 	self.socket.Close()
+	self.stopKeepAlive()
+	self.stopView()
 }
 
 func (self client) read(length int) ([]byte, error) {
@@ -268,9 +357,11 @@ func (self *client) register(name string, id uuid.UUID) {
 	self.logger.Info("Trying to connect...")
 
 	self.info = userInfo{
-		name: name,
-		uuid: id,
-		cfg:  userConfig{},
+		name:        name,
+		uuid:        id,
+		cfg:         userConfig{},
+		transferred: self.info.transferred,
+		properties:  []Property{},
 	}
 }
 
@@ -0,0 +1,1035 @@
+package minecraft
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/keyboard-slayer/minecraft-server/internal/world"
+)
+
+// Node types and flags of the commands packet
+const (
+	rootNode     byte = 0x00
+	literalNode  byte = 0x01
+	argumentNode byte = 0x02
+
+	executableNode  byte = 0x04
+	suggestionsNode byte = 0x10
+)
+
+// Longest part of the input shown before the cursor of a parse error, as
+// the vanilla server does
+const errorContext = 10
+
+// CommandHandler runs a command on the goroutine of the player who typed
+// it. The returned error is shown to the player in red.
+type CommandHandler func(ctx *CommandContext) error
+
+// SuggestionProvider lists the completions of an argument starting with
+// the partial text typed by the player.
+type SuggestionProvider func(s *Session, partial string) []string
+
+// CommandNode is a node of the Brigadier command tree: a literal word or a
+// typed argument, followed by more nodes.
+type CommandNode struct {
+	name     string
+	parser   ArgumentParser
+	children []*CommandNode
+	handler  CommandHandler
+	requires func(s *Session) bool
+	suggests SuggestionProvider
+}
+
+// Literal creates a node matching name as is.
+func Literal(name string) *CommandNode {
+	return &CommandNode{name: name}
+}
+
+// Argument creates a node reading a value with parser, fetched from the
+// context under name.
+func Argument(name string, parser ArgumentParser) *CommandNode {
+	return &CommandNode{name: name, parser: parser}
+}
+
+// Then adds the nodes which may follow this one.
+func (self *CommandNode) Then(children ...*CommandNode) *CommandNode {
+	self.children = append(self.children, children...)
+	return self
+}
+
+// Executes makes the command valid when it stops at this node.
+func (self *CommandNode) Executes(handler CommandHandler) *CommandNode {
+	self.handler = handler
+	return self
+}
+
+// Requires hides the node, and what follows it, from the players for whom
+// check fails.
+func (self *CommandNode) Requires(check func(s *Session) bool) *CommandNode {
+	self.requires = check
+	return self
+}
+
+// Suggests makes the client ask the server for the completions of an
+// argument, instead of those of its parser.
+func (self *CommandNode) Suggests(provider SuggestionProvider) *CommandNode {
+	self.suggests = provider
+	return self
+}
+
+func (self *CommandNode) allowed(s *Session) bool {
+	return self.requires == nil || self.requires(s)
+}
+
+// sortedChildren puts the literals first, as they win over arguments
+// reading the same word.
+func (self *CommandNode) sortedChildren() []*CommandNode {
+	children := slices.Clone(self.children)
+	slices.SortStableFunc(children, func(a *CommandNode, b *CommandNode) int {
+		switch {
+		case a.parser == nil && b.parser != nil:
+			return -1
+		case a.parser != nil && b.parser == nil:
+			return 1
+		}
+
+		return 0
+	})
+
+	return children
+}
+
+// commandRegistry is the root of the command tree of the server.
+type commandRegistry struct {
+	lock sync.RWMutex
+	root *CommandNode
+}
+
+func newCommandRegistry() *commandRegistry {
+	return &commandRegistry{root: &CommandNode{}}
+}
+
+// RegisterCommand adds a command, a literal node, to the tree, replacing
+// the one of the same name. The players in Play get the new tree.
+func (self *Server) RegisterCommand(command *CommandNode) error {
+	if command == nil || command.parser != nil {
+		return errors.New("Commands must start with a literal")
+	}
+
+	self.commands.lock.Lock()
+	self.commands.root.children = slices.DeleteFunc(self.commands.root.children, func(n *CommandNode) bool {
+		return n.name == command.name
+	})
+	self.commands.root.children = append(self.commands.root.children, command)
+	self.commands.lock.Unlock()
+
+	self.inPlay(func(c *client) error {
+		return c.sendCommands()
+	})
+
+	return nil
+}
+
+// CommandContext holds the arguments of a command being run.
+type CommandContext struct {
+	Session *Session
+	// Input is the command as typed, without its slash
+	Input  string
+	server *Server
+	args   map[string]any
+}
+
+// Int returns an integer argument.
+func (self *CommandContext) Int(name string) int {
+	v, _ := self.args[name].(int)
+	return v
+}
+
+// Float returns a float or double argument.
+func (self *CommandContext) Float(name string) float64 {
+	v, _ := self.args[name].(float64)
+	return v
+}
+
+func (self *CommandContext) Bool(name string) bool {
+	v, _ := self.args[name].(bool)
+	return v
+}
+
+// String returns a string argument, or the name of an item argument.
+func (self *CommandContext) String(name string) string {
+	v, _ := self.args[name].(string)
+	return v
+}
+
+// Players returns the players selected by an entity argument.
+func (self *CommandContext) Players(name string) []*Session {
+	v, _ := self.args[name].([]*Session)
+	return v
+}
+
+// Player returns the player selected by a single entity argument.
+func (self *CommandContext) Player(name string) *Session {
+	players := self.Players(name)
+	if len(players) == 0 {
+		return nil
+	}
+
+	return players[0]
+}
+
+func (self *CommandContext) GameMode(name string) GameMode {
+	v, _ := self.args[name].(GameMode)
+	return v
+}
+
+// Location returns a position argument, the relative coordinates being
+// resolved against the player running the command.
+func (self *CommandContext) Location(name string) Location {
+	v, _ := self.args[name].(coordinates)
+	return v.resolve(self.Session.Location())
+}
+
+// commandError is a parse error, pointing at where the input went wrong.
+type commandError struct {
+	message string
+	input   string
+	cursor  int
+}
+
+func (self *commandError) Error() string {
+	if self.cursor < 0 {
+		return self.message
+	}
+
+	start := max(0, self.cursor-errorContext)
+	before := self.input[start:self.cursor]
+	if start > 0 {
+		before = "..." + before
+	}
+
+	return fmt.Sprintf("%s at position %d: %s<--[HERE]", self.message, self.cursor, before)
+}
+
+// commandReader walks the input of a command.
+type commandReader struct {
+	input  string
+	cursor int
+}
+
+func (self *commandReader) canRead() bool {
+	return self.cursor < len(self.input)
+}
+
+func (self *commandReader) peek() byte {
+	return self.input[self.cursor]
+}
+
+func (self *commandReader) fail(format string, args ...any) error {
+	return &commandError{fmt.Sprintf(format, args...), self.input, self.cursor}
+}
+
+// readWord reads up to the next space.
+func (self *commandReader) readWord() string {
+	start := self.cursor
+	for self.canRead() && self.peek() != ' ' {
+		self.cursor += 1
+	}
+
+	return self.input[start:self.cursor]
+}
+
+// readQuoted reads a string between double quotes, with backslash escapes.
+func (self *commandReader) readQuoted() (string, error) {
+	self.cursor += 1
+
+	var builder strings.Builder
+	escaped := false
+	for self.canRead() {
+		c := self.peek()
+		self.cursor += 1
+
+		switch {
+		case escaped:
+			if c != '"' && c != '\\' {
+				self.cursor -= 1
+				return "", self.fail("Invalid escape sequence '%c' in quoted string", c)
+			}
+
+			builder.WriteByte(c)
+			escaped = false
+		case c == '\\':
+			escaped = true
+		case c == '"':
+			return builder.String(), nil
+		default:
+			builder.WriteByte(c)
+		}
+	}
+
+	return "", self.fail("Unclosed quoted string")
+}
+
+// ArgumentParser reads a typed argument. The client parses them again with
+// the parser of the same registry ID to highlight and complete the input.
+type ArgumentParser interface {
+	// kind is the entry of minecraft:command_argument_type
+	kind() string
+	properties() ([]byte, error)
+	parse(r *commandReader, ctx *CommandContext) (any, error)
+	suggest(ctx *CommandContext, partial string) []string
+}
+
+type numberParser struct {
+	name     string
+	min, max float64
+	integer  bool
+}
+
+// IntegerArgument reads an integer between min and max.
+func IntegerArgument(min int, max int) ArgumentParser {
+	return numberParser{"brigadier:integer", float64(min), float64(max), true}
+}
+
+// DoubleArgument reads a number between min and max.
+func DoubleArgument(min float64, max float64) ArgumentParser {
+	return numberParser{"brigadier:double", min, max, false}
+}
+
+func (self numberParser) kind() string {
+	return self.name
+}
+
+func (self numberParser) properties() ([]byte, error) {
+	flags := byte(0)
+	contents := []any{}
+
+	lowest, highest := -math.MaxFloat64, math.MaxFloat64
+	if self.integer {
+		lowest, highest = math.MinInt32, math.MaxInt32
+	}
+
+	if self.min > lowest {
+		flags |= 0x01
+		contents = append(contents, self.value(self.min))
+	}
+
+	if self.max < highest {
+		flags |= 0x02
+		contents = append(contents, self.value(self.max))
+	}
+
+	return marshal(append([]any{flags}, contents...)...)
+}
+
+func (self numberParser) value(v float64) any {
+	if self.integer {
+		return int32(v)
+	}
+
+	return v
+}
+
+func (self numberParser) parse(r *commandReader, ctx *CommandContext) (any, error) {
+	start := r.cursor
+	word := r.readWord()
+
+	if self.integer {
+		v, err := strconv.ParseInt(word, 10, 32)
+		if err != nil {
+			r.cursor = start
+			return nil, r.fail("Invalid integer '%s'", word)
+		}
+
+		if float64(v) < self.min {
+			r.cursor = start
+			return nil, r.fail("Integer must not be less than %d, found %d", int(self.min), v)
+		}
+
+		if float64(v) > self.max {
+			r.cursor = start
+			return nil, r.fail("Integer must not be more than %d, found %d", int(self.max), v)
+		}
+
+		return int(v), nil
+	}
+
+	v, err := strconv.ParseFloat(word, 64)
+	if err != nil || !finite(v) {
+		r.cursor = start
+		return nil, r.fail("Invalid double '%s'", word)
+	}
+
+	if v < self.min || v > self.max {
+		r.cursor = start
+		return nil, r.fail("Double must be between %g and %g, found %g", self.min, self.max, v)
+	}
+
+	return v, nil
+}
+
+func (self numberParser) suggest(ctx *CommandContext, partial string) []string {
+	return nil
+}
+
+type boolParser struct{}
+
+// BoolArgument reads true or false.
+func BoolArgument() ArgumentParser {
+	return boolParser{}
+}
+
+func (self boolParser) kind() string {
+	return "brigadier:bool"
+}
+
+func (self boolParser) properties() ([]byte, error) {
+	return []byte{}, nil
+}
+
+func (self boolParser) parse(r *commandReader, ctx *CommandContext) (any, error) {
+	start := r.cursor
+	switch word := r.readWord(); word {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	default:
+		r.cursor = start
+		return nil, r.fail("Invalid bool, expected true or false but found '%s'", word)
+	}
+}
+
+func (self boolParser) suggest(ctx *CommandContext, partial string) []string {
+	return matching([]string{"true", "false"}, partial)
+}
+
+// Modes of the string parser
+const (
+	singleWord = iota
+	quotablePhrase
+	greedyPhrase
+)
+
+type stringParser struct {
+	mode int
+}
+
+// WordArgument reads a single word.
+func WordArgument() ArgumentParser {
+	return stringParser{singleWord}
+}
+
+// StringArgument reads a word, or a phrase between double quotes.
+func StringArgument() ArgumentParser {
+	return stringParser{quotablePhrase}
+}
+
+// GreedyArgument reads the rest of the input, so it ends the command.
+func GreedyArgument() ArgumentParser {
+	return stringParser{greedyPhrase}
+}
+
+func (self stringParser) kind() string {
+	return "brigadier:string"
+}
+
+func (self stringParser) properties() ([]byte, error) {
+	return writeVarInt(self.mode), nil
+}
+
+func (self stringParser) parse(r *commandReader, ctx *CommandContext) (any, error) {
+	switch {
+	case self.mode == greedyPhrase:
+		text := r.input[r.cursor:]
+		r.cursor = len(r.input)
+		return text, nil
+	case self.mode == quotablePhrase && r.canRead() && r.peek() == '"':
+		return r.readQuoted()
+	}
+
+	word := r.readWord()
+	if word == "" {
+		return nil, r.fail("Expected string")
+	}
+
+	return word, nil
+}
+
+func (self stringParser) suggest(ctx *CommandContext, partial string) []string {
+	return nil
+}
+
+type entityParser struct {
+	single bool
+}
+
+// PlayerArgument reads the name of a player in Play, or the @s, @p, @r and
+// @a selectors.
+func PlayerArgument() ArgumentParser {
+	return entityParser{single: true}
+}
+
+// PlayersArgument is PlayerArgument selecting any number of players.
+func PlayersArgument() ArgumentParser {
+	return entityParser{single: false}
+}
+
+func (self entityParser) kind() string {
+	return "minecraft:entity"
+}
+
+func (self entityParser) properties() ([]byte, error) {
+	// Only players
+	flags := byte(0x02)
+	if self.single {
+		flags |= 0x01
+	}
+
+	return []byte{flags}, nil
+}
+
+func (self entityParser) parse(r *commandReader, ctx *CommandContext) (any, error) {
+	start := r.cursor
+	name := r.readWord()
+
+	players := make([]*Session, 0)
+	for _, c := range ctx.server.listed() {
+		players = append(players, c.session())
+	}
+
+	var selected []*Session
+	switch name {
+	case "@s":
+		selected = []*Session{ctx.Session}
+	case "@p":
+		selected = nearest(ctx.Session, players)
+	case "@r":
+		if len(players) > 0 {
+			selected = []*Session{players[rand.IntN(len(players))]}
+		}
+	case "@a", "@e":
+		selected = players
+	default:
+		if strings.HasPrefix(name, "@") {
+			r.cursor = start
+			return nil, r.fail("Unknown selector type '%s'", name)
+		}
+
+		for _, p := range players {
+			if strings.EqualFold(p.Name(), name) {
+				selected = []*Session{p}
+			}
+		}
+	}
+
+	if len(selected) == 0 {
+		r.cursor = start
+		return nil, r.fail("No player was found")
+	}
+
+	if self.single && len(selected) > 1 {
+		r.cursor = start
+		return nil, r.fail("Only one player is allowed, but the provided selector allows more than one")
+	}
+
+	return selected, nil
+}
+
+func (self entityParser) suggest(ctx *CommandContext, partial string) []string {
+	names := []string{"@s", "@p", "@r", "@a"}
+	for _, c := range ctx.server.listed() {
+		names = append(names, c.info.name)
+	}
+
+	return matching(names, partial)
+}
+
+// nearest selects the closest player to s in the same world.
+func nearest(s *Session, players []*Session) []*Session {
+	from := s.Location()
+
+	var best *Session
+	distance := math.Inf(1)
+	for _, p := range players {
+		if p.World() != s.World() {
+			continue
+		}
+
+		loc := p.Location()
+		d := math.Pow(loc.X-from.X, 2) + math.Pow(loc.Y-from.Y, 2) + math.Pow(loc.Z-from.Z, 2)
+		if d < distance {
+			best, distance = p, d
+		}
+	}
+
+	if best == nil {
+		return nil
+	}
+
+	return []*Session{best}
+}
+
+type gameModeParser struct{}
+
+// GameModeArgument reads the name of a game mode.
+func GameModeArgument() ArgumentParser {
+	return gameModeParser{}
+}
+
+func (self gameModeParser) kind() string {
+	return "minecraft:gamemode"
+}
+
+func (self gameModeParser) properties() ([]byte, error) {
+	return []byte{}, nil
+}
+
+func (self gameModeParser) parse(r *commandReader, ctx *CommandContext) (any, error) {
+	start := r.cursor
+	name := r.readWord()
+
+	mode, err := ParseGameMode(name)
+	if err != nil {
+		r.cursor = start
+		return nil, r.fail("Unknown game mode: %s", name)
+	}
+
+	return mode, nil
+}
+
+func (self gameModeParser) suggest(ctx *CommandContext, partial string) []string {
+	return matching(gameModeNames, partial)
+}
+
+// coordinates is a position read by a vec3 argument, each axis possibly
+// relative to the player.
+type coordinates struct {
+	values   [3]float64
+	relative [3]bool
+}
+
+func (self coordinates) resolve(from Location) Location {
+	base := [3]float64{from.X, from.Y, from.Z}
+	for i := range 3 {
+		if !self.relative[i] {
+			base[i] = self.values[i]
+		} else {
+			base[i] += self.values[i]
+		}
+	}
+
+	from.X, from.Y, from.Z = base[0], base[1], base[2]
+	return from
+}
+
+type vec3Parser struct{}
+
+// PositionArgument reads three coordinates, ~ making one relative to the
+// player. Whole numbers are centered on the block.
+func PositionArgument() ArgumentParser {
+	return vec3Parser{}
+}
+
+func (self vec3Parser) kind() string {
+	return "minecraft:vec3"
+}
+
+func (self vec3Parser) properties() ([]byte, error) {
+	return []byte{}, nil
+}
+
+func (self vec3Parser) parse(r *commandReader, ctx *CommandContext) (any, error) {
+	var coords coordinates
+
+	for i := range 3 {
+		if i > 0 {
+			if !r.canRead() || r.peek() != ' ' {
+				return nil, r.fail("Incomplete (expected 3 coordinates)")
+			}
+
+			r.cursor += 1
+		}
+
+		from := r.cursor
+		word := r.readWord()
+		if strings.HasPrefix(word, "^") {
+			r.cursor = from
+			return nil, r.fail("Local coordinates are not supported")
+		}
+
+		if rest, ok := strings.CutPrefix(word, "~"); ok {
+			coords.relative[i] = true
+			word = rest
+			if word == "" {
+				continue
+			}
+		}
+
+		v, err := strconv.ParseFloat(word, 64)
+		if err != nil || !finite(v) {
+			r.cursor = from
+			return nil, r.fail("Expected a coordinate")
+		}
+
+		// Blocks are centered horizontally, as vanilla does
+		if !coords.relative[i] && i != 1 && !strings.Contains(word, ".") {
+			v += 0.5
+		}
+
+		coords.values[i] = v
+	}
+
+	return coords, nil
+}
+
+func (self vec3Parser) suggest(ctx *CommandContext, partial string) []string {
+	return matching([]string{"~", "~ ~", "~ ~ ~"}, partial)
+}
+
+type itemParser struct{}
+
+// ItemArgument reads the name of an item, the namespace defaulting to
+// minecraft.
+func ItemArgument() ArgumentParser {
+	return itemParser{}
+}
+
+func (self itemParser) kind() string {
+	return "minecraft:item_stack"
+}
+
+func (self itemParser) properties() ([]byte, error) {
+	return []byte{}, nil
+}
+
+func (self itemParser) parse(r *commandReader, ctx *CommandContext) (any, error) {
+	start := r.cursor
+	name := r.readWord()
+	if !strings.Contains(name, ":") {
+		name = "minecraft:" + name
+	}
+
+	if _, ok := world.ItemID(name); !ok {
+		r.cursor = start
+		return nil, r.fail("Unknown item '%s'", name)
+	}
+
+	return name, nil
+}
+
+func (self itemParser) suggest(ctx *CommandContext, partial string) []string {
+	names := make([]string, 0)
+	for _, name := range world.Registry("minecraft:item") {
+		short := strings.TrimPrefix(name, "minecraft:")
+		if strings.HasPrefix(name, partial) || strings.HasPrefix(short, partial) {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+// matching keeps the candidates starting with partial.
+func matching(candidates []string, partial string) []string {
+	matches := make([]string, 0)
+	for _, candidate := range candidates {
+		if strings.HasPrefix(strings.ToLower(candidate), strings.ToLower(partial)) {
+			matches = append(matches, candidate)
+		}
+	}
+
+	return matches
+}
+
+// furthest keeps the error of the branch which read the most input.
+func furthest(a error, b error) error {
+	x, okA := a.(*commandError)
+	y, okB := b.(*commandError)
+	if !okA || (okB && y.cursor >= x.cursor) {
+		return b
+	}
+
+	return a
+}
+
+// parseChildren reads the input from the cursor with the first child of
+// node accepting it, then with its own children, until the input ends.
+func parseChildren(node *CommandNode, r *commandReader, ctx *CommandContext) (*CommandNode, error) {
+	var failure error = r.fail("Unknown or incomplete command, see below for error")
+	if node != ctx.server.commands.root {
+		failure = r.fail("Incorrect argument for command")
+	}
+
+	start := r.cursor
+	for _, child := range node.sortedChildren() {
+		if !child.allowed(ctx.Session) {
+			continue
+		}
+
+		r.cursor = start
+		if child.parser == nil {
+			if r.readWord() != child.name {
+				continue
+			}
+		} else {
+			value, err := child.parser.parse(r, ctx)
+			if err != nil {
+				failure = furthest(failure, err)
+				continue
+			}
+
+			if r.canRead() && r.peek() != ' ' {
+				failure = furthest(failure, r.fail("Expected whitespace to end one argument, but found trailing data"))
+				continue
+			}
+
+			ctx.args[child.name] = value
+		}
+
+		if !r.canRead() {
+			return child, nil
+		}
+
+		r.cursor += 1
+		end, err := parseChildren(child, r, ctx)
+		if err == nil {
+			return end, nil
+		}
+
+		failure = furthest(failure, err)
+		delete(ctx.args, child.name)
+	}
+
+	r.cursor = start
+	return nil, failure
+}
+
+// execute runs a command typed by the player, without its slash.
+func (self *client) execute(input string) error {
+	s := self.session()
+	ctx := &CommandContext{Session: s, Input: input, server: self.server, args: make(map[string]any)}
+	reader := &commandReader{input: input}
+
+	self.server.commands.lock.RLock()
+	node, err := parseChildren(self.server.commands.root, reader, ctx)
+	self.server.commands.lock.RUnlock()
+
+	if err != nil {
+		return self.sendSystem("§c"+err.Error(), false)
+	}
+
+	if node.handler == nil {
+		err := &commandError{"Unknown or incomplete command, see below for error", input, len(input)}
+		return self.sendSystem("§c"+err.Error(), false)
+	}
+
+	if err := node.handler(ctx); err != nil {
+		return self.sendSystem("§c"+err.Error(), false)
+	}
+
+	return nil
+}
+
+// suggestions lists the completions of the last word of input, and where
+// that word starts.
+func (self *client) suggestions(input string) (int, []string) {
+	s := self.session()
+	ctx := &CommandContext{Session: s, Input: input, server: self.server, args: make(map[string]any)}
+
+	self.server.commands.lock.RLock()
+	defer self.server.commands.lock.RUnlock()
+
+	return suggestChildren(self.server.commands.root, &commandReader{input: input}, ctx)
+}
+
+func suggestChildren(node *CommandNode, r *commandReader, ctx *CommandContext) (int, []string) {
+	start := r.cursor
+	partial := r.input[start:]
+	last := !strings.Contains(partial, " ")
+
+	matches := make([]string, 0)
+	for _, child := range node.sortedChildren() {
+		if !child.allowed(ctx.Session) {
+			continue
+		}
+
+		if last {
+			switch {
+			case child.suggests != nil:
+				matches = append(matches, child.suggests(ctx.Session, partial)...)
+			case child.parser == nil:
+				if strings.HasPrefix(child.name, partial) {
+					matches = append(matches, child.name)
+				}
+			default:
+				matches = append(matches, child.parser.suggest(ctx, partial)...)
+			}
+		}
+
+		r.cursor = start
+		if child.parser == nil {
+			if r.readWord() != child.name {
+				continue
+			}
+		} else {
+			value, err := child.parser.parse(r, ctx)
+			if err != nil {
+				continue
+			}
+
+			ctx.args[child.name] = value
+		}
+
+		if r.canRead() && r.peek() == ' ' {
+			r.cursor += 1
+			if at, found := suggestChildren(child, r, ctx); len(found) > 0 {
+				return at, found
+			}
+		}
+	}
+
+	return start, matches
+}
+
+// readCommandSuggestion answers command_suggestion, sent by the client to
+// complete the arguments whose suggestions come from the server.
+func readCommandSuggestion(c *client, data []byte) error {
+	m, err := readFromBuffer(data,
+		factoryPair{"id", intFactory},
+		factoryPair{"text", bytesFactory},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	text := string(m["text"].([]byte))
+	input := strings.TrimPrefix(text, "/")
+	start, matches := c.suggestions(input)
+
+	// The range sent back is within the text, slash included
+	offset := len(text) - len(input)
+	contents := []any{m["id"].(int), start + offset, len(input) - start, len(matches)}
+	for _, match := range matches {
+		contents = append(contents, match, false)
+	}
+
+	// command_suggestions
+	return c.send(0x0f, contents...)
+}
+
+// encodeCommands serializes the part of the tree the player may use, the
+// nodes being listed breadth first from the root.
+func (self *client) encodeCommands() (raw, error) {
+	s := self.session()
+
+	self.server.commands.lock.RLock()
+	defer self.server.commands.lock.RUnlock()
+
+	nodes := []*CommandNode{self.server.commands.root}
+	for i := 0; i < len(nodes); i++ {
+		for _, child := range nodes[i].children {
+			if child.allowed(s) {
+				nodes = append(nodes, child)
+			}
+		}
+	}
+
+	index := make(map[*CommandNode]int)
+	for i, node := range nodes {
+		index[node] = i
+	}
+
+	buffer := writeVarInt(len(nodes))
+	for i, node := range nodes {
+		flags := rootNode
+		switch {
+		case i == 0:
+		case node.parser == nil:
+			flags = literalNode
+		default:
+			flags = argumentNode
+		}
+
+		if node.handler != nil {
+			flags |= executableNode
+		}
+
+		if node.parser != nil && node.suggests != nil {
+			flags |= suggestionsNode
+		}
+
+		children := make([]int, 0)
+		for _, child := range node.children {
+			if j, ok := index[child]; ok {
+				children = append(children, j)
+			}
+		}
+
+		buffer = append(buffer, flags)
+		buffer = append(buffer, writeVarInt(len(children))...)
+		for _, j := range children {
+			buffer = append(buffer, writeVarInt(j)...)
+		}
+
+		if i == 0 {
+			continue
+		}
+
+		name, err := marshal(node.name)
+		if err != nil {
+			return nil, err
+		}
+
+		buffer = append(buffer, name...)
+
+		if node.parser == nil {
+			continue
+		}
+
+		id, ok := world.RegistryID("minecraft:command_argument_type", node.parser.kind())
+		if !ok {
+			return nil, fmt.Errorf("Unknown argument type %s", node.parser.kind())
+		}
+
+		properties, err := node.parser.properties()
+		if err != nil {
+			return nil, err
+		}
+
+		buffer = append(buffer, writeVarInt(id)...)
+		buffer = append(buffer, properties...)
+
+		if node.suggests != nil {
+			suggestions, err := marshal("minecraft:ask_server")
+			if err != nil {
+				return nil, err
+			}
+
+			buffer = append(buffer, suggestions...)
+		}
+	}
+
+	buffer = append(buffer, writeVarInt(0)...)
+
+	return raw(buffer), nil
+}
+
+// sendCommands sends the command tree, used by the client to highlight
+// and complete commands.
+func (self *client) sendCommands() error {
+	commands, err := self.encodeCommands()
+	if err != nil {
+		return err
+	}
+
+	// commands
+	return self.send(0x10, commands)
+}
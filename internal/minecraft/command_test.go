@@ -0,0 +1,229 @@
+package minecraft
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/keyboard-slayer/minecraft-server/internal/world"
+)
+
+func testCommandServer(t *testing.T, commands ...*CommandNode) *Server {
+	t.Helper()
+
+	serv := &Server{
+		cfg:      DefaultServerConfig(),
+		commands: newCommandRegistry(),
+		clients:  make(map[int]*client),
+	}
+
+	for _, command := range commands {
+		if err := serv.RegisterCommand(command); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return serv
+}
+
+func parseCommand(serv *Server, input string) (*CommandNode, *CommandContext, error) {
+	ctx := &CommandContext{Input: input, server: serv, args: make(map[string]any)}
+	node, err := parseChildren(serv.commands.root, &commandReader{input: input}, ctx)
+
+	return node, ctx, err
+}
+
+func TestCommandTree(t *testing.T) {
+	done := func(ctx *CommandContext) error { return nil }
+
+	serv := testCommandServer(t,
+		Literal("give").Then(
+			Argument("count", IntegerArgument(1, 64)).Executes(done).Then(
+				Argument("reason", GreedyArgument()).Executes(done),
+			),
+		),
+		Literal("mode").Then(
+			Argument("mode", GameModeArgument()).Executes(done),
+			Literal("all").Executes(done),
+		),
+		Literal("say").Then(Argument("message", StringArgument()).Executes(done)),
+		Literal("set").Then(
+			Argument("flag", BoolArgument()).Then(
+				Argument("value", DoubleArgument(0, 1)).Executes(done),
+			),
+		),
+	)
+
+	node, ctx, err := parseCommand(serv, "give 5")
+	if err != nil || node.name != "count" || ctx.Int("count") != 5 {
+		t.Fatalf("give 5: %v, %v", node, err)
+	}
+
+	node, ctx, err = parseCommand(serv, "give 5 because I can")
+	if err != nil || node.name != "reason" || ctx.String("reason") != "because I can" {
+		t.Fatalf("give with a reason: %q, %v", ctx.String("reason"), err)
+	}
+
+	// Literals win over the arguments reading the same word
+	node, _, err = parseCommand(serv, "mode all")
+	if err != nil || node.name != "all" {
+		t.Fatalf("mode all: %v, %v", node, err)
+	}
+
+	node, ctx, err = parseCommand(serv, "mode creative")
+	if err != nil || ctx.GameMode("mode") != Creative {
+		t.Fatalf("mode creative: %v, %v", node, err)
+	}
+
+	_, ctx, err = parseCommand(serv, `say "hello \"you\""`)
+	if err != nil || ctx.String("message") != `hello "you"` {
+		t.Fatalf("Quoted string: %q, %v", ctx.String("message"), err)
+	}
+
+	_, ctx, err = parseCommand(serv, "set true 0.5")
+	if err != nil || !ctx.Bool("flag") || ctx.Float("value") != 0.5 {
+		t.Fatalf("set true 0.5: %v", err)
+	}
+
+	// Commands stopping before a node that executes are incomplete
+	node, _, err = parseCommand(serv, "give")
+	if err != nil || node.handler != nil {
+		t.Fatalf("give alone: %v, %v", node, err)
+	}
+}
+
+func TestCommandErrors(t *testing.T) {
+	done := func(ctx *CommandContext) error { return nil }
+
+	serv := testCommandServer(t,
+		Literal("give").Then(Argument("count", IntegerArgument(1, 64)).Executes(done)),
+		Literal("say").Then(Argument("message", StringArgument()).Executes(done)),
+		Literal("set").Then(
+			Argument("flag", BoolArgument()).Then(
+				Argument("value", DoubleArgument(0, 1)).Executes(done),
+			),
+		),
+	)
+
+	cases := []struct {
+		input   string
+		message string
+		cursor  int
+	}{
+		{"nope", "Unknown or incomplete command, see below for error", 0},
+		{"give 65", "Integer must not be more than 64, found 65", 5},
+		{"give 0", "Integer must not be less than 1, found 0", 5},
+		{"give five", "Invalid integer 'five'", 5},
+		{"give 5x", "Invalid integer '5x'", 5},
+		{`say "open`, "Unclosed quoted string", 9},
+		{`say "bad \n"`, "Invalid escape sequence 'n' in quoted string", 10},
+		{`say "word"x`, "Expected whitespace to end one argument, but found trailing data", 10},
+		{"set maybe 0.5", "Invalid bool, expected true or false but found 'maybe'", 4},
+		// The error is the one of the argument read the furthest
+		{"set true 2", "Double must be between 0 and 1, found 2", 9},
+	}
+
+	for _, c := range cases {
+		_, _, err := parseCommand(serv, c.input)
+
+		var parseErr *commandError
+		if !errors.As(err, &parseErr) {
+			t.Fatalf("%s: got %v", c.input, err)
+		}
+
+		if parseErr.message != c.message || parseErr.cursor != c.cursor {
+			t.Fatalf("%s: got %q at %d", c.input, parseErr.message, parseErr.cursor)
+		}
+	}
+}
+
+func TestRegisterCommand(t *testing.T) {
+	serv := testCommandServer(t)
+
+	if err := serv.RegisterCommand(Argument("count", IntegerArgument(0, 1))); err == nil {
+		t.Fatal("Registered a command starting with an argument")
+	}
+
+	if err := serv.RegisterCommand(Literal("list")); err != nil {
+		t.Fatal(err)
+	}
+
+	// A command replaces the one of the same name
+	if err := serv.RegisterCommand(Literal("list")); err != nil || len(serv.commands.root.children) != 1 {
+		t.Fatalf("%d commands once replaced: %v", len(serv.commands.root.children), err)
+	}
+}
+
+func TestPositionArgument(t *testing.T) {
+	from := Location{X: 10, Y: 20, Z: 30, Yaw: 90}
+
+	cases := map[string]Location{
+		// Whole numbers are centered on their block, but not the height
+		"1 64 -3":       {X: 1.5, Y: 64, Z: -2.5, Yaw: 90},
+		"1.25 64.5 0.0": {X: 1.25, Y: 64.5, Z: 0, Yaw: 90},
+		"~ ~2 ~-0.5":    {X: 10, Y: 22, Z: 29.5, Yaw: 90},
+	}
+
+	for input, want := range cases {
+		v, err := PositionArgument().parse(&commandReader{input: input}, nil)
+		if err != nil {
+			t.Fatalf("%s: %v", input, err)
+		}
+
+		if got := v.(coordinates).resolve(from); got != want {
+			t.Fatalf("%s: got %+v", input, got)
+		}
+	}
+
+	for _, input := range []string{"1 2", "^ ^ ^1", "a b c", "1 NaN 2"} {
+		if _, err := PositionArgument().parse(&commandReader{input: input}, nil); err == nil {
+			t.Fatalf("%s: accepted", input)
+		}
+	}
+}
+
+func TestCommandSuggestions(t *testing.T) {
+	done := func(ctx *CommandContext) error { return nil }
+
+	serv := testCommandServer(t,
+		Literal("mode").Then(
+			Argument("mode", GameModeArgument()).Executes(done),
+			Literal("all").Executes(done),
+		),
+		Literal("money").Executes(done),
+	)
+
+	cases := []struct {
+		input   string
+		start   int
+		matches []string
+	}{
+		{"mo", 0, []string{"mode", "money"}},
+		{"mode s", 5, []string{"survival", "spectator"}},
+		{"mode ", 5, []string{"all", "survival", "creative", "adventure", "spectator"}},
+		{"nope", 0, []string{}},
+	}
+
+	for _, c := range cases {
+		ctx := &CommandContext{Input: c.input, server: serv, args: make(map[string]any)}
+		start, matches := suggestChildren(serv.commands.root, &commandReader{input: c.input}, ctx)
+
+		if start != c.start || !slices.Equal(matches, c.matches) {
+			t.Fatalf("%q: got %v at %d", c.input, matches, start)
+		}
+	}
+}
+
+func TestArgumentTypes(t *testing.T) {
+	parsers := []ArgumentParser{
+		IntegerArgument(0, 1), DoubleArgument(0, 1), BoolArgument(), WordArgument(),
+		PlayerArgument(), GameModeArgument(), PositionArgument(), ItemArgument(),
+	}
+
+	// The client can't read the tree past a type it doesn't know
+	for _, parser := range parsers {
+		if _, ok := world.RegistryID("minecraft:command_argument_type", parser.kind()); !ok {
+			t.Fatalf("Argument type %s isn't registered", parser.kind())
+		}
+	}
+}
@@ -0,0 +1,231 @@
+package minecraft
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+)
+
+// isOperator reports whether the player may manage the server, being
+// listed by name or UUID in ServerConfig.Operators.
+func (self *client) isOperator() bool {
+	operators := self.server.config().Operators
+
+	return slices.ContainsFunc(operators, func(op string) bool {
+		return strings.EqualFold(op, self.info.name) || op == self.info.uuid.String()
+	})
+}
+
+func operator(s *Session) bool {
+	return s.c.isOperator()
+}
+
+// registerDefaultCommands registers the vanilla commands the server
+// supports.
+func (self *Server) registerDefaultCommands() error {
+	commands := []*CommandNode{
+		Literal("tp").Requires(operator).Then(
+			Argument("location", PositionArgument()).Executes(teleportCommand),
+			Argument("destination", PlayerArgument()).Executes(teleportCommand),
+			Argument("targets", PlayersArgument()).Then(
+				Argument("location", PositionArgument()).Executes(teleportCommand),
+				Argument("destination", PlayerArgument()).Executes(teleportCommand),
+			),
+		),
+		Literal("gamemode").Requires(operator).Then(
+			Argument("gamemode", GameModeArgument()).Executes(gameModeCommand).Then(
+				Argument("target", PlayersArgument()).Executes(gameModeCommand),
+			),
+		),
+		Literal("give").Requires(operator).Then(
+			Argument("targets", PlayersArgument()).Then(
+				Argument("item", ItemArgument()).Executes(giveCommand).Then(
+					Argument("count", IntegerArgument(1, math.MaxInt32)).Executes(giveCommand),
+				),
+			),
+		),
+		Literal("kick").Requires(operator).Then(
+			Argument("targets", PlayersArgument()).Executes(kickCommand).Then(
+				Argument("reason", GreedyArgument()).Executes(kickCommand),
+			),
+		),
+		Literal("list").Executes(listCommand),
+		Literal("say").Requires(operator).Then(
+			Argument("message", GreedyArgument()).Executes(sayCommand),
+		),
+		Literal("stop").Requires(operator).Executes(stopCommand),
+	}
+
+	for _, command := range commands {
+		if err := self.RegisterCommand(command); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func teleportCommand(ctx *CommandContext) error {
+	targets := ctx.Players("targets")
+	if targets == nil {
+		targets = []*Session{ctx.Session}
+	}
+
+	var destination Location
+	world := ctx.Session.World()
+	name := ""
+	if to := ctx.Player("destination"); to != nil {
+		destination, world, name = to.Location(), to.World(), to.Name()
+	} else {
+		destination = ctx.Location("location")
+		name = fmt.Sprintf("%.2f, %.2f, %.2f", destination.X, destination.Y, destination.Z)
+	}
+
+	for _, target := range targets {
+		// The target keeps looking where it was looking
+		loc := destination
+		current := target.Location()
+		loc.Yaw, loc.Pitch = current.Yaw, current.Pitch
+
+		if target.World() != world {
+			if err := target.ChangeWorld(world); err != nil {
+				return err
+			}
+		}
+
+		if err := target.Teleport(loc); err != nil {
+			return err
+		}
+	}
+
+	if len(targets) == 1 {
+		return ctx.Session.SendMessage(fmt.Sprintf("Teleported %s to %s", targets[0].Name(), name))
+	}
+
+	return ctx.Session.SendMessage(fmt.Sprintf("Teleported %d entities to %s", len(targets), name))
+}
+
+func gameModeCommand(ctx *CommandContext) error {
+	mode := ctx.GameMode("gamemode")
+
+	targets := ctx.Players("target")
+	if targets == nil {
+		targets = []*Session{ctx.Session}
+	}
+
+	for _, target := range targets {
+		if target.GameMode() == mode {
+			continue
+		}
+
+		if err := target.SetGameMode(mode); err != nil {
+			return err
+		}
+
+		name := mode.title()
+		if target.c == ctx.Session.c {
+			if err := target.SendMessage(fmt.Sprintf("Set own game mode to %s Mode", name)); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if err := ctx.Session.SendMessage(fmt.Sprintf("Set %s's game mode to %s Mode", target.Name(), name)); err != nil {
+			return err
+		}
+
+		if err := target.SendMessage(fmt.Sprintf("Your game mode has been updated to %s Mode", name)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// maxGivenStacks caps what /give hands out, as the vanilla server does
+const maxGivenStacks = 100
+
+// itemDisplayName returns the English name of an item, "diamond_sword"
+// reading "Diamond Sword".
+func itemDisplayName(item string) string {
+	words := strings.Split(strings.TrimPrefix(item, "minecraft:"), "_")
+	for i, word := range words {
+		if word != "" {
+			words[i] = strings.ToUpper(word[:1]) + word[1:]
+		}
+	}
+
+	return strings.Join(words, " ")
+}
+
+func giveCommand(ctx *CommandContext) error {
+	item := ctx.String("item")
+
+	count := ctx.Int("count")
+	if count == 0 {
+		count = 1
+	}
+
+	stack := NewItemStack(item, 1)
+	if limit := stack.MaxStackSize() * maxGivenStacks; count > limit {
+		return fmt.Errorf("Can't give more than %d of [%s]", limit, itemDisplayName(item))
+	}
+
+	targets := ctx.Players("targets")
+	for _, target := range targets {
+		// Stacks are given one at a time, what doesn't fit being dropped
+		for left := count; left > 0; left -= stack.MaxStackSize() {
+			if _, err := target.Give(stack.withCount(min(left, stack.MaxStackSize()))); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(targets) == 1 {
+		return ctx.Session.SendMessage(fmt.Sprintf("Gave %d [%s] to %s", count, itemDisplayName(item), targets[0].Name()))
+	}
+
+	return ctx.Session.SendMessage(fmt.Sprintf("Gave %d [%s] to %d players", count, itemDisplayName(item), len(targets)))
+}
+
+func kickCommand(ctx *CommandContext) error {
+	reason := ctx.String("reason")
+	if reason == "" {
+		reason = "Kicked by an operator"
+	}
+
+	for _, target := range ctx.Players("targets") {
+		target.Kick(reason)
+
+		if err := ctx.Session.SendMessage(fmt.Sprintf("Kicked %s: %s", target.Name(), reason)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func listCommand(ctx *CommandContext) error {
+	names := make([]string, 0)
+	for _, c := range ctx.server.listed() {
+		names = append(names, c.info.name)
+	}
+
+	return ctx.Session.SendMessage(fmt.Sprintf("There are %d players online: %s", len(names), strings.Join(names, ", ")))
+}
+
+func sayCommand(ctx *CommandContext) error {
+	ctx.server.Broadcast(fmt.Sprintf("[%s] %s", ctx.Session.Name(), ctx.String("message")))
+	return nil
+}
+
+func stopCommand(ctx *CommandContext) error {
+	if err := ctx.Session.SendMessage("Stopping the server"); err != nil {
+		return err
+	}
+
+	ctx.server.Stop()
+	return nil
+}
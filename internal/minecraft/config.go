@@ -0,0 +1,98 @@
+package minecraft
+
+import "time"
+
+type ServerConfig struct {
+	Port  uint16
+	Brand string
+	// Worlds are loaded together, the players joining the first one
+	Worlds []WorldConfig
+	// DimensionTypes are added to the vanilla ones, for worlds with another
+	// height or sky
+	DimensionTypes []DimensionType
+	// ViewDistance is the maximum distance, in chunks, sent to the players
+	ViewDistance int
+	// AutosaveInterval is the time between two saves of the world, zero
+	// turning the periodic saving off
+	AutosaveInterval time.Duration
+
+	// TabHeader and TabFooter are shown above and below the player list,
+	// refreshed every second. They are text/template templates given the
+	// Name, World and Ping of the player, and the Online count and TPS of
+	// the server: "{{.Online}} players, {{printf \"%.1f\" .TPS}} TPS"
+	TabHeader string
+	TabFooter string
+	// ChatFormat is the text/template template of the chat messages, given
+	// the Name and World of the player and the Message. The messages are
+	// decorated by the client when empty
+	ChatFormat string
+
+	// DefaultGameMode is the game mode of the players joining for the
+	// first time
+	DefaultGameMode GameMode
+
+	// Operators are the names or UUIDs of the players allowed to run the
+	// commands managing the server, such as /stop
+	Operators []string
+
+	// AcceptTransfers lets in players sent over by another server with a
+	// transfer packet.
+	AcceptTransfers bool
+	// SessionServer checks that the players logging in own their account,
+	// and gives their UUID and skin. The players are trusted when empty
+	SessionServer string
+
+	// ServerLinks are listed in the pause menu of the players
+	ServerLinks []ServerLink
+	// ReportDetails are added to the crash reports of the players
+	ReportDetails []ReportDetail
+	// FeatureFlags enables experimental features, such as minecraft:trade_rebalance
+	FeatureFlags []string
+}
+
+// WorldConfig describes one of the worlds of the server.
+type WorldConfig struct {
+	// Name is the dimension of the world, such as minecraft:the_nether
+	Name string
+	// Type is the dimension type of the world, the one named like the
+	// world when empty
+	Type string
+	// Dir is a vanilla world folder to serve, or the file of a compact
+	// world. The world is kept in memory when empty
+	Dir string
+	// Storage is how the world is kept: anvil, memory or compact. It
+	// defaults to anvil when Dir is set and to memory otherwise
+	Storage string
+	// LevelType names the generator of the missing chunks: flat, normal or
+	// void. It defaults to normal
+	LevelType string
+	// GeneratorSettings is the preset of the generator, such as the layers
+	// of a flat world or the biome of a void one
+	GeneratorSettings string
+	// Seed of a new world, a number or any string to hash, random when
+	// empty. Worlds read from a folder keep their own seed
+	Seed string
+}
+
+func DefaultServerConfig() ServerConfig {
+	return ServerConfig{
+		Port:         6969,
+		Brand:        "vanilla",
+		ViewDistance: 10,
+
+		Worlds: []WorldConfig{
+			{Name: "minecraft:overworld", LevelType: "flat"},
+		},
+		DimensionTypes: []DimensionType{},
+		Operators:      []string{},
+
+		DefaultGameMode: Survival,
+		SessionServer:   "https://sessionserver.mojang.com",
+
+		AutosaveInterval: 5 * time.Minute,
+
+		ServerLinks:   []ServerLink{},
+		ReportDetails: []ReportDetail{},
+		FeatureFlags:  []string{"minecraft:vanilla"},
+	}
+}
@@ -0,0 +1,232 @@
+package minecraft
+
+import (
+	"fmt"
+
+	"github.com/beito123/nbt"
+	"github.com/google/uuid"
+)
+
+// ConfigurationHandler runs while a player is in the Config state, right
+// before the configuration is finished. It is where registries, tags or
+// resource packs are sent, both on join and on reconfiguration.
+type ConfigurationHandler func(s *Session) error
+
+// OnConfiguration registers a handler run every time a player goes through
+// the Config state.
+func (self *Server) OnConfiguration(handler ConfigurationHandler) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	self.configurationHandlers = append(self.configurationHandlers, handler)
+}
+
+// Reconfigure sends every player in Play back to the Config state, to pick
+// up reloaded registries, tags, feature flags or resource packs.
+func (self *Server) Reconfigure() {
+	self.broadcast(func(c *client) error {
+		if c.state.Load() != Play {
+			return nil
+		}
+
+		return c.startConfiguration()
+	})
+}
+
+// configure starts the Config state, the client answers select_known_packs
+// and the configuration is then finished by finishConfiguration.
+func (self *client) configure() error {
+	if err := self.sendBrand(); err != nil {
+		return err
+	}
+
+	cfg := self.server.config()
+
+	if err := self.sendServerLinks(cfg.ServerLinks); err != nil {
+		return err
+	}
+
+	if err := self.sendReportDetails(cfg.ReportDetails); err != nil {
+		return err
+	}
+
+	// update_enabled_features
+	if err := self.send(0x0c, cfg.FeatureFlags); err != nil {
+		return err
+	}
+
+	// select_known_packs
+	return self.send(0x0e, 1, "minecraft", "core", "1.21.8")
+}
+
+func (self *client) finishConfiguration() error {
+	if err := self.sendDimensionTypes(); err != nil {
+		return err
+	}
+
+	if err := self.sendChatTypes(); err != nil {
+		return err
+	}
+
+	if err := self.sendSyncedRegistries(); err != nil {
+		return err
+	}
+
+	if err := self.sendTags(); err != nil {
+		return err
+	}
+
+	self.server.lock.RLock()
+	handlers := self.server.configurationHandlers
+	self.server.lock.RUnlock()
+
+	for _, handler := range handlers {
+		if err := handler(self.session()); err != nil {
+			return err
+		}
+	}
+
+	// finish_configuration
+	return self.send(0x03)
+}
+
+// enterPlay sends what a client needs in Play, which it starts afresh both
+// on join and after reconfiguration: the world, the commands, the player
+// and the chunks around them.
+func (self *client) enterPlay() error {
+	info, err := self.spawnInfo()
+	if err != nil {
+		return err
+	}
+
+	// login
+	distance := self.server.config().ViewDistance
+	if err := self.send(0x2b, int32(self.id), false, self.server.Worlds(), 1, distance, distance, false, false, false, info, false); err != nil {
+		return err
+	}
+
+	if err := self.teleportTo(self.location()); err != nil {
+		return err
+	}
+
+	if err := self.sendCommands(); err != nil {
+		return err
+	}
+
+	if err := self.sendPlayerState(); err != nil {
+		return err
+	}
+
+	self.tracker.reset()
+	if err := self.startView(); err != nil {
+		return err
+	}
+
+	self.startKeepAlive()
+	return nil
+}
+
+// join brings a player to Play for the first time, where they left the
+// world, and adds them to the tab list of the others.
+func (self *client) join() error {
+	if err := self.loadPlayer(); err != nil {
+		return err
+	}
+
+	if err := self.enterPlay(); err != nil {
+		return err
+	}
+
+	return self.server.list(self)
+}
+
+// rejoin brings a player back to Play after reconfiguration. The others
+// kept them in their tab list, so only the player is sent it.
+func (self *client) rejoin() error {
+	if err := self.enterPlay(); err != nil {
+		return err
+	}
+
+	if err := self.sendInfo(listPlayer, self.server.listed()); err != nil {
+		return err
+	}
+
+	return self.sendTabList()
+}
+
+// sendRegistry sends the entries of a registry in the order of their IDs,
+// those without data being taken from the packs known by the client.
+func (self *client) sendRegistry(registry string, names []string, data map[string]nbt.Tag) error {
+	entries := raw(writeVarInt(len(names)))
+
+	for _, name := range names {
+		tag, ok := data[name]
+
+		contents := []any{name, ok}
+		if ok {
+			contents = append(contents, tag)
+		}
+
+		entry, err := marshal(contents...)
+		if err != nil {
+			return err
+		}
+
+		entries = append(entries, entry...)
+	}
+
+	// registry_data
+	return self.send(0x07, registry, entries)
+}
+
+func (self *client) startConfiguration() error {
+	if self.state.Load() != Play {
+		return fmt.Errorf("Can't reconfigure in state %s", self.state.Load().string())
+	}
+
+	// start_configuration
+	return self.send(0x6f)
+}
+
+func (self *client) pushResourcePack(id uuid.UUID, url string, hash string, forced bool, prompt string) error {
+	contents := []any{id, url, hash, forced, prompt != ""}
+	if prompt != "" {
+		contents = append(contents, text(prompt))
+	}
+
+	switch self.state.Load() {
+	case Config:
+		// resource_pack_push
+		return self.send(0x09, contents...)
+	case Play:
+		// resource_pack_push
+		return self.send(0x4a, contents...)
+	default:
+		return fmt.Errorf("Can't push resource packs in state %s", self.state.Load().string())
+	}
+}
+
+func readResourcePackResponse(c *client, data []byte) error {
+	m, err := readFromBuffer(data,
+		factoryPair{"uuid", uuidFactory},
+		factoryPair{"result", intFactory},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	result := []string{
+		"successfully loaded", "declined", "failed download", "accepted",
+		"downloaded", "invalid url", "failed to reload", "discarded",
+	}
+
+	status := "??"
+	if r := m["result"].(int); r >= 0 && r < len(result) {
+		status = result[r]
+	}
+
+	c.logger.Debug("Resource pack", "uuid", m["uuid"].(uuid.UUID), "result", status)
+
+	return nil
+}
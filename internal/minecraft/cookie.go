@@ -0,0 +1,124 @@
+package minecraft
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Clients refuse cookies bigger than this, both ways.
+const maxCookieSize = 5120
+
+// CookieHandler receives the answer to a cookie request. ok is false when
+// the client has no cookie stored under that key.
+type CookieHandler func(s *Session, data []byte, ok bool) error
+
+type cookieJar struct {
+	lock    sync.Mutex
+	pending map[string][]CookieHandler
+}
+
+func newCookieJar() *cookieJar {
+	return &cookieJar{
+		pending: make(map[string][]CookieHandler),
+	}
+}
+
+func (self *cookieJar) wait(key string, handler CookieHandler) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	self.pending[key] = append(self.pending[key], handler)
+}
+
+func (self *cookieJar) take(key string) []CookieHandler {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	handlers := self.pending[key]
+	delete(self.pending, key)
+
+	return handlers
+}
+
+func (self *client) storeCookie(key string, data []byte) error {
+	if len(data) > maxCookieSize {
+		return fmt.Errorf("Cookie %s is %d bytes long, the limit is %d", key, len(data), maxCookieSize)
+	}
+
+	switch self.state.Load() {
+	case Config:
+		// store_cookie
+		return self.send(0x0a, key, data)
+	case Play:
+		// store_cookie
+		return self.send(0x71, key, data)
+	default:
+		return fmt.Errorf("Can't store cookies in state %s", self.state.Load().string())
+	}
+}
+
+func (self *client) requestCookie(key string, handler CookieHandler) error {
+	var id int
+
+	switch self.state.Load() {
+	case Login:
+		id = 0x05
+	case Config:
+		id = 0x00
+	case Play:
+		id = 0x15
+	default:
+		return fmt.Errorf("Can't request cookies in state %s", self.state.Load().string())
+	}
+
+	self.cookies.wait(key, handler)
+
+	// cookie_request
+	return self.send(id, key)
+}
+
+func (self *client) transfer(host string, port uint16) error {
+	switch self.state.Load() {
+	case Config:
+		// transfer
+		return self.send(0x0b, host, int(port))
+	case Play:
+		// transfer
+		return self.send(0x7a, host, int(port))
+	default:
+		return fmt.Errorf("Can't transfer in state %s", self.state.Load().string())
+	}
+}
+
+func readCookieResponse(c *client, data []byte) error {
+	m, err := readFromBuffer(data,
+		factoryPair{"key", bytesFactory},
+		factoryPair{"payload", optionalBytesFactory},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	key := string(m["key"].([]byte))
+	payload, ok := m["payload"].([]byte)
+
+	if len(payload) > maxCookieSize {
+		return fmt.Errorf("Cookie %s is %d bytes long, the limit is %d", key, len(payload), maxCookieSize)
+	}
+
+	c.logger.Debug("", "cookie", key, "payload", payload)
+
+	handlers := c.cookies.take(key)
+	if len(handlers) == 0 {
+		return fmt.Errorf("Got an unrequested cookie %s", key)
+	}
+
+	for _, handler := range handlers {
+		if err := handler(c.session(), payload, ok); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
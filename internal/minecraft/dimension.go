@@ -0,0 +1,144 @@
+package minecraft
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"slices"
+
+	"github.com/beito123/nbt"
+)
+
+// DimensionType sets the height and the rendering of the worlds using it.
+// The vanilla types are known by the client, other ones are sent to it
+// along with their settings.
+type DimensionType struct {
+	Name          string
+	MinY          int
+	Height        int
+	LogicalHeight int
+
+	HasSkylight        bool
+	HasCeiling         bool
+	Ultrawarm          bool
+	Natural            bool
+	CoordinateScale    float64
+	BedWorks           bool
+	RespawnAnchorWorks bool
+	PiglinSafe         bool
+	HasRaids           bool
+
+	// Infiniburn is the block tag burning forever, Effects the sky
+	Infiniburn   string
+	Effects      string
+	AmbientLight float32
+	// FixedTime is the time of day the sky is stuck at, -1 letting it move
+	FixedTime int64
+
+	MonsterSpawnLightLevel      int
+	MonsterSpawnBlockLightLimit int
+}
+
+// Vanilla dimension types, in the order of the registry
+var vanillaDimensionTypes = []DimensionType{
+	{
+		Name: "minecraft:overworld", MinY: -64, Height: 384, LogicalHeight: 384,
+		HasSkylight: true, Natural: true, CoordinateScale: 1, BedWorks: true, HasRaids: true,
+		Infiniburn: "#minecraft:infiniburn_overworld", Effects: "minecraft:overworld", FixedTime: -1,
+		MonsterSpawnLightLevel: 7,
+	},
+	{
+		Name: "minecraft:overworld_caves", MinY: -64, Height: 384, LogicalHeight: 384,
+		HasSkylight: true, HasCeiling: true, Natural: true, CoordinateScale: 1, BedWorks: true, HasRaids: true,
+		Infiniburn: "#minecraft:infiniburn_overworld", Effects: "minecraft:overworld", FixedTime: -1,
+		MonsterSpawnLightLevel: 7,
+	},
+	{
+		Name: "minecraft:the_end", MinY: 0, Height: 256, LogicalHeight: 256,
+		CoordinateScale: 1, HasRaids: true,
+		Infiniburn: "#minecraft:infiniburn_end", Effects: "minecraft:the_end", FixedTime: 6000,
+		MonsterSpawnLightLevel: 7,
+	},
+	{
+		Name: "minecraft:the_nether", MinY: 0, Height: 256, LogicalHeight: 128,
+		HasCeiling: true, Ultrawarm: true, CoordinateScale: 8, RespawnAnchorWorks: true, PiglinSafe: true,
+		Infiniburn: "#minecraft:infiniburn_nether", Effects: "minecraft:the_nether", AmbientLight: 0.1, FixedTime: 18000,
+		MonsterSpawnLightLevel: 7, MonsterSpawnBlockLightLimit: 15,
+	},
+}
+
+// dimensionTypes returns the vanilla dimension types followed by the ones
+// of the configuration, the index of a type being its registry ID.
+func dimensionTypes(cfg ServerConfig) []DimensionType {
+	return append(slices.Clone(vanillaDimensionTypes), cfg.DimensionTypes...)
+}
+
+func dimensionType(cfg ServerConfig, name string) (DimensionType, int, error) {
+	for i, t := range dimensionTypes(cfg) {
+		if t.Name == name {
+			return t, i, nil
+		}
+	}
+
+	return DimensionType{}, 0, fmt.Errorf("Unknown dimension type %s", name)
+}
+
+func boolTag(name string, value bool) nbt.Tag {
+	if value {
+		return nbt.NewByteTag(name, 1)
+	}
+
+	return nbt.NewByteTag(name, 0)
+}
+
+func (self DimensionType) encode() nbt.Tag {
+	values := map[string]nbt.Tag{
+		"min_y":                           nbt.NewIntTag("min_y", int32(self.MinY)),
+		"height":                          nbt.NewIntTag("height", int32(self.Height)),
+		"logical_height":                  nbt.NewIntTag("logical_height", int32(self.LogicalHeight)),
+		"has_skylight":                    boolTag("has_skylight", self.HasSkylight),
+		"has_ceiling":                     boolTag("has_ceiling", self.HasCeiling),
+		"ultrawarm":                       boolTag("ultrawarm", self.Ultrawarm),
+		"natural":                         boolTag("natural", self.Natural),
+		"coordinate_scale":                nbt.NewDoubleTag("coordinate_scale", self.CoordinateScale),
+		"bed_works":                       boolTag("bed_works", self.BedWorks),
+		"respawn_anchor_works":            boolTag("respawn_anchor_works", self.RespawnAnchorWorks),
+		"piglin_safe":                     boolTag("piglin_safe", self.PiglinSafe),
+		"has_raids":                       boolTag("has_raids", self.HasRaids),
+		"infiniburn":                      nbt.NewStringTag("infiniburn", self.Infiniburn),
+		"effects":                         nbt.NewStringTag("effects", self.Effects),
+		"ambient_light":                   nbt.NewFloatTag("ambient_light", self.AmbientLight),
+		"monster_spawn_light_level":       nbt.NewIntTag("monster_spawn_light_level", int32(self.MonsterSpawnLightLevel)),
+		"monster_spawn_block_light_limit": nbt.NewIntTag("monster_spawn_block_light_limit", int32(self.MonsterSpawnBlockLightLimit)),
+	}
+
+	if self.FixedTime >= 0 {
+		values["fixed_time"] = nbt.NewLongTag("fixed_time", self.FixedTime)
+	}
+
+	return nbt.NewCompoundTag("", values)
+}
+
+// sendDimensionTypes sends the dimension_type registry, leaving the vanilla
+// entries to the core pack of the client.
+func (self *client) sendDimensionTypes() error {
+	types := dimensionTypes(self.server.config())
+
+	names := make([]string, len(types))
+	data := make(map[string]nbt.Tag)
+	for i, t := range types {
+		names[i] = t.Name
+		if i >= len(vanillaDimensionTypes) {
+			data[t.Name] = t.encode()
+		}
+	}
+
+	return self.sendRegistry("minecraft:dimension_type", names, data)
+}
+
+// hashedSeed is the seed given to the client for the biome blending, the
+// first 8 bytes of the SHA-256 of the seed.
+func hashedSeed(seed int64) int64 {
+	sum := sha256.Sum256(binary.LittleEndian.AppendUint64(nil, uint64(seed)))
+	return int64(binary.LittleEndian.Uint64(sum[:8]))
+}
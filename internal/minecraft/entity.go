@@ -0,0 +1,393 @@
+package minecraft
+
+import (
+	"math"
+	"sync"
+
+	"github.com/keyboard-slayer/minecraft-server/internal/world"
+)
+
+// Entity metadata the players are sent with
+const (
+	sharedFlagsIndex = 0
+	poseIndex        = 6
+	skinPartsIndex   = 17
+	mainHandIndex    = 18
+
+	byteMetadata = 0
+	poseMetadata = 21
+	endMetadata  = 0xff
+)
+
+// Shared flags and poses, as known by the client
+const (
+	crouchingFlag byte = 0x02
+	sprintingFlag byte = 0x08
+
+	standingPose  = 0
+	crouchingPose = 5
+)
+
+// Deltas of the relative moves are in 1/4096 of a block, a full sync being
+// sent when they don't fit or every few seconds against drift.
+const (
+	deltaScale    = 4096
+	syncInterval  = 400
+	equipmentSize = 6
+)
+
+// tracker follows the players another player sees. It is only used by the
+// tick goroutine, apart from the reset when the client drops its entities.
+type tracker struct {
+	lock    sync.Mutex
+	tracked map[*client]*trackedPlayer
+}
+
+// trackedPlayer is the state of a player last sent to a viewer.
+type trackedPlayer struct {
+	x, y, z    int64
+	yaw, pitch byte
+	onGround   bool
+	metadata   string
+	equipment  string
+	ticks      int
+}
+
+func newTracker() *tracker {
+	return &tracker{tracked: make(map[*client]*trackedPlayer)}
+}
+
+// reset forgets the tracked players, the client having dropped them on
+// respawn or reconfiguration.
+func (self *tracker) reset() {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	self.tracked = make(map[*client]*trackedPlayer)
+}
+
+func angle(degrees float32) byte {
+	return byte(int(math.Floor(float64(degrees) * 256 / 360)))
+}
+
+func fixed(v float64) int64 {
+	return int64(math.Round(v * deltaScale))
+}
+
+// readPlayerInput handles player_input, the keys held by the player.
+func readPlayerInput(c *client, data []byte) error {
+	m, err := readFromBuffer(data,
+		factoryPair{"flags", byteFactory},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	c.moveLock.Lock()
+	c.sneaking = m["flags"].(byte)&0x20 != 0
+	c.moveLock.Unlock()
+
+	return nil
+}
+
+// Actions of player_command
+const (
+	startSprinting = 1
+	stopSprinting  = 2
+)
+
+func readPlayerCommand(c *client, data []byte) error {
+	m, err := readFromBuffer(data,
+		factoryPair{"entity", intFactory},
+		factoryPair{"action", intFactory},
+		factoryPair{"data", intFactory},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	c.moveLock.Lock()
+	defer c.moveLock.Unlock()
+
+	switch m["action"].(int) {
+	case startSprinting:
+		c.sprinting = true
+	case stopSprinting:
+		c.sprinting = false
+	}
+
+	return nil
+}
+
+// metadata encodes the entity data of the player other players see.
+func (self *client) metadata() (string, error) {
+	self.moveLock.Lock()
+	sneaking, sprinting := self.sneaking, self.sprinting
+	self.moveLock.Unlock()
+
+	settings := self.settings()
+
+	flags := byte(0)
+	pose := standingPose
+	if sneaking {
+		flags |= crouchingFlag
+		pose = crouchingPose
+	}
+
+	if sprinting {
+		flags |= sprintingFlag
+	}
+
+	mainHand := byte(1)
+	if settings.isHandLeft {
+		mainHand = 0
+	}
+
+	data, err := marshal(
+		byte(sharedFlagsIndex), byteMetadata, flags,
+		byte(poseIndex), poseMetadata, pose,
+		byte(skinPartsIndex), byteMetadata, settings.skinPart,
+		byte(mainHandIndex), byteMetadata, mainHand,
+		byte(endMetadata),
+	)
+
+	return string(data), err
+}
+
+// equipmentSlots lists the inventory slots behind the equipment slots of
+// the entity, the main hand being the held slot of the hotbar.
+var equipmentSlots = [equipmentSize]int{-1, offhandSlot, bootsSlot, leggingsSlot, chestplateSlot, helmetSlot}
+
+// equipment encodes what the player holds and wears.
+func (self *client) equipment() (string, error) {
+	self.inventory.lock.Lock()
+	defer self.inventory.lock.Unlock()
+
+	buffer := make([]byte, 0)
+	for slot, i := range equipmentSlots {
+		if i < 0 {
+			i = hotbarSlot + self.inventory.held
+		}
+
+		// The high bit tells another slot follows
+		flag := byte(0x80)
+		if slot == equipmentSize-1 {
+			flag = 0
+		}
+
+		data, err := encodeSlot(self.inventory.slots[i])
+		if err != nil {
+			return "", err
+		}
+
+		buffer = append(buffer, byte(slot)|flag)
+		buffer = append(buffer, data...)
+	}
+
+	return string(buffer), nil
+}
+
+// trackPlayers spawns, moves and despawns the players every player in
+// Play sees, those in the chunks of their view. Only the players of the
+// tab list are tracked, as the clients spawn the players they know.
+func (self *Server) trackPlayers() {
+	players := make([]*client, 0)
+	for _, c := range self.listed() {
+		if c.dimension.Load() != nil && c.currentView() != nil {
+			players = append(players, c)
+		}
+	}
+
+	for _, viewer := range players {
+		if err := viewer.track(players); err != nil {
+			viewer.logger.Error("Couldn't track players", "error", err)
+		}
+	}
+}
+
+func (self *client) track(players []*client) error {
+	self.tracker.lock.Lock()
+	defer self.tracker.lock.Unlock()
+
+	view := self.currentView()
+	if view == nil {
+		return nil
+	}
+
+	visible := make(map[*client]bool)
+	for _, other := range players {
+		if other == self || other.dimension.Load() != self.dimension.Load() {
+			continue
+		}
+
+		if view.has(other.location().chunk()) {
+			visible[other] = true
+		}
+	}
+
+	removed := make([]int, 0)
+	for other := range self.tracker.tracked {
+		if !visible[other] {
+			removed = append(removed, other.id)
+			delete(self.tracker.tracked, other)
+		}
+	}
+
+	if len(removed) > 0 {
+		contents := []any{len(removed)}
+		for _, id := range removed {
+			contents = append(contents, id)
+		}
+
+		// remove_entities
+		if err := self.send(0x46, contents...); err != nil {
+			return err
+		}
+	}
+
+	for other := range visible {
+		state, ok := self.tracker.tracked[other]
+		if !ok {
+			state, err := self.spawnPlayer(other)
+			if err != nil {
+				return err
+			}
+
+			self.tracker.tracked[other] = state
+			continue
+		}
+
+		if err := self.movePlayer(other, state); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (self *client) spawnPlayer(other *client) (*trackedPlayer, error) {
+	kind, _ := world.EntityTypeID("minecraft:player")
+	loc := other.location()
+
+	state := &trackedPlayer{
+		x: fixed(loc.X), y: fixed(loc.Y), z: fixed(loc.Z),
+		yaw: angle(loc.Yaw), pitch: angle(loc.Pitch),
+		onGround: loc.OnGround,
+	}
+
+	// add_entity
+	err := self.send(0x01,
+		other.id, other.info.uuid, kind, loc.X, loc.Y, loc.Z,
+		state.pitch, state.yaw, state.yaw, 0, int16(0), int16(0), int16(0),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, err := other.metadata()
+	if err != nil {
+		return nil, err
+	}
+
+	state.metadata = metadata
+
+	// set_entity_data
+	if err := self.send(0x5c, other.id, raw(metadata)); err != nil {
+		return nil, err
+	}
+
+	equipment, err := other.equipment()
+	if err != nil {
+		return nil, err
+	}
+
+	state.equipment = equipment
+
+	// set_equipment
+	if err := self.send(0x5f, other.id, raw(equipment)); err != nil {
+		return nil, err
+	}
+
+	return state, nil
+}
+
+func (self *client) movePlayer(other *client, state *trackedPlayer) error {
+	loc := other.location()
+	x, y, z := fixed(loc.X), fixed(loc.Y), fixed(loc.Z)
+	yaw, pitch := angle(loc.Yaw), angle(loc.Pitch)
+
+	dx, dy, dz := x-state.x, y-state.y, z-state.z
+	moved := dx != 0 || dy != 0 || dz != 0
+	rotated := yaw != state.yaw || pitch != state.pitch
+	state.ticks += 1
+
+	fits := func(d int64) bool {
+		return d >= math.MinInt16 && d <= math.MaxInt16
+	}
+
+	var err error
+	switch {
+	case state.ticks >= syncInterval || (moved && !(fits(dx) && fits(dy) && fits(dz))):
+		// entity_position_sync
+		err = self.send(0x1f, other.id, loc.X, loc.Y, loc.Z, 0.0, 0.0, 0.0, loc.Yaw, loc.Pitch, loc.OnGround)
+		state.ticks = 0
+	case moved && rotated:
+		// move_entity_pos_rot
+		err = self.send(0x2f, other.id, int16(dx), int16(dy), int16(dz), yaw, pitch, loc.OnGround)
+	case moved:
+		// move_entity_pos
+		err = self.send(0x2e, other.id, int16(dx), int16(dy), int16(dz), loc.OnGround)
+	case rotated:
+		// move_entity_rot
+		err = self.send(0x31, other.id, yaw, pitch, loc.OnGround)
+	case loc.OnGround != state.onGround:
+		// move_entity_pos
+		err = self.send(0x2e, other.id, int16(0), int16(0), int16(0), loc.OnGround)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if yaw != state.yaw {
+		// rotate_head
+		if err := self.send(0x4c, other.id, yaw); err != nil {
+			return err
+		}
+	}
+
+	state.x, state.y, state.z = x, y, z
+	state.yaw, state.pitch = yaw, pitch
+	state.onGround = loc.OnGround
+
+	metadata, err := other.metadata()
+	if err != nil {
+		return err
+	}
+
+	if metadata != state.metadata {
+		state.metadata = metadata
+
+		// set_entity_data
+		if err := self.send(0x5c, other.id, raw(metadata)); err != nil {
+			return err
+		}
+	}
+
+	equipment, err := other.equipment()
+	if err != nil {
+		return err
+	}
+
+	if equipment != state.equipment {
+		state.equipment = equipment
+
+		// set_equipment
+		return self.send(0x5f, other.id, raw(equipment))
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package minecraft
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+)
+
+func TestPlayerMetadata(t *testing.T) {
+	c := &client{
+		cfgLock:  &sync.RWMutex{},
+		moveLock: &sync.Mutex{},
+		sneaking: true,
+	}
+
+	c.info.cfg.skinPart = 0x7f
+
+	data, err := c.metadata()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Index, serializer and value of each entry, the serializers being
+	// numbered as in the entityMetadataEntry mapper of protocol.json:
+	// 0 is a byte and 21 a pose
+	want := []byte{
+		0, 0, crouchingFlag,
+		6, 21, crouchingPose,
+		17, 0, 0x7f,
+		18, 0, 1,
+		0xff,
+	}
+
+	if !bytes.Equal([]byte(data), want) {
+		t.Fatalf("Metadata is %v instead of %v", []byte(data), want)
+	}
+}
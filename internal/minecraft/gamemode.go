@@ -0,0 +1,249 @@
+package minecraft
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// GameMode is the game mode of a player, as numbered by the protocol.
+type GameMode byte
+
+const (
+	Survival GameMode = iota
+	Creative
+	Adventure
+	Spectator
+)
+
+var gameModeNames = []string{"survival", "creative", "adventure", "spectator"}
+
+func (self GameMode) String() string {
+	if int(self) >= len(gameModeNames) {
+		return "unknown"
+	}
+
+	return gameModeNames[self]
+}
+
+// title returns the name of the game mode as the messages of /gamemode
+// show it.
+func (self GameMode) title() string {
+	name := self.String()
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+// ParseGameMode returns the game mode named like the argument of /gamemode.
+func ParseGameMode(name string) (GameMode, error) {
+	i := slices.Index(gameModeNames, name)
+	if i < 0 {
+		return 0, fmt.Errorf("Unknown game mode %s", name)
+	}
+
+	return GameMode(i), nil
+}
+
+// Events of game_event
+const changeGameModeEvent byte = 3
+
+// Flags of player_abilities
+const (
+	invulnerableFlag byte = 0x01
+	flyingFlag       byte = 0x02
+	allowFlyingFlag  byte = 0x04
+	instantBreakFlag byte = 0x08
+)
+
+// Speeds of the players, as the vanilla server sets them
+const (
+	DefaultFlySpeed  float32 = 0.05
+	DefaultWalkSpeed float32 = 0.1
+)
+
+// Abilities are what a player may do, set by their game mode and sent to
+// the client, which enforces them.
+type Abilities struct {
+	Invulnerable bool
+	Flying       bool
+	AllowFlying  bool
+	// InstantBreak breaks the blocks at once, as in creative
+	InstantBreak bool
+	FlySpeed     float32
+	// WalkSpeed changes the field of view of the client, the speed itself
+	// coming from the movement_speed attribute
+	WalkSpeed float32
+}
+
+// DefaultAbilities returns the abilities of a player in mode.
+func DefaultAbilities(mode GameMode) Abilities {
+	return Abilities{FlySpeed: DefaultFlySpeed, WalkSpeed: DefaultWalkSpeed}.forMode(mode)
+}
+
+// forMode sets the abilities given by a game mode, keeping the speeds.
+// Players keep flying when they still may.
+func (self Abilities) forMode(mode GameMode) Abilities {
+	switch mode {
+	case Creative:
+		self.Invulnerable, self.AllowFlying, self.InstantBreak = true, true, true
+	case Spectator:
+		self.Invulnerable, self.AllowFlying, self.InstantBreak = true, true, false
+		self.Flying = true
+	default:
+		self.Invulnerable, self.AllowFlying, self.InstantBreak = false, false, false
+		self.Flying = false
+	}
+
+	return self
+}
+
+func (self Abilities) flags() byte {
+	var flags byte
+	if self.Invulnerable {
+		flags |= invulnerableFlag
+	}
+
+	if self.Flying {
+		flags |= flyingFlag
+	}
+
+	if self.AllowFlying {
+		flags |= allowFlyingFlag
+	}
+
+	if self.InstantBreak {
+		flags |= instantBreakFlag
+	}
+
+	return flags
+}
+
+func (self *client) gameMode() GameMode {
+	self.moveLock.Lock()
+	defer self.moveLock.Unlock()
+
+	return self.mode
+}
+
+// previousGameMode returns the game mode the player had before the current
+// one, -1 when it never changed.
+func (self *client) previousGameMode() int {
+	self.moveLock.Lock()
+	defer self.moveLock.Unlock()
+
+	return self.previousMode
+}
+
+// setGameMode changes the game mode of the player and the abilities coming
+// with it, telling the client and the tab list of the others.
+func (self *client) setGameMode(mode GameMode) error {
+	self.moveLock.Lock()
+	if self.mode != mode {
+		self.previousMode = int(self.mode)
+	}
+
+	self.mode = mode
+	self.abilities = self.abilities.forMode(mode)
+	self.moveLock.Unlock()
+
+	if self.state.Load() != Play {
+		return nil
+	}
+
+	// game_event
+	if err := self.send(0x22, changeGameModeEvent, float32(mode)); err != nil {
+		return err
+	}
+
+	if err := self.sendAbilities(); err != nil {
+		return err
+	}
+
+	self.server.inPlay(func(c *client) error {
+		return c.sendInfo(updateGameMode, []*client{self})
+	})
+
+	return nil
+}
+
+func (self *client) getAbilities() Abilities {
+	self.moveLock.Lock()
+	defer self.moveLock.Unlock()
+
+	return self.abilities
+}
+
+// setAbilities replaces the abilities of the player, until their game mode
+// changes.
+func (self *client) setAbilities(abilities Abilities) error {
+	self.moveLock.Lock()
+	self.abilities = abilities
+	self.moveLock.Unlock()
+
+	if self.state.Load() != Play {
+		return nil
+	}
+
+	return self.sendAbilities()
+}
+
+func (self *client) sendAbilities() error {
+	a := self.getAbilities()
+
+	// player_abilities
+	return self.send(0x39, a.flags(), a.FlySpeed, a.WalkSpeed)
+}
+
+func readPlayerAbilities(c *client, data []byte) error {
+	m, err := readFromBuffer(data,
+		factoryPair{"flags", byteFactory},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	flying := m["flags"].(byte)&flyingFlag != 0
+
+	c.moveLock.Lock()
+	allowed := c.abilities.AllowFlying
+	if allowed {
+		c.abilities.Flying = flying
+	}
+	c.moveLock.Unlock()
+
+	// Flying without the ability is stopped on the client
+	if flying && !allowed {
+		c.logger.Warn("Flew without the ability")
+		return c.sendAbilities()
+	}
+
+	return nil
+}
+
+// readChangeGameMode switches the game mode of the operators pressing F3+F4,
+// the others having no right to.
+func readChangeGameMode(c *client, data []byte) error {
+	m, err := readFromBuffer(data,
+		factoryPair{"gamemode", intFactory},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	mode := m["gamemode"].(int)
+	if mode < 0 || mode >= len(gameModeNames) {
+		return fmt.Errorf("Unknown game mode %d", mode)
+	}
+
+	if !c.isOperator() {
+		c.logger.Warn("Changed game mode without the permission")
+		return nil
+	}
+
+	if err := c.setGameMode(GameMode(mode)); err != nil {
+		return err
+	}
+
+	return c.sendSystem(fmt.Sprintf("Set own game mode to %s Mode", GameMode(mode).title()), false)
+}
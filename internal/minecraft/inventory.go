@@ -0,0 +1,760 @@
+package minecraft
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/beito123/nbt"
+)
+
+// Slots of the player inventory window. The crafting grid has no recipes,
+// so its result slot stays empty.
+const (
+	inventoryWindow = 0
+	inventorySize   = 46
+
+	craftResultSlot = 0
+	craftingSlot    = 1
+	helmetSlot      = 5
+	chestplateSlot  = 6
+	leggingsSlot    = 7
+	bootsSlot       = 8
+	mainSlot        = 9
+	hotbarSlot      = 36
+	offhandSlot     = 45
+
+	hotbarSize  = 9
+	outsideSlot = -999
+)
+
+// Modes of container_click
+const (
+	pickupClick = iota
+	quickMoveClick
+	swapClick
+	cloneClick
+	throwClick
+	quickCraftClick
+	pickupAllClick
+)
+
+// Stages and types of the drags, the quick_craft clicks
+const (
+	dragStart = 0
+	dragAdd   = 1
+	dragEnd   = 2
+
+	dragSplit = 0
+	dragOne   = 1
+	dragClone = 2
+)
+
+// Button of container_click swapping with the off hand
+const offhandButton = 40
+
+// Most slots a container_click may claim to change, as vanilla limits them
+const maxChangedSlots = 128
+
+// inventory holds the items of a player, in the slots of the inventory
+// window, and the item carried by the cursor.
+type inventory struct {
+	lock    sync.Mutex
+	slots   [inventorySize]ItemStack
+	carried ItemStack
+	// held is the selected slot of the hotbar
+	held int
+	// stateID is bumped every time the server sends slots, the client
+	// giving back the last one it got
+	stateID int
+
+	dragging  bool
+	dragType  int
+	dragSlots []int
+
+	// unread are the saved items the server couldn't read, such as items
+	// of a newer version, written back as they were until an item is saved
+	// in their slot
+	unread [inventorySize]nbt.Tag
+}
+
+// armorSlot returns the armor slot an item is worn in, zero for the items
+// which aren't worn.
+func armorSlot(item string) int {
+	switch {
+	case strings.HasSuffix(item, "_helmet"), strings.HasSuffix(item, "_head"),
+		strings.HasSuffix(item, "_skull"), item == "minecraft:carved_pumpkin":
+		return helmetSlot
+	case strings.HasSuffix(item, "_chestplate"), item == "minecraft:elytra":
+		return chestplateSlot
+	case strings.HasSuffix(item, "_leggings"):
+		return leggingsSlot
+	case strings.HasSuffix(item, "_boots"):
+		return bootsSlot
+	}
+
+	return 0
+}
+
+// accepts reports whether stack may be put in slot.
+func accepts(slot int, stack ItemStack) bool {
+	switch {
+	case slot == craftResultSlot:
+		return false
+	case slot >= helmetSlot && slot <= bootsSlot:
+		return stack.Empty() || armorSlot(stack.Item) == slot
+	}
+
+	return true
+}
+
+// slotLimit returns how many of stack fit in slot.
+func slotLimit(slot int, stack ItemStack) int {
+	if slot >= helmetSlot && slot <= bootsSlot {
+		return 1
+	}
+
+	return stack.MaxStackSize()
+}
+
+// moveInto puts stack in the slots, first on the stacks of the same item
+// then in the first empty slot, and returns what didn't fit.
+func (self *inventory) moveInto(stack ItemStack, slots []int) ItemStack {
+	for _, i := range slots {
+		if stack.Empty() {
+			return ItemStack{}
+		}
+
+		if !self.slots[i].stacksWith(stack) {
+			continue
+		}
+
+		moved := min(stack.Count, slotLimit(i, stack)-self.slots[i].Count)
+		if moved > 0 {
+			self.slots[i].Count += moved
+			stack = stack.withCount(stack.Count - moved)
+		}
+	}
+
+	for _, i := range slots {
+		if stack.Empty() {
+			return ItemStack{}
+		}
+
+		if self.slots[i].Empty() && accepts(i, stack) {
+			moved := min(stack.Count, slotLimit(i, stack))
+			self.slots[i] = stack.withCount(moved)
+			stack = stack.withCount(stack.Count - moved)
+		}
+	}
+
+	return stack
+}
+
+// span returns the slots from first to last included.
+func span(first int, last int) []int {
+	slots := make([]int, 0, last-first+1)
+	for i := first; i <= last; i++ {
+		slots = append(slots, i)
+	}
+
+	return slots
+}
+
+// storage lists the slots items are added to, the hotbar first.
+func storage() []int {
+	return append(span(hotbarSlot, hotbarSlot+hotbarSize-1), span(mainSlot, hotbarSlot-1)...)
+}
+
+// add puts stack in the hotbar and the main inventory, returning what
+// didn't fit.
+func (self *inventory) add(stack ItemStack) ItemStack {
+	return self.moveInto(stack, storage())
+}
+
+// quickMove moves the stack of a slot to the other part of the inventory,
+// as a shift click does.
+func (self *inventory) quickMove(slot int) {
+	stack := self.slots[slot]
+	if stack.Empty() || slot == craftResultSlot {
+		return
+	}
+
+	var targets []int
+	switch armor := armorSlot(stack.Item); {
+	case slot >= mainSlot && slot < offhandSlot && armor != 0 && self.slots[armor].Empty():
+		targets = []int{armor}
+	case slot >= mainSlot && slot < hotbarSlot:
+		targets = span(hotbarSlot, hotbarSlot+hotbarSize-1)
+	case slot >= hotbarSlot && slot < offhandSlot:
+		targets = span(mainSlot, hotbarSlot-1)
+	default:
+		targets = span(mainSlot, offhandSlot-1)
+	}
+
+	self.slots[slot] = ItemStack{}
+	self.slots[slot] = self.moveInto(stack, targets)
+}
+
+// pickup handles the left and right clicks on a slot.
+func (self *inventory) pickup(slot int, right bool) {
+	if slot == outsideSlot {
+		// Dropped items vanish, as the server has no item entities
+		if right {
+			self.carried = self.carried.withCount(self.carried.Count - 1)
+		} else {
+			self.carried = ItemStack{}
+		}
+
+		return
+	}
+
+	current, carried := self.slots[slot], self.carried
+
+	switch {
+	case current.Empty() && carried.Empty():
+	case carried.Empty():
+		if slot == craftResultSlot {
+			return
+		}
+
+		taken := current.Count
+		if right {
+			taken = (current.Count + 1) / 2
+		}
+
+		self.carried = current.withCount(taken)
+		self.slots[slot] = current.withCount(current.Count - taken)
+	case !accepts(slot, carried):
+	case current.Empty() || current.stacksWith(carried):
+		placed := carried.Count
+		if right {
+			placed = 1
+		}
+
+		placed = min(placed, slotLimit(slot, carried)-current.Count)
+		if placed <= 0 {
+			return
+		}
+
+		self.slots[slot] = carried.withCount(current.Count + placed)
+		self.carried = carried.withCount(carried.Count - placed)
+	case carried.Count <= slotLimit(slot, carried):
+		self.slots[slot], self.carried = carried, current
+	}
+}
+
+// swap exchanges a slot with one of the hotbar or the off hand, as the
+// number keys do.
+func (self *inventory) swap(slot int, button int) {
+	target := offhandSlot
+	if button >= 0 && button < hotbarSize {
+		target = hotbarSlot + button
+	} else if button != offhandButton {
+		return
+	}
+
+	a, b := self.slots[slot], self.slots[target]
+	if slot == target || !accepts(slot, b) || !accepts(target, a) {
+		return
+	}
+
+	self.slots[slot], self.slots[target] = b, a
+}
+
+// pickupAll gathers the items like the carried one onto the cursor, as a
+// double click does. The partial stacks go first.
+func (self *inventory) pickupAll(slot int, button int) {
+	carried := self.carried
+	if carried.Empty() || (!self.slots[slot].Empty() && slot != craftResultSlot) {
+		return
+	}
+
+	order := span(craftingSlot, offhandSlot)
+	if button != 0 {
+		slices.Reverse(order)
+	}
+
+	for _, full := range []bool{false, true} {
+		for _, i := range order {
+			limit := carried.MaxStackSize()
+			if carried.Count >= limit {
+				break
+			}
+
+			current := self.slots[i]
+			if !current.stacksWith(carried) || (current.Count >= current.MaxStackSize()) != full {
+				continue
+			}
+
+			taken := min(current.Count, limit-carried.Count)
+			carried = carried.withCount(carried.Count + taken)
+			self.slots[i] = current.withCount(current.Count - taken)
+		}
+	}
+
+	self.carried = carried
+}
+
+// drag handles the quick_craft clicks, which spread the carried item over
+// the slots the cursor goes through.
+func (self *inventory) drag(slot int, button int, creative bool) {
+	stage, kind := button&3, (button>>2)&3
+
+	switch stage {
+	case dragStart:
+		self.dragging = !self.carried.Empty() && (kind != dragClone || creative)
+		self.dragType = kind
+		self.dragSlots = nil
+	case dragAdd:
+		carried := self.carried
+		if !self.dragging || kind != self.dragType || slot < 0 || slot >= inventorySize {
+			return
+		}
+
+		current := self.slots[slot]
+		fits := current.Empty() || (current.stacksWith(carried) && current.Count < slotLimit(slot, carried))
+		enough := kind == dragClone || carried.Count > len(self.dragSlots)
+
+		if fits && accepts(slot, carried) && enough && !slices.Contains(self.dragSlots, slot) {
+			self.dragSlots = append(self.dragSlots, slot)
+		}
+	case dragEnd:
+		slots, dragging := self.dragSlots, self.dragging
+		self.dragging = false
+		self.dragSlots = nil
+
+		if !dragging || kind != self.dragType || len(slots) == 0 {
+			return
+		}
+
+		if len(slots) == 1 && kind != dragClone {
+			self.pickup(slots[0], kind == dragOne)
+			return
+		}
+
+		carried := self.carried
+		left := carried.Count
+		for _, i := range slots {
+			share := 1
+			switch kind {
+			case dragSplit:
+				share = carried.Count / len(slots)
+			case dragClone:
+				share = carried.MaxStackSize()
+			}
+
+			current := self.slots[i]
+			count := min(current.Count+share, slotLimit(i, carried))
+			if kind != dragClone {
+				left -= count - current.Count
+			}
+
+			self.slots[i] = carried.withCount(count)
+		}
+
+		self.carried = carried.withCount(left)
+	}
+}
+
+// click applies a click of the player on the inventory window.
+func (self *inventory) click(slot int, button int, mode int, creative bool) error {
+	valid := slot >= 0 && slot < inventorySize
+	if !valid && slot != outsideSlot && mode != quickCraftClick {
+		return fmt.Errorf("Invalid slot %d", slot)
+	}
+
+	// A drag only goes on with quick_craft clicks
+	if mode != quickCraftClick {
+		self.dragging = false
+		self.dragSlots = nil
+	}
+
+	switch mode {
+	case pickupClick:
+		if button == 0 || button == 1 {
+			self.pickup(slot, button == 1)
+		}
+	case quickMoveClick:
+		if valid {
+			self.quickMove(slot)
+		}
+	case swapClick:
+		if valid {
+			self.swap(slot, button)
+		}
+	case cloneClick:
+		if valid && creative && self.carried.Empty() && !self.slots[slot].Empty() {
+			stack := self.slots[slot]
+			self.carried = stack.withCount(stack.MaxStackSize())
+		}
+	case throwClick:
+		if valid && self.carried.Empty() && slot != craftResultSlot {
+			// Thrown items vanish, as the server has no item entities
+			current := self.slots[slot]
+			if button == 0 {
+				self.slots[slot] = current.withCount(current.Count - 1)
+			} else {
+				self.slots[slot] = ItemStack{}
+			}
+		}
+	case quickCraftClick:
+		self.drag(slot, button, creative)
+	case pickupAllClick:
+		if valid {
+			self.pickupAll(slot, button)
+		}
+	default:
+		return fmt.Errorf("Invalid click mode %d", mode)
+	}
+
+	return nil
+}
+
+// sendInventory sends every slot of the inventory and the carried item.
+func (self *client) sendInventory() error {
+	self.inventory.lock.Lock()
+	defer self.inventory.lock.Unlock()
+
+	return self.sendInventoryLocked()
+}
+
+func (self *client) sendInventoryLocked() error {
+	inv := self.inventory
+	inv.stateID += 1
+
+	contents := []any{inventoryWindow, inv.stateID, inventorySize}
+	for _, stack := range append(inv.slots[:], inv.carried) {
+		data, err := encodeSlot(stack)
+		if err != nil {
+			return err
+		}
+
+		contents = append(contents, raw(data))
+	}
+
+	// container_set_content
+	return self.send(0x12, contents...)
+}
+
+// sendSlotLocked sends one slot of the inventory, the lock being held.
+func (self *client) sendSlotLocked(slot int) error {
+	inv := self.inventory
+	inv.stateID += 1
+
+	data, err := encodeSlot(inv.slots[slot])
+	if err != nil {
+		return err
+	}
+
+	// container_set_slot
+	return self.send(0x14, inventoryWindow, inv.stateID, int16(slot), raw(data))
+}
+
+// sendHeldSlot selects a slot of the hotbar on the client.
+func (self *client) sendHeldSlot() error {
+	self.inventory.lock.Lock()
+	held := self.inventory.held
+	self.inventory.lock.Unlock()
+
+	// set_held_slot
+	return self.send(0x62, held)
+}
+
+func readContainerClick(c *client, data []byte) error {
+	m, err := readFromBuffer(data,
+		factoryPair{"window", intFactory},
+		factoryPair{"state", intFactory},
+		factoryPair{"slot", shortFactory},
+		factoryPair{"button", byteFactory},
+		factoryPair{"mode", intFactory},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	// Other windows aren't opened by the server
+	if m["window"].(int) != inventoryWindow {
+		return nil
+	}
+
+	// The header takes at most 5 + 5 + 2 + 1 + 5 bytes, skip it
+	rest := data
+	for _, f := range []factory{intFactory, intFactory, shortFactory, byteFactory, intFactory} {
+		rest, _, _ = f(rest)
+	}
+
+	rest, changes, err := varInts(rest, 1)
+	if err != nil {
+		return err
+	}
+
+	if err := checkCount(rest, changes[0], maxChangedSlots); err != nil {
+		return err
+	}
+
+	// The slots the client changed on its own, as it predicts the click
+	claimed := make(map[int]hashedSlot)
+	for range changes[0] {
+		next, slot, err := shortFactory(rest)
+		if err != nil {
+			return err
+		}
+
+		next, hashed, err := hashedSlotFactory(next)
+		if err != nil {
+			return err
+		}
+
+		claimed[slot.(int)] = hashed.(hashedSlot)
+		rest = next
+	}
+
+	_, carried, err := hashedSlotFactory(rest)
+	if err != nil {
+		return err
+	}
+
+	inv := c.inventory
+	inv.lock.Lock()
+	defer inv.lock.Unlock()
+
+	// Spectators can't move items
+	if c.gameMode() == Spectator {
+		return c.sendInventoryLocked()
+	}
+
+	before := inv.slots
+	outdated := m["state"].(int) != inv.stateID
+
+	if err := inv.click(m["slot"].(int), int(m["button"].(byte)), m["mode"].(int), c.gameMode() == Creative); err != nil {
+		return err
+	}
+
+	// The client was told of changes it hadn't seen when it clicked, or
+	// it guessed the cursor wrong: everything is sent again
+	if outdated || !carried.(hashedSlot).matches(inv.carried) {
+		return c.sendInventoryLocked()
+	}
+
+	for i, stack := range inv.slots {
+		guess, ok := claimed[i]
+
+		var right bool
+		if ok {
+			right = guess.matches(stack)
+		} else {
+			right = before[i].Count == stack.Count && (stack.Empty() || before[i].stacksWith(stack))
+		}
+
+		if !right {
+			if err := c.sendSlotLocked(i); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// readContainerClose puts back in the inventory the items left in the
+// crafting grid and on the cursor.
+func readContainerClose(c *client, data []byte) error {
+	m, err := readFromBuffer(data,
+		factoryPair{"window", intFactory},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	if m["window"].(int) != inventoryWindow {
+		return nil
+	}
+
+	c.inventory.lock.Lock()
+	defer c.inventory.lock.Unlock()
+
+	c.inventory.putBack()
+	return c.sendInventoryLocked()
+}
+
+// putBack moves the items of the crafting grid and the cursor to the
+// inventory, as when it is closed.
+func (self *inventory) putBack() {
+	leftovers := []ItemStack{self.carried}
+	for i := craftingSlot; i < helmetSlot; i++ {
+		leftovers = append(leftovers, self.slots[i])
+		self.slots[i] = ItemStack{}
+	}
+
+	self.carried = ItemStack{}
+	self.dragging = false
+	self.dragSlots = nil
+
+	// What doesn't fit is dropped, and vanishes
+	for _, stack := range leftovers {
+		self.add(stack)
+	}
+}
+
+func readSetCarriedItem(c *client, data []byte) error {
+	m, err := readFromBuffer(data,
+		factoryPair{"slot", shortFactory},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	slot := m["slot"].(int)
+	if slot < 0 || slot >= hotbarSize {
+		c.logger.Warn("Invalid held slot", "slot", slot)
+		return nil
+	}
+
+	c.inventory.lock.Lock()
+	c.inventory.held = slot
+	c.inventory.lock.Unlock()
+
+	return nil
+}
+
+func readSetCreativeModeSlot(c *client, data []byte) error {
+	m, err := readFromBuffer(data,
+		factoryPair{"slot", shortFactory},
+		factoryPair{"stack", untrustedSlotFactory},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	if c.gameMode() != Creative {
+		return nil
+	}
+
+	slot, stack := m["slot"].(int), m["stack"].(ItemStack)
+
+	// Items dropped from the creative menu vanish
+	if slot == -1 {
+		return nil
+	}
+
+	if slot < craftingSlot || slot >= inventorySize || stack.Count > stack.MaxStackSize() {
+		c.logger.Warn("Invalid creative slot", "slot", slot, "count", stack.Count)
+		return nil
+	}
+
+	c.inventory.lock.Lock()
+	c.inventory.slots[slot] = stack
+	c.inventory.lock.Unlock()
+
+	return nil
+}
+
+// Hands of use_item_on
+const (
+	mainHand = 0
+	offHand  = 1
+)
+
+// handSlot returns the inventory slot of a hand, the lock being held.
+func (self *inventory) handSlot(hand int) int {
+	if hand == offHand {
+		return offhandSlot
+	}
+
+	return hotbarSlot + self.held
+}
+
+// handItem returns the name of the item in a hand of the player, empty
+// when the hand is.
+func (self *client) handItem(hand int) string {
+	self.inventory.lock.Lock()
+	defer self.inventory.lock.Unlock()
+
+	stack := self.inventory.slots[self.inventory.handSlot(hand)]
+	if stack.Empty() {
+		return ""
+	}
+
+	return stack.Item
+}
+
+// heldItem returns the name of the item in the main hand of the player.
+func (self *client) heldItem() string {
+	return self.handItem(mainHand)
+}
+
+// useItem takes one item from a hand of a player in survival, after
+// placing a block. The client already took it on its side.
+func (self *client) useItem(hand int) {
+	if self.gameMode() == Creative {
+		return
+	}
+
+	self.inventory.lock.Lock()
+	defer self.inventory.lock.Unlock()
+
+	slot := self.inventory.handSlot(hand)
+	stack := self.inventory.slots[slot]
+	self.inventory.slots[slot] = stack.withCount(stack.Count - 1)
+}
+
+// give adds a stack to the inventory, returning how many items didn't fit.
+func (self *client) give(stack ItemStack) (int, error) {
+	inv := self.inventory
+	inv.lock.Lock()
+	defer inv.lock.Unlock()
+
+	before := inv.slots
+	rest := inv.add(stack)
+
+	if self.state.Load() == Play {
+		for i := range inv.slots {
+			if before[i].Count != inv.slots[i].Count || before[i].Item != inv.slots[i].Item {
+				if err := self.sendSlotLocked(i); err != nil {
+					return rest.Count, err
+				}
+			}
+		}
+	}
+
+	if rest.Empty() {
+		return 0, nil
+	}
+
+	return rest.Count, nil
+}
+
+// item returns the stack in a slot of the inventory window.
+func (self *client) item(slot int) (ItemStack, error) {
+	if slot < 0 || slot >= inventorySize {
+		return ItemStack{}, fmt.Errorf("Invalid slot %d", slot)
+	}
+
+	self.inventory.lock.Lock()
+	defer self.inventory.lock.Unlock()
+
+	return self.inventory.slots[slot], nil
+}
+
+// setItem replaces the stack in a slot of the inventory window.
+func (self *client) setItem(slot int, stack ItemStack) error {
+	if slot < 0 || slot >= inventorySize {
+		return fmt.Errorf("Invalid slot %d", slot)
+	}
+
+	self.inventory.lock.Lock()
+	defer self.inventory.lock.Unlock()
+
+	self.inventory.slots[slot] = stack
+	if self.state.Load() != Play {
+		return nil
+	}
+
+	return self.sendSlotLocked(slot)
+}
@@ -0,0 +1,292 @@
+package minecraft
+
+import (
+	"slices"
+	"testing"
+)
+
+func stackOf(item string, count int) ItemStack {
+	return NewItemStack("minecraft:"+item, count)
+}
+
+func expectStack(t *testing.T, what string, got ItemStack, item string, count int) {
+	t.Helper()
+
+	if count == 0 {
+		if !got.Empty() {
+			t.Fatalf("%s holds %d %s instead of nothing", what, got.Count, got.Item)
+		}
+
+		return
+	}
+
+	if got.Item != "minecraft:"+item || got.Count != count {
+		t.Fatalf("%s holds %d %s instead of %d %s", what, got.Count, got.Item, count, item)
+	}
+}
+
+func mustClick(t *testing.T, inv *inventory, slot int, button int, mode int, creative bool) {
+	t.Helper()
+
+	if err := inv.click(slot, button, mode, creative); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPickupClick(t *testing.T) {
+	inv := &inventory{}
+	inv.slots[hotbarSlot] = stackOf("stone", 10)
+
+	// Left click takes the whole stack, right click puts one back
+	mustClick(t, inv, hotbarSlot, 0, pickupClick, false)
+	expectStack(t, "cursor", inv.carried, "stone", 10)
+	expectStack(t, "hotbar", inv.slots[hotbarSlot], "", 0)
+
+	mustClick(t, inv, mainSlot, 1, pickupClick, false)
+	expectStack(t, "cursor", inv.carried, "stone", 9)
+	expectStack(t, "main slot", inv.slots[mainSlot], "stone", 1)
+
+	mustClick(t, inv, mainSlot, 0, pickupClick, false)
+	expectStack(t, "cursor", inv.carried, "", 0)
+	expectStack(t, "main slot", inv.slots[mainSlot], "stone", 10)
+
+	// Right click takes half, rounded up
+	inv.slots[mainSlot] = stackOf("stone", 7)
+	mustClick(t, inv, mainSlot, 1, pickupClick, false)
+	expectStack(t, "cursor", inv.carried, "stone", 4)
+	expectStack(t, "main slot", inv.slots[mainSlot], "stone", 3)
+
+	// Different items are swapped
+	inv.slots[mainSlot+1] = stackOf("dirt", 2)
+	mustClick(t, inv, mainSlot+1, 0, pickupClick, false)
+	expectStack(t, "cursor", inv.carried, "dirt", 2)
+	expectStack(t, "swapped slot", inv.slots[mainSlot+1], "stone", 4)
+
+	// Only armor goes in the armor slots
+	mustClick(t, inv, helmetSlot, 0, pickupClick, false)
+	expectStack(t, "helmet", inv.slots[helmetSlot], "", 0)
+	expectStack(t, "cursor", inv.carried, "dirt", 2)
+
+	// Clicking outside drops one or all of the cursor
+	mustClick(t, inv, outsideSlot, 1, pickupClick, false)
+	expectStack(t, "cursor", inv.carried, "dirt", 1)
+
+	mustClick(t, inv, outsideSlot, 0, pickupClick, false)
+	expectStack(t, "cursor", inv.carried, "", 0)
+}
+
+func TestPickupClickFullSlot(t *testing.T) {
+	inv := &inventory{}
+	inv.slots[mainSlot] = stackOf("stone", 60)
+	inv.carried = stackOf("stone", 10)
+
+	mustClick(t, inv, mainSlot, 0, pickupClick, false)
+	expectStack(t, "main slot", inv.slots[mainSlot], "stone", 64)
+	expectStack(t, "cursor", inv.carried, "stone", 6)
+
+	// The result of the crafting grid can't be filled
+	mustClick(t, inv, craftResultSlot, 0, pickupClick, false)
+	expectStack(t, "crafting result", inv.slots[craftResultSlot], "", 0)
+}
+
+func TestQuickMoveClick(t *testing.T) {
+	inv := &inventory{}
+	inv.slots[mainSlot] = stackOf("stone", 10)
+	inv.slots[hotbarSlot+2] = stackOf("stone", 60)
+
+	// Shift clicking the main inventory fills the hotbar, stacks first
+	mustClick(t, inv, mainSlot, 0, quickMoveClick, false)
+	expectStack(t, "main slot", inv.slots[mainSlot], "", 0)
+	expectStack(t, "partial hotbar slot", inv.slots[hotbarSlot+2], "stone", 64)
+	expectStack(t, "first hotbar slot", inv.slots[hotbarSlot], "stone", 6)
+
+	// And the hotbar goes back to the main inventory
+	mustClick(t, inv, hotbarSlot, 0, quickMoveClick, false)
+	expectStack(t, "hotbar slot", inv.slots[hotbarSlot], "", 0)
+	expectStack(t, "main slot", inv.slots[mainSlot], "stone", 6)
+
+	// Armor is worn when its slot is free
+	inv.slots[mainSlot+1] = stackOf("iron_helmet", 1)
+	mustClick(t, inv, mainSlot+1, 0, quickMoveClick, false)
+	expectStack(t, "helmet", inv.slots[helmetSlot], "iron_helmet", 1)
+}
+
+func TestSwapClick(t *testing.T) {
+	inv := &inventory{}
+	inv.slots[mainSlot] = stackOf("stone", 3)
+	inv.slots[hotbarSlot+4] = stackOf("dirt", 5)
+
+	mustClick(t, inv, mainSlot, 4, swapClick, false)
+	expectStack(t, "main slot", inv.slots[mainSlot], "dirt", 5)
+	expectStack(t, "hotbar", inv.slots[hotbarSlot+4], "stone", 3)
+
+	mustClick(t, inv, mainSlot, offhandButton, swapClick, false)
+	expectStack(t, "off hand", inv.slots[offhandSlot], "dirt", 5)
+	expectStack(t, "main slot", inv.slots[mainSlot], "", 0)
+
+	// Items that can't be worn stay out of the armor slots
+	mustClick(t, inv, bootsSlot, 4, swapClick, false)
+	expectStack(t, "boots", inv.slots[bootsSlot], "", 0)
+	expectStack(t, "hotbar", inv.slots[hotbarSlot+4], "stone", 3)
+}
+
+func TestCloneClick(t *testing.T) {
+	inv := &inventory{}
+	inv.slots[mainSlot] = stackOf("stone", 1)
+
+	mustClick(t, inv, mainSlot, 2, cloneClick, false)
+	expectStack(t, "cursor", inv.carried, "", 0)
+
+	mustClick(t, inv, mainSlot, 2, cloneClick, true)
+	expectStack(t, "cursor", inv.carried, "stone", 64)
+	expectStack(t, "main slot", inv.slots[mainSlot], "stone", 1)
+}
+
+func TestThrowClick(t *testing.T) {
+	inv := &inventory{}
+	inv.slots[mainSlot] = stackOf("stone", 5)
+
+	mustClick(t, inv, mainSlot, 0, throwClick, false)
+	expectStack(t, "main slot", inv.slots[mainSlot], "stone", 4)
+
+	mustClick(t, inv, mainSlot, 1, throwClick, false)
+	expectStack(t, "main slot", inv.slots[mainSlot], "", 0)
+}
+
+// dragClicks spreads the cursor over slots as a quick_craft of kind.
+func dragClicks(t *testing.T, inv *inventory, kind int, creative bool, slots ...int) {
+	t.Helper()
+
+	mustClick(t, inv, outsideSlot, dragStart|kind<<2, quickCraftClick, creative)
+	for _, slot := range slots {
+		mustClick(t, inv, slot, dragAdd|kind<<2, quickCraftClick, creative)
+	}
+	mustClick(t, inv, outsideSlot, dragEnd|kind<<2, quickCraftClick, creative)
+}
+
+func TestQuickCraftClick(t *testing.T) {
+	inv := &inventory{}
+	inv.carried = stackOf("stone", 10)
+	inv.slots[mainSlot+2] = stackOf("stone", 1)
+
+	// The cursor is split evenly, the rest staying on it
+	dragClicks(t, inv, dragSplit, false, mainSlot, mainSlot+1, mainSlot+2)
+	expectStack(t, "first slot", inv.slots[mainSlot], "stone", 3)
+	expectStack(t, "second slot", inv.slots[mainSlot+1], "stone", 3)
+	expectStack(t, "slot already holding stone", inv.slots[mainSlot+2], "stone", 4)
+	expectStack(t, "cursor", inv.carried, "stone", 1)
+
+	inv.carried = stackOf("dirt", 2)
+	dragClicks(t, inv, dragOne, false, hotbarSlot, hotbarSlot+1, hotbarSlot+2)
+	expectStack(t, "first hotbar slot", inv.slots[hotbarSlot], "dirt", 1)
+	expectStack(t, "second hotbar slot", inv.slots[hotbarSlot+1], "dirt", 1)
+	expectStack(t, "hotbar slot past the cursor", inv.slots[hotbarSlot+2], "", 0)
+	expectStack(t, "cursor", inv.carried, "", 0)
+
+	// Cloning by dragging is for creative players
+	inv.carried = stackOf("sand", 1)
+	dragClicks(t, inv, dragClone, false, hotbarSlot+3, hotbarSlot+4)
+	expectStack(t, "hotbar slot", inv.slots[hotbarSlot+3], "", 0)
+
+	dragClicks(t, inv, dragClone, true, hotbarSlot+3, hotbarSlot+4)
+	expectStack(t, "first cloned slot", inv.slots[hotbarSlot+3], "sand", 64)
+	expectStack(t, "second cloned slot", inv.slots[hotbarSlot+4], "sand", 64)
+	expectStack(t, "cursor", inv.carried, "sand", 1)
+
+	// Another click ends the drag
+	inv.carried = stackOf("sand", 4)
+	mustClick(t, inv, outsideSlot, dragStart, quickCraftClick, false)
+	mustClick(t, inv, mainSlot+5, dragAdd, quickCraftClick, false)
+	mustClick(t, inv, mainSlot+6, 0, pickupClick, false)
+	mustClick(t, inv, outsideSlot, dragEnd, quickCraftClick, false)
+	expectStack(t, "dragged slot", inv.slots[mainSlot+5], "", 0)
+}
+
+func TestPickupAllClick(t *testing.T) {
+	inv := &inventory{}
+	inv.carried = stackOf("stone", 1)
+	inv.slots[mainSlot] = stackOf("stone", 64)
+	inv.slots[mainSlot+1] = stackOf("stone", 5)
+	inv.slots[hotbarSlot] = stackOf("stone", 3)
+	inv.slots[hotbarSlot+1] = stackOf("dirt", 3)
+
+	// The partial stacks are emptied before the full ones
+	mustClick(t, inv, mainSlot+2, 0, pickupAllClick, false)
+	expectStack(t, "cursor", inv.carried, "stone", 64)
+	expectStack(t, "partial slot", inv.slots[mainSlot+1], "", 0)
+	expectStack(t, "partial hotbar slot", inv.slots[hotbarSlot], "", 0)
+	expectStack(t, "full slot", inv.slots[mainSlot], "stone", 9)
+	expectStack(t, "other item", inv.slots[hotbarSlot+1], "dirt", 3)
+}
+
+func TestInvalidClicks(t *testing.T) {
+	inv := &inventory{}
+
+	if err := inv.click(inventorySize, 0, pickupClick, false); err == nil {
+		t.Fatal("Accepted a slot out of the window")
+	}
+
+	if err := inv.click(mainSlot, 0, pickupAllClick+1, false); err == nil {
+		t.Fatal("Accepted an unknown mode")
+	}
+}
+
+func TestOversizedClick(t *testing.T) {
+	header := append(writeVarInt(inventoryWindow), writeVarInt(0)...)
+	header = append(header, 0, mainSlot, 0)
+	header = append(header, writeVarInt(pickupClick)...)
+
+	// A count of changed slots no packet could hold is refused before
+	// anything is allocated
+	packets := [][]byte{
+		append(slices.Clone(header), writeVarInt(1<<30)...),
+		// -1, the client's varints being signed
+		append(slices.Clone(header), 0xff, 0xff, 0xff, 0xff, 0x0f),
+		append(slices.Clone(header), writeVarInt(maxChangedSlots+1)...),
+	}
+
+	for _, data := range packets {
+		if err := readContainerClick(&client{}, data); err == nil {
+			t.Fatalf("Accepted %v", data)
+		}
+	}
+
+	// The same goes for the components of a slot
+	slot := append([]byte{1}, writeVarInt(1)...)
+	slot = append(slot, writeVarInt(1)...)
+	slot = append(slot, writeVarInt(1<<30)...)
+
+	if _, _, err := hashedSlotFactory(slot); err == nil {
+		t.Fatal("Accepted a hashed slot with too many components")
+	}
+
+	if _, _, err := untrustedSlotFactory(slot[1:]); err == nil {
+		t.Fatal("Accepted a creative slot with too many components")
+	}
+
+	if _, _, err := nbtListFactory(writeVarInt(maxLoreLines + 1)); err == nil {
+		t.Fatal("Accepted too many lore lines")
+	}
+}
+
+func TestMaxStackSize(t *testing.T) {
+	sizes := map[string]int{
+		"stone": 64, "pig_spawn_egg": 64, "dragon_egg": 64, "egg": 16, "oak_sign": 16,
+		"shulker_box": 1, "saddle": 1, "music_disc_cat": 1, "bundle": 1, "mace": 1,
+	}
+
+	for item, size := range sizes {
+		if got := stackOf(item, 1).MaxStackSize(); got != size {
+			t.Fatalf("%s stacks to %d instead of %d", item, got, size)
+		}
+	}
+
+	// Shift clicking a shulker box doesn't stack it with another
+	inv := &inventory{}
+	inv.slots[mainSlot] = stackOf("shulker_box", 1)
+	inv.slots[hotbarSlot] = stackOf("shulker_box", 1)
+
+	mustClick(t, inv, mainSlot, 0, quickMoveClick, false)
+	expectStack(t, "first hotbar slot", inv.slots[hotbarSlot], "shulker_box", 1)
+	expectStack(t, "second hotbar slot", inv.slots[hotbarSlot+1], "shulker_box", 1)
+}
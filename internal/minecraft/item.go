@@ -0,0 +1,701 @@
+package minecraft
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/beito123/nbt"
+	"github.com/keyboard-slayer/minecraft-server/internal/world"
+)
+
+// Most elements the client may send in the arrays of a slot, as vanilla
+// limits them
+const (
+	maxComponents = 256
+	maxLoreLines  = 256
+)
+
+// ItemStack is a stack of items along with the data components changing
+// the defaults of the item.
+type ItemStack struct {
+	Item  string
+	Count int
+	// Components are the data components added or replaced, by name. The
+	// known ones hold Go values, see componentCodecs, the others the bytes
+	// sent by the client.
+	Components map[string]any
+	// Removed are the default components taken away from the item
+	Removed []string
+	// kept are the components of a saved stack the server can't send to
+	// the clients, written back as they were read
+	kept map[string]nbt.Tag
+}
+
+// NewItemStack returns count items without components.
+func NewItemStack(item string, count int) ItemStack {
+	return ItemStack{Item: item, Count: count}
+}
+
+// Empty reports whether the stack holds nothing.
+func (self ItemStack) Empty() bool {
+	return self.Item == "" || self.Item == "minecraft:air" || self.Count <= 0
+}
+
+// MaxStackSize returns how many of the item fit in one slot.
+func (self ItemStack) MaxStackSize() int {
+	if size, ok := self.Components["minecraft:max_stack_size"].(int); ok {
+		return size
+	}
+
+	if _, ok := self.Components["minecraft:max_damage"]; ok {
+		return 1
+	}
+
+	return world.MaxStackSize(self.Item)
+}
+
+// withCount returns a copy of the stack holding count items.
+func (self ItemStack) withCount(count int) ItemStack {
+	if count <= 0 {
+		return ItemStack{}
+	}
+
+	self.Count = count
+	self.Components = maps.Clone(self.Components)
+	self.Removed = slices.Clone(self.Removed)
+	self.kept = maps.Clone(self.kept)
+
+	return self
+}
+
+// stacksWith reports whether both stacks hold the same item with the same
+// components, so they can be merged.
+func (self ItemStack) stacksWith(other ItemStack) bool {
+	if self.Empty() || other.Empty() || self.Item != other.Item {
+		return false
+	}
+
+	a, errA := encodeSlot(self.withCount(1))
+	b, errB := encodeSlot(other.withCount(1))
+
+	return errA == nil && errB == nil && bytes.Equal(a, b) && reflect.DeepEqual(self.kept, other.kept)
+}
+
+// nbtFactory reads a network NBT tag, nameless, nil for an end tag.
+func nbtFactory(buffer []byte) ([]byte, any, error) {
+	if len(buffer) == 0 {
+		return []byte{}, nil, errors.New("unexpected end of buffer while reading nbt")
+	}
+
+	if buffer[0] == nbt.IDTagEnd {
+		return buffer[1:], nil, nil
+	}
+
+	// The nbt package reads the name the network format leaves out
+	named := append([]byte{buffer[0], 0, 0}, buffer[1:]...)
+	stream, err := nbt.FromBytes(named, nbt.BigEndian)
+	if err != nil {
+		return []byte{}, nil, err
+	}
+
+	tag, err := stream.ReadTag()
+	if err != nil {
+		return []byte{}, nil, err
+	}
+
+	return buffer[stream.Stream.Off()-2:], tag, nil
+}
+
+func unitFactory(buffer []byte) ([]byte, any, error) {
+	return buffer, true, nil
+}
+
+func nbtListFactory(buffer []byte) ([]byte, any, error) {
+	length, sz, err := readVarIntFromBuff(buffer)
+	if err != nil {
+		return []byte{}, nil, err
+	}
+
+	buffer = buffer[sz:]
+
+	if err := checkCount(buffer, length, maxLoreLines); err != nil {
+		return []byte{}, nil, err
+	}
+
+	tags := make([]nbt.Tag, 0, length)
+	for range length {
+		rest, tag, err := nbtFactory(buffer)
+		if err != nil {
+			return []byte{}, nil, err
+		}
+
+		if tag == nil {
+			return []byte{}, nil, errors.New("Empty tag in a list")
+		}
+
+		tags = append(tags, tag.(nbt.Tag))
+		buffer = rest
+	}
+
+	return buffer, tags, nil
+}
+
+func writeUnit(v any) ([]byte, error) {
+	return []byte{}, nil
+}
+
+func writeValue(v any) ([]byte, error) {
+	return marshal(v)
+}
+
+func writeNBTList(v any) ([]byte, error) {
+	tags := v.([]nbt.Tag)
+
+	contents := []any{len(tags)}
+	for _, tag := range tags {
+		contents = append(contents, tag)
+	}
+
+	return marshal(contents...)
+}
+
+// componentCodec reads and writes the value of a data component, on the
+// network and in the saved NBT.
+type componentCodec struct {
+	read  factory
+	write func(v any) ([]byte, error)
+	save  func(v any) nbt.Tag
+	load  func(tag nbt.Tag) (any, error)
+}
+
+var intCodec = componentCodec{
+	read:  intFactory,
+	write: writeValue,
+	save: func(v any) nbt.Tag {
+		return nbt.NewIntTag("", int32(v.(int)))
+	},
+	load: func(tag nbt.Tag) (any, error) {
+		return tag.ToInt()
+	},
+}
+
+var nbtCodec = componentCodec{
+	read:  nbtFactory,
+	write: writeValue,
+	save: func(v any) nbt.Tag {
+		return v.(nbt.Tag)
+	},
+	load: func(tag nbt.Tag) (any, error) {
+		return tag, nil
+	},
+}
+
+var unitCodec = componentCodec{
+	read:  unitFactory,
+	write: writeUnit,
+	save: func(v any) nbt.Tag {
+		return nbt.NewCompoundTag("", map[string]nbt.Tag{})
+	},
+	load: func(tag nbt.Tag) (any, error) {
+		return true, nil
+	},
+}
+
+var boolCodec = componentCodec{
+	read:  boolFactory,
+	write: writeValue,
+	save: func(v any) nbt.Tag {
+		if v.(bool) {
+			return nbt.NewByteTag("", 1)
+		}
+
+		return nbt.NewByteTag("", 0)
+	},
+	load: func(tag nbt.Tag) (any, error) {
+		v, err := tag.ToInt()
+		return v != 0, err
+	},
+}
+
+var loreCodec = componentCodec{
+	read:  nbtListFactory,
+	write: writeNBTList,
+	save: func(v any) nbt.Tag {
+		lines := v.([]nbt.Tag)
+		if len(lines) == 0 {
+			return nbt.NewListTag("", lines, nbt.IDTagEnd)
+		}
+
+		// The lines of a list share a type, plain strings becoming text
+		// compounds when mixed with compounds
+		kind := lines[0].ID()
+		if slices.ContainsFunc(lines, func(line nbt.Tag) bool { return line.ID() != kind }) {
+			kind = nbt.IDTagCompound
+			lines = slices.Clone(lines)
+
+			for i, line := range lines {
+				if line.ID() != nbt.IDTagCompound {
+					lines[i] = nbt.NewCompoundTag("", map[string]nbt.Tag{"text": line})
+				}
+			}
+		}
+
+		return nbt.NewListTag("", lines, kind)
+	},
+	load: func(tag nbt.Tag) (any, error) {
+		list, ok := tag.(*nbt.List)
+		if !ok {
+			return nil, errors.New("Lore isn't a list")
+		}
+
+		return slices.Clone(list.Value), nil
+	},
+}
+
+// Rarities of the items, in the order of their IDs
+var rarities = []string{"common", "uncommon", "rare", "epic"}
+
+var rarityCodec = componentCodec{
+	read:  intFactory,
+	write: writeValue,
+	save: func(v any) nbt.Tag {
+		rarity := v.(int)
+		if rarity < 0 || rarity >= len(rarities) {
+			rarity = 0
+		}
+
+		return nbt.NewStringTag("", rarities[rarity])
+	},
+	load: func(tag nbt.Tag) (any, error) {
+		name, err := tag.ToString()
+		if err != nil {
+			return nil, err
+		}
+
+		i := slices.Index(rarities, name)
+		if i < 0 {
+			return nil, fmt.Errorf("Unknown rarity %s", name)
+		}
+
+		return i, nil
+	},
+}
+
+// componentCodecs are the data components the server understands. The
+// values are an int, true for the components without data, an nbt.Tag for
+// the custom data and the text components, or a []nbt.Tag for the lore.
+var componentCodecs = map[string]componentCodec{
+	"minecraft:custom_data":                nbtCodec,
+	"minecraft:max_stack_size":             intCodec,
+	"minecraft:max_damage":                 intCodec,
+	"minecraft:damage":                     intCodec,
+	"minecraft:unbreakable":                unitCodec,
+	"minecraft:custom_name":                nbtCodec,
+	"minecraft:item_name":                  nbtCodec,
+	"minecraft:lore":                       loreCodec,
+	"minecraft:rarity":                     rarityCodec,
+	"minecraft:repair_cost":                intCodec,
+	"minecraft:enchantment_glint_override": boolCodec,
+}
+
+const componentRegistry = "minecraft:data_component_type"
+
+// encodeComponent writes the value of a component as sent to the client.
+func encodeComponent(name string, value any) ([]byte, error) {
+	if data, ok := value.(raw); ok {
+		return data, nil
+	}
+
+	codec, ok := componentCodecs[name]
+	if !ok {
+		return []byte{}, fmt.Errorf("Unknown data component %s", name)
+	}
+
+	return codec.write(value)
+}
+
+// encodeSlot writes a stack as a slot, its components sorted by registry
+// ID so equal stacks give equal bytes.
+func encodeSlot(stack ItemStack) ([]byte, error) {
+	if stack.Empty() {
+		return writeVarInt(0), nil
+	}
+
+	item, ok := world.ItemID(stack.Item)
+	if !ok {
+		return []byte{}, fmt.Errorf("Unknown item %s", stack.Item)
+	}
+
+	type component struct {
+		id    int
+		value []byte
+	}
+
+	added := make([]component, 0, len(stack.Components))
+	for name, value := range stack.Components {
+		id, ok := world.RegistryID(componentRegistry, name)
+		if !ok {
+			return []byte{}, fmt.Errorf("Unknown data component %s", name)
+		}
+
+		data, err := encodeComponent(name, value)
+		if err != nil {
+			return []byte{}, err
+		}
+
+		added = append(added, component{id, data})
+	}
+
+	slices.SortFunc(added, func(a component, b component) int {
+		return a.id - b.id
+	})
+
+	removed := make([]int, 0, len(stack.Removed))
+	for _, name := range stack.Removed {
+		id, ok := world.RegistryID(componentRegistry, name)
+		if !ok {
+			return []byte{}, fmt.Errorf("Unknown data component %s", name)
+		}
+
+		removed = append(removed, id)
+	}
+
+	slices.Sort(removed)
+
+	buffer := writeVarInt(stack.Count)
+	buffer = append(buffer, writeVarInt(item)...)
+	buffer = append(buffer, writeVarInt(len(added))...)
+	buffer = append(buffer, writeVarInt(len(removed))...)
+
+	for _, c := range added {
+		buffer = append(buffer, writeVarInt(c.id)...)
+		buffer = append(buffer, c.value...)
+	}
+
+	for _, id := range removed {
+		buffer = append(buffer, writeVarInt(id)...)
+	}
+
+	return buffer, nil
+}
+
+// checkCount makes sure count elements, taking a byte at least each, can
+// follow in buffer and stay under limit before anything is allocated.
+func checkCount(buffer []byte, count int, limit int) error {
+	if count < 0 || count > limit {
+		return fmt.Errorf("Invalid count of %d elements, expected at most %d", count, limit)
+	}
+
+	if count > len(buffer) {
+		return errors.New("unexpected end of buffer while reading array")
+	}
+
+	return nil
+}
+
+// varInts reads count varints in a row.
+func varInts(buffer []byte, count int) ([]byte, []int, error) {
+	if count < 0 || count > len(buffer) {
+		return []byte{}, nil, errors.New("unexpected end of buffer while reading varints")
+	}
+
+	values := make([]int, count)
+	for i := range values {
+		v, sz, err := readVarIntFromBuff(buffer)
+		if err != nil {
+			return []byte{}, nil, err
+		}
+
+		values[i] = v
+		buffer = buffer[sz:]
+	}
+
+	return buffer, values, nil
+}
+
+// componentName returns the name of a data component type sent by the
+// client.
+func componentName(id int) (string, error) {
+	name, ok := world.RegistryEntry(componentRegistry, id)
+	if !ok {
+		return "", fmt.Errorf("Unknown data component %d", id)
+	}
+
+	return name, nil
+}
+
+// untrustedSlotFactory reads a slot sent by a client in creative, whose
+// components are prefixed by their length. The components the server
+// doesn't know are kept as is.
+func untrustedSlotFactory(buffer []byte) ([]byte, any, error) {
+	buffer, count, err := varInts(buffer, 1)
+	if err != nil {
+		return []byte{}, nil, err
+	}
+
+	if count[0] <= 0 {
+		return buffer, ItemStack{}, nil
+	}
+
+	buffer, header, err := varInts(buffer, 3)
+	if err != nil {
+		return []byte{}, nil, err
+	}
+
+	item, ok := world.ItemName(header[0])
+	if !ok {
+		return []byte{}, nil, fmt.Errorf("Unknown item %d", header[0])
+	}
+
+	for _, count := range header[1:] {
+		if err := checkCount(buffer, count, maxComponents); err != nil {
+			return []byte{}, nil, err
+		}
+	}
+
+	stack := ItemStack{Item: item, Count: count[0], Components: make(map[string]any)}
+
+	for range header[1] {
+		rest, id, err := varInts(buffer, 1)
+		if err != nil {
+			return []byte{}, nil, err
+		}
+
+		rest, data, err := bytesFactory(rest)
+		if err != nil {
+			return []byte{}, nil, err
+		}
+
+		buffer = rest
+
+		name, err := componentName(id[0])
+		if err != nil {
+			return []byte{}, nil, err
+		}
+
+		codec, ok := componentCodecs[name]
+		if !ok {
+			stack.Components[name] = raw(slices.Clone(data.([]byte)))
+			continue
+		}
+
+		left, value, err := codec.read(data.([]byte))
+		if err != nil {
+			return []byte{}, nil, err
+		}
+
+		if len(left) != 0 {
+			return []byte{}, nil, fmt.Errorf("Data component %s is too long", name)
+		}
+
+		stack.Components[name] = value
+	}
+
+	buffer, removed, err := varInts(buffer, header[2])
+	if err != nil {
+		return []byte{}, nil, err
+	}
+
+	for _, id := range removed {
+		name, err := componentName(id)
+		if err != nil {
+			return []byte{}, nil, err
+		}
+
+		stack.Removed = append(stack.Removed, name)
+	}
+
+	if len(stack.Components) == 0 {
+		stack.Components = nil
+	}
+
+	return buffer, stack, nil
+}
+
+// hashedSlot is a slot as the client describes it in container_click, the
+// values of the components being replaced by their hash.
+type hashedSlot struct {
+	item    string
+	count   int
+	added   []string
+	removed []string
+}
+
+func hashedSlotFactory(buffer []byte) ([]byte, any, error) {
+	buffer, present, err := boolFactory(buffer)
+	if err != nil {
+		return []byte{}, nil, err
+	}
+
+	if !present.(bool) {
+		return buffer, hashedSlot{}, nil
+	}
+
+	buffer, header, err := varInts(buffer, 3)
+	if err != nil {
+		return []byte{}, nil, err
+	}
+
+	if err := checkCount(buffer, header[2], maxComponents); err != nil {
+		return []byte{}, nil, err
+	}
+
+	item, _ := world.ItemName(header[0])
+	slot := hashedSlot{item: item, count: header[1]}
+
+	for range header[2] {
+		rest, id, err := varInts(buffer, 1)
+		if err != nil || len(rest) < 4 {
+			return []byte{}, nil, errors.New("unexpected end of buffer while reading hashed slot")
+		}
+
+		// The CRC32C of the value is left alone
+		buffer = rest[4:]
+
+		name, err := componentName(id[0])
+		if err != nil {
+			return []byte{}, nil, err
+		}
+
+		slot.added = append(slot.added, name)
+	}
+
+	buffer, count, err := varInts(buffer, 1)
+	if err != nil {
+		return []byte{}, nil, err
+	}
+
+	if err := checkCount(buffer, count[0], maxComponents); err != nil {
+		return []byte{}, nil, err
+	}
+
+	buffer, removed, err := varInts(buffer, count[0])
+	if err != nil {
+		return []byte{}, nil, err
+	}
+
+	for _, id := range removed {
+		name, err := componentName(id)
+		if err != nil {
+			return []byte{}, nil, err
+		}
+
+		slot.removed = append(slot.removed, name)
+	}
+
+	return buffer, slot, nil
+}
+
+// matches reports whether the client sees stack in a slot it described as
+// hashed. The hashes of the component values aren't checked, only which
+// components are there.
+func (self hashedSlot) matches(stack ItemStack) bool {
+	if stack.Empty() {
+		return self.item == "" || self.count <= 0
+	}
+
+	if self.item != stack.Item || self.count != stack.Count {
+		return false
+	}
+
+	added := slices.Sorted(maps.Keys(stack.Components))
+	removed := slices.Sorted(slices.Values(stack.Removed))
+
+	return slices.Equal(added, slices.Sorted(slices.Values(self.added))) &&
+		slices.Equal(removed, slices.Sorted(slices.Values(self.removed)))
+}
+
+// itemNBT returns a stack as the game saves it. The components sent by a
+// client the server doesn't understand are left out.
+func itemNBT(stack ItemStack) nbt.Tag {
+	components := make(map[string]nbt.Tag)
+	for name, tag := range stack.kept {
+		components[name] = tag
+	}
+
+	for name, value := range stack.Components {
+		if codec, ok := componentCodecs[name]; ok {
+			components[name] = codec.save(value)
+		}
+	}
+
+	for _, name := range stack.Removed {
+		components["!"+name] = nbt.NewCompoundTag("", map[string]nbt.Tag{})
+	}
+
+	data := map[string]nbt.Tag{
+		"id":    nbt.NewStringTag("id", stack.Item),
+		"count": nbt.NewIntTag("count", int32(stack.Count)),
+	}
+
+	if len(components) > 0 {
+		data["components"] = nbt.NewCompoundTag("components", components)
+	}
+
+	return nbt.NewCompoundTag("", data)
+}
+
+// itemFromNBT reads a stack saved by the game.
+func itemFromNBT(tag nbt.Tag) (ItemStack, error) {
+	c, ok := tag.(*nbt.Compound)
+	if !ok {
+		return ItemStack{}, errors.New("Item isn't a compound")
+	}
+
+	var stack ItemStack
+	if id, ok := c.Value["id"]; ok {
+		stack.Item, _ = id.ToString()
+	}
+
+	if _, ok := world.ItemID(stack.Item); !ok {
+		return ItemStack{}, fmt.Errorf("Unknown item %s", stack.Item)
+	}
+
+	// Stacks of one leave the count out
+	stack.Count = 1
+	if count, ok := c.Value["count"]; ok {
+		stack.Count, _ = count.ToInt()
+	}
+
+	components, _ := c.Value["components"].(*nbt.Compound)
+	if components == nil {
+		return stack, nil
+	}
+
+	for name, value := range components.Value {
+		if removed, ok := strings.CutPrefix(name, "!"); ok {
+			if _, known := world.RegistryID(componentRegistry, removed); known {
+				stack.Removed = append(stack.Removed, removed)
+				continue
+			}
+		}
+
+		codec, ok := componentCodecs[name]
+		if !ok {
+			if stack.kept == nil {
+				stack.kept = make(map[string]nbt.Tag)
+			}
+
+			stack.kept[name] = value
+			continue
+		}
+
+		v, err := codec.load(value)
+		if err != nil {
+			return ItemStack{}, fmt.Errorf("Invalid data component %s: %w", name, err)
+		}
+
+		if stack.Components == nil {
+			stack.Components = make(map[string]any)
+		}
+
+		stack.Components[name] = v
+	}
+
+	return stack, nil
+}
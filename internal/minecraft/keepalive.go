@@ -0,0 +1,140 @@
+package minecraft
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"encoding/json"
+)
+
+// keepAliveInterval is the time between two keep alives, a player not
+// answering by the next one being kicked, following the vanilla server.
+const keepAliveInterval = 15 * time.Second
+
+// keepAlive checks the connection of a player in Play, measuring its
+// latency on the way. It outlives the keep alive loop, which stops while
+// the player is reconfigured.
+type keepAlive struct {
+	lock      sync.Mutex
+	done      chan struct{}
+	pending   bool
+	challenge int64
+	sentAt    time.Time
+	latency   time.Duration
+}
+
+// startKeepAlive starts sending keep alives to a player entering Play.
+func (self *client) startKeepAlive() {
+	self.stopKeepAlive()
+
+	self.alive.lock.Lock()
+	done := make(chan struct{})
+	self.alive.done = done
+	self.alive.pending = false
+	self.alive.lock.Unlock()
+
+	go self.keepAliveLoop(done)
+}
+
+func (self *client) stopKeepAlive() {
+	self.alive.lock.Lock()
+	defer self.alive.lock.Unlock()
+
+	if self.alive.done != nil {
+		close(self.alive.done)
+		self.alive.done = nil
+	}
+}
+
+func (self *client) keepAliveLoop(done chan struct{}) {
+	ticker := time.NewTicker(keepAliveInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-self.server.done:
+			return
+		case <-ticker.C:
+			self.alive.lock.Lock()
+			if self.alive.pending {
+				self.alive.lock.Unlock()
+
+				self.logger.Info("Timed out")
+				self.disconnect("Timed out")
+				return
+			}
+
+			now := time.Now()
+			self.alive.pending = true
+			self.alive.challenge = now.UnixMilli()
+			self.alive.sentAt = now
+			challenge := self.alive.challenge
+			self.alive.lock.Unlock()
+
+			// keep_alive
+			if err := self.send(0x26, challenge); err != nil {
+				self.logger.Error("Couldn't send keep alive", "error", err)
+				return
+			}
+		}
+	}
+}
+
+func readKeepAlive(c *client, data []byte) error {
+	m, err := readFromBuffer(data,
+		factoryPair{"id", longFactory},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	id := m["id"].(int64)
+
+	c.alive.lock.Lock()
+	if !c.alive.pending || id != c.alive.challenge {
+		c.alive.lock.Unlock()
+
+		c.disconnect("Timed out")
+		return fmt.Errorf("Unexpected keep alive %d", id)
+	}
+
+	// Smoothed like the vanilla server, the last round trip weighing a
+	// quarter
+	elapsed := time.Since(c.alive.sentAt)
+	c.alive.latency = (c.alive.latency*3 + elapsed) / 4
+	c.alive.pending = false
+	c.alive.lock.Unlock()
+
+	return nil
+}
+
+// latency returns the smoothed round trip time of the keep alives.
+func (self *client) latency() time.Duration {
+	self.alive.lock.Lock()
+	defer self.alive.lock.Unlock()
+
+	return self.alive.latency
+}
+
+// disconnect kicks the player with reason, closing the connection.
+func (self *client) disconnect(reason string) {
+	switch self.state.Load() {
+	case Login:
+		message, _ := json.Marshal(map[string]string{"text": reason})
+
+		// login_disconnect
+		self.send(0x00, string(message))
+	case Config:
+		// disconnect
+		self.send(0x02, text(reason))
+	case Play:
+		// disconnect
+		self.send(0x1c, text(reason))
+	}
+
+	self.socket.Close()
+}
@@ -0,0 +1,137 @@
+package minecraft
+
+import (
+	"fmt"
+	"slices"
+)
+
+type ServerLinkLabel int
+
+// Labels the client knows how to translate, any other label is shown as is.
+const (
+	BugReport ServerLinkLabel = iota
+	CommunityGuidelines
+	Support
+	ServerStatus
+	Feedback
+	Community
+	Website
+	Forums
+	News
+	Announcements
+	CustomLabel ServerLinkLabel = -1
+)
+
+type ServerLink struct {
+	Label ServerLinkLabel
+	// Text is the label shown when Label is CustomLabel
+	Text string
+	URL  string
+}
+
+// ReportDetail is a key-value pair added to the crash reports and the debug
+// reports the client makes while connected.
+type ReportDetail struct {
+	Title       string
+	Description string
+}
+
+// The client rejects the packet past these bounds.
+const (
+	maxReportDetails     = 32
+	maxReportTitle       = 128
+	maxReportDescription = 4096
+)
+
+func encodeServerLinks(links []ServerLink) []any {
+	contents := []any{len(links)}
+
+	for _, link := range links {
+		if link.Label == CustomLabel {
+			contents = append(contents, false, text(link.Text), link.URL)
+		} else {
+			contents = append(contents, true, int(link.Label), link.URL)
+		}
+	}
+
+	return contents
+}
+
+func encodeReportDetails(details []ReportDetail) ([]any, error) {
+	if len(details) > maxReportDetails {
+		return nil, fmt.Errorf("Got %d report details, the limit is %d", len(details), maxReportDetails)
+	}
+
+	contents := []any{len(details)}
+
+	for _, detail := range details {
+		if len(detail.Title) > maxReportTitle || len(detail.Description) > maxReportDescription {
+			return nil, fmt.Errorf("Report detail %s is too long", detail.Title)
+		}
+
+		contents = append(contents, detail.Title, detail.Description)
+	}
+
+	return contents, nil
+}
+
+func (self *client) sendServerLinks(links []ServerLink) error {
+	switch self.state.Load() {
+	case Config:
+		// server_links
+		return self.send(0x10, encodeServerLinks(links)...)
+	case Play:
+		// server_links
+		return self.send(0x82, encodeServerLinks(links)...)
+	default:
+		return fmt.Errorf("Can't send server links in state %s", self.state.Load().string())
+	}
+}
+
+func (self *client) sendReportDetails(details []ReportDetail) error {
+	contents, err := encodeReportDetails(details)
+	if err != nil {
+		return err
+	}
+
+	switch self.state.Load() {
+	case Config:
+		// custom_report_details
+		return self.send(0x0f, contents...)
+	case Play:
+		// custom_report_details
+		return self.send(0x81, contents...)
+	default:
+		return fmt.Errorf("Can't send report details in state %s", self.state.Load().string())
+	}
+}
+
+// SetServerLinks replaces the configured server links and sends them to the
+// players already connected.
+func (self *Server) SetServerLinks(links []ServerLink) {
+	self.lock.Lock()
+	self.cfg.ServerLinks = slices.Clone(links)
+	self.lock.Unlock()
+
+	self.broadcast(func(c *client) error {
+		return c.sendServerLinks(links)
+	})
+}
+
+// SetReportDetails replaces the configured report details and sends them to
+// the players already connected.
+func (self *Server) SetReportDetails(details []ReportDetail) error {
+	if _, err := encodeReportDetails(details); err != nil {
+		return err
+	}
+
+	self.lock.Lock()
+	self.cfg.ReportDetails = slices.Clone(details)
+	self.lock.Unlock()
+
+	self.broadcast(func(c *client) error {
+		return c.sendReportDetails(details)
+	})
+
+	return nil
+}
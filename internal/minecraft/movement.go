@@ -0,0 +1,192 @@
+package minecraft
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/keyboard-slayer/minecraft-server/internal/world"
+)
+
+// Location is where a player stands and looks.
+type Location struct {
+	X, Y, Z    float64
+	Yaw, Pitch float32
+	OnGround   bool
+}
+
+// chunk returns the chunk the location is in.
+func (self Location) chunk() (int32, int32) {
+	return int32(math.Floor(self.X)) >> 4, int32(math.Floor(self.Z)) >> 4
+}
+
+// Limits of the movements accepted from the clients, following the vanilla
+// server
+const (
+	maxHorizontal  = 3.0e7
+	maxVertical    = 2.0e7
+	maxMoveSquared = 100.0
+	teleportResend = time.Second
+)
+
+// Flags of the movement packets
+const (
+	onGroundFlag byte = 0x01
+)
+
+// spawnLocation returns the spawn point of a world, at the center of the
+// block.
+func spawnLocation(w *world.World) Location {
+	return Location{
+		X: float64(w.Level.SpawnX) + 0.5,
+		Y: float64(w.Level.SpawnY),
+		Z: float64(w.Level.SpawnZ) + 0.5,
+	}
+}
+
+// location returns where the player is, safe to read from other
+// goroutines.
+func (self *client) location() Location {
+	self.moveLock.Lock()
+	defer self.moveLock.Unlock()
+
+	return self.loc
+}
+
+// teleportTo moves the player, whose movements are ignored until the client
+// confirms the teleport.
+func (self *client) teleportTo(loc Location) error {
+	if self.state.Load() != Play {
+		return fmt.Errorf("Can't teleport in state %s", self.state.Load().string())
+	}
+
+	self.moveLock.Lock()
+	id := self.teleportId()
+	self.awaiting = id
+	self.awaitingSince = time.Now()
+	self.loc = loc
+	self.moveLock.Unlock()
+
+	if view := self.currentView(); view != nil {
+		view.move(loc.chunk())
+	}
+
+	// player_position
+	return self.send(0x41, id, loc.X, loc.Y, loc.Z, 0.0, 0.0, 0.0, loc.Yaw, loc.Pitch, int32(0))
+}
+
+func readAcceptTeleportation(c *client, data []byte) error {
+	m, err := readFromBuffer(data,
+		factoryPair{"id", intFactory},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	c.moveLock.Lock()
+	defer c.moveLock.Unlock()
+
+	// Confirmations of the teleports replaced by a newer one are expected
+	if id := m["id"].(int); id != c.awaiting {
+		c.logger.Debug("Ignored teleport confirmation", "id", id, "awaiting", c.awaiting)
+		return nil
+	}
+
+	c.awaiting = 0
+	return nil
+}
+
+// readMovePlayer handles the four movement packets, which carry the
+// position, the rotation, both or only the flags.
+func readMovePlayer(c *client, data []byte, position bool, rotation bool) error {
+	pairs := make([]factoryPair, 0)
+	if position {
+		pairs = append(pairs,
+			factoryPair{"x", doubleFactory},
+			factoryPair{"y", doubleFactory},
+			factoryPair{"z", doubleFactory},
+		)
+	}
+
+	if rotation {
+		pairs = append(pairs,
+			factoryPair{"yaw", floatFactory},
+			factoryPair{"pitch", floatFactory},
+		)
+	}
+
+	pairs = append(pairs, factoryPair{"flags", byteFactory})
+
+	m, err := readFromBuffer(data, pairs...)
+	if err != nil {
+		return err
+	}
+
+	c.moveLock.Lock()
+	loc := c.loc
+
+	// The client may still be moving from where it was before the teleport
+	if c.awaiting != 0 {
+		resend := time.Since(c.awaitingSince) > teleportResend
+		c.moveLock.Unlock()
+
+		if resend {
+			return c.teleportTo(loc)
+		}
+
+		return nil
+	}
+
+	moved := loc
+	if position {
+		x, y, z := m["x"].(float64), m["y"].(float64), m["z"].(float64)
+		if !finite(x) || !finite(y) || !finite(z) {
+			c.moveLock.Unlock()
+			return errors.New("Invalid move player packet received")
+		}
+
+		moved.X = clamp(x, maxHorizontal)
+		moved.Y = clamp(y, maxVertical)
+		moved.Z = clamp(z, maxHorizontal)
+	}
+
+	if rotation {
+		yaw, pitch := m["yaw"].(float32), m["pitch"].(float32)
+		if !finite(float64(yaw)) || !finite(float64(pitch)) {
+			c.moveLock.Unlock()
+			return errors.New("Invalid move player packet received")
+		}
+
+		moved.Yaw = yaw
+		moved.Pitch = min(max(pitch, -90), 90)
+	}
+
+	moved.OnGround = m["flags"].(byte)&onGroundFlag != 0
+
+	dx, dy, dz := moved.X-loc.X, moved.Y-loc.Y, moved.Z-loc.Z
+	if dx*dx+dy*dy+dz*dz > maxMoveSquared {
+		c.moveLock.Unlock()
+		c.logger.Warn("Moved too quickly", "dx", dx, "dy", dy, "dz", dz)
+
+		return c.teleportTo(loc)
+	}
+
+	c.loc = moved
+	c.moveLock.Unlock()
+
+	if view := c.currentView(); view != nil {
+		view.move(moved.chunk())
+	}
+
+	return nil
+}
+
+func finite(v float64) bool {
+	return !math.IsNaN(v) && !math.IsInf(v, 0)
+}
+
+func clamp(v float64, limit float64) float64 {
+	return min(max(v, -limit), limit)
+}
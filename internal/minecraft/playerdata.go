@@ -0,0 +1,305 @@
+package minecraft
+
+import (
+	"errors"
+
+	"github.com/beito123/nbt"
+	"github.com/keyboard-slayer/minecraft-server/internal/world"
+)
+
+// Food and saturation shown to the players, who never get hungry
+const (
+	fullFood       = 20
+	fullSaturation = 5
+)
+
+// stats are the health, the experience and the respawn point of a player,
+// kept as they were loaded as the server has no damage nor experience.
+type stats struct {
+	health     float32
+	xpLevel    int
+	xpProgress float32
+	xpTotal    int
+	respawn    *world.Respawn
+}
+
+// equipmentNames are the names the saves give to the armor and off hand
+// slots of the inventory window.
+var equipmentNames = map[string]int{
+	"head":    helmetSlot,
+	"chest":   chestplateSlot,
+	"legs":    leggingsSlot,
+	"feet":    bootsSlot,
+	"offhand": offhandSlot,
+}
+
+// saveSlot returns the slot a save gives to a slot of the inventory
+// window, the hotbar coming first, or -1 for the equipment.
+func saveSlot(slot int) int {
+	switch {
+	case slot >= hotbarSlot && slot < hotbarSlot+hotbarSize:
+		return slot - hotbarSlot
+	case slot >= mainSlot && slot < hotbarSlot:
+		return slot
+	}
+
+	return -1
+}
+
+// windowSlot reverses saveSlot, returning -1 for the slots out of the
+// main inventory.
+func windowSlot(slot int) int {
+	switch {
+	case slot >= 0 && slot < hotbarSize:
+		return hotbarSlot + slot
+	case slot >= mainSlot && slot < hotbarSlot:
+		return slot
+	}
+
+	return -1
+}
+
+// playerStore returns where the players are kept: the first world, whose
+// folder is the root of the vanilla world folder.
+func (self *Server) playerStore() world.WorldStore {
+	return self.worlds[0].world.Store()
+}
+
+// loadPlayer puts the player where they left, with their items, or at the
+// spawn of the first world when they join for the first time.
+func (self *client) loadPlayer() error {
+	self.dimension.Store(self.server.worlds[0])
+
+	data, err := self.server.playerStore().LoadPlayer(self.info.uuid.String())
+	if err != nil {
+		return err
+	}
+
+	self.moveLock.Lock()
+	defer self.moveLock.Unlock()
+
+	self.loc = spawnLocation(self.dimension.Load().world)
+	self.stats = stats{health: world.NewPlayerData().Health}
+
+	if data == nil {
+		return nil
+	}
+
+	// Players of a world no longer loaded go back to the spawn
+	if d := self.server.dimension(data.Dimension); d != nil {
+		self.dimension.Store(d)
+		self.loc = Location{X: data.X, Y: data.Y, Z: data.Z, Yaw: data.Yaw, Pitch: data.Pitch, OnGround: data.OnGround}
+	} else {
+		self.logger.Warn("Player left from an unknown world", "world", data.Dimension)
+	}
+
+	if data.GameMode >= 0 && data.GameMode < len(gameModeNames) {
+		self.mode = GameMode(data.GameMode)
+	}
+
+	self.previousMode = data.PreviousGameMode
+
+	// The game mode decides what the player may do, the speeds and the
+	// flight being kept
+	self.abilities = DefaultAbilities(self.mode)
+	if a := data.Abilities; a != nil {
+		self.abilities.FlySpeed, self.abilities.WalkSpeed = a.FlySpeed, a.WalkSpeed
+		self.abilities.Flying = self.abilities.Flying || (a.Flying && self.abilities.AllowFlying)
+	}
+
+	// Dead players come back, as they can't respawn
+	health := data.Health
+	if health <= 0 {
+		health = world.NewPlayerData().Health
+	}
+
+	self.stats = stats{
+		health:     health,
+		xpLevel:    data.XpLevel,
+		xpProgress: data.XpProgress,
+		xpTotal:    data.XpTotal,
+		respawn:    data.Respawn,
+	}
+
+	return self.loadInventory(data)
+}
+
+// loadInventory fills the inventory from the saved items, keeping the ones
+// it can't read to save them back.
+func (self *client) loadInventory(data *world.PlayerData) error {
+	inv := self.inventory
+	inv.lock.Lock()
+	defer inv.lock.Unlock()
+
+	if data.SelectedSlot >= 0 && data.SelectedSlot < hotbarSize {
+		inv.held = data.SelectedSlot
+	}
+
+	for _, tag := range data.Inventory {
+		c, ok := tag.(*nbt.Compound)
+		if !ok {
+			continue
+		}
+
+		var slot int
+		if s, ok := c.Value["Slot"]; ok {
+			slot, _ = s.ToInt()
+		}
+
+		i := windowSlot(slot)
+		if i < 0 {
+			self.logger.Warn("Item in an unknown slot", "slot", slot)
+			continue
+		}
+
+		stack, err := itemFromNBT(tag)
+		if err != nil {
+			self.logger.Warn("Couldn't load an item", "slot", slot, "error", err)
+			inv.unread[i] = tag
+			continue
+		}
+
+		inv.slots[i] = stack
+	}
+
+	for name, tag := range data.Equipment {
+		i, ok := equipmentNames[name]
+		if !ok {
+			continue
+		}
+
+		stack, err := itemFromNBT(tag)
+		if err != nil {
+			self.logger.Warn("Couldn't load an item", "slot", name, "error", err)
+			inv.unread[i] = tag
+			continue
+		}
+
+		inv.slots[i] = stack
+	}
+
+	return nil
+}
+
+// playerData returns what is saved of the player.
+func (self *client) playerData() world.PlayerData {
+	data := world.NewPlayerData()
+
+	self.moveLock.Lock()
+	loc := self.loc
+	data.GameMode = int(self.mode)
+	data.PreviousGameMode = self.previousMode
+	data.Health = self.stats.health
+	data.XpLevel = self.stats.xpLevel
+	data.XpProgress = self.stats.xpProgress
+	data.XpTotal = self.stats.xpTotal
+	data.Respawn = self.stats.respawn
+	data.Abilities = &world.Abilities{
+		Flying:       self.abilities.Flying,
+		MayFly:       self.abilities.AllowFlying,
+		Instabuild:   self.abilities.InstantBreak,
+		Invulnerable: self.abilities.Invulnerable,
+		MayBuild:     self.mode == Survival || self.mode == Creative,
+		FlySpeed:     self.abilities.FlySpeed,
+		WalkSpeed:    self.abilities.WalkSpeed,
+	}
+	self.moveLock.Unlock()
+
+	data.X, data.Y, data.Z = loc.X, loc.Y, loc.Z
+	data.Yaw, data.Pitch, data.OnGround = loc.Yaw, loc.Pitch, loc.OnGround
+	data.Dimension = self.dimension.Load().name
+
+	inv := self.inventory
+	inv.lock.Lock()
+	defer inv.lock.Unlock()
+
+	data.SelectedSlot = inv.held
+
+	// Unread items are lost once another item takes their slot
+	for i, stack := range inv.slots {
+		if !stack.Empty() {
+			inv.unread[i] = nil
+		}
+	}
+
+	data.Inventory = make([]nbt.Tag, 0)
+	for i, stack := range inv.slots {
+		slot := saveSlot(i)
+		if slot < 0 {
+			continue
+		}
+
+		if stack.Empty() {
+			if inv.unread[i] != nil {
+				data.Inventory = append(data.Inventory, inv.unread[i])
+			}
+
+			continue
+		}
+
+		tag := itemNBT(stack).(*nbt.Compound)
+		tag.Value["Slot"] = nbt.NewByteTag("Slot", int8(slot))
+		data.Inventory = append(data.Inventory, tag)
+	}
+
+	for name, i := range equipmentNames {
+		if !inv.slots[i].Empty() {
+			data.Equipment[name] = itemNBT(inv.slots[i])
+		} else if inv.unread[i] != nil {
+			data.Equipment[name] = inv.unread[i]
+		}
+	}
+
+	return data
+}
+
+// savePlayer writes the player to the first world. Players of read-only
+// worlds are forgotten when they leave.
+func (self *client) savePlayer() error {
+	if self.dimension.Load() == nil {
+		return nil
+	}
+
+	err := self.server.playerStore().SavePlayer(self.info.uuid.String(), self.playerData())
+	if errors.Is(err, world.ErrReadOnly) {
+		return nil
+	}
+
+	return err
+}
+
+// savePlayers writes every player in the worlds.
+func (self *Server) savePlayers() error {
+	self.lock.RLock()
+	clients := make([]*client, 0, len(self.clients))
+	for _, c := range self.clients {
+		if c.state.Load() == Play && c.dimension.Load() != nil {
+			clients = append(clients, c)
+		}
+	}
+	self.lock.RUnlock()
+
+	var errs []error
+	for _, c := range clients {
+		if err := c.savePlayer(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// sendStats shows the health and the experience of the player.
+func (self *client) sendStats() error {
+	self.moveLock.Lock()
+	s := self.stats
+	self.moveLock.Unlock()
+
+	// set_health
+	if err := self.send(0x61, s.health, fullFood, float32(fullSaturation)); err != nil {
+		return err
+	}
+
+	// set_experience
+	return self.send(0x60, s.xpProgress, s.xpLevel, s.xpTotal)
+}
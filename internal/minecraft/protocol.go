@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"sync/atomic"
 
 	"encoding/binary"
 	"encoding/json"
@@ -26,25 +27,87 @@ func (self State) string() string {
 	return []string{"Handshaking", "Status", "Login", "Config", "Play"}[self]
 }
 
+// stateValue holds the State of a client, changed by the goroutine of the
+// client and read by the ones broadcasting to it.
+type stateValue struct {
+	value atomic.Int32
+}
+
+func (self *stateValue) Load() State {
+	return State(self.value.Load())
+}
+
+func (self *stateValue) Store(state State) {
+	self.value.Store(int32(state))
+}
+
 func resName(c *client, id int) string {
 	switch id {
 	case 0x00:
-		return []string{"intention", "status_request", "hello", "client_information", "??"}[c.state]
+		return []string{"intention", "status_request", "hello", "client_information", "accept_teleportation"}[c.state.Load()]
 	case 0x01:
-		return []string{"??", "ping_request", "key", "??", "??"}[c.state]
+		return []string{"??", "ping_request", "key", "cookie_response", "??"}[c.state.Load()]
 	case 0x02:
-		return []string{"??", "??", "??", "custom_payload", "??"}[c.state]
+		return []string{"??", "??", "??", "custom_payload", "bundle_item_selected"}[c.state.Load()]
 	case 0x03:
-		return []string{"??", "??", "login_acknowledged", "finish_configuration", "??"}[c.state]
+		return []string{"??", "??", "login_acknowledged", "finish_configuration", "??"}[c.state.Load()]
+	case 0x04:
+		return []string{"??", "??", "cookie_response", "??", "change_game_mode"}[c.state.Load()]
+	case 0x06:
+		return []string{"??", "??", "??", "resource_pack", "chat_command"}[c.state.Load()]
 	case 0x07:
-		return []string{"??", "??", "??", "select_known_packs", "??"}[c.state]
+		return []string{"??", "??", "??", "select_known_packs", "chat_command_signed"}[c.state.Load()]
+	case 0x08:
+		return []string{"??", "??", "??", "??", "chat"}[c.state.Load()]
+	case 0x0a:
+		return []string{"??", "??", "??", "??", "chunk_batch_received"}[c.state.Load()]
+	case 0x0d:
+		return []string{"??", "??", "??", "??", "client_information"}[c.state.Load()]
+	case 0x0e:
+		return []string{"??", "??", "??", "??", "command_suggestion"}[c.state.Load()]
+	case 0x0f:
+		return []string{"??", "??", "??", "??", "configuration_acknowledged"}[c.state.Load()]
+	case 0x11:
+		return []string{"??", "??", "??", "??", "container_click"}[c.state.Load()]
+	case 0x12:
+		return []string{"??", "??", "??", "??", "container_close"}[c.state.Load()]
+	case 0x14:
+		return []string{"??", "??", "??", "??", "cookie_response"}[c.state.Load()]
+	case 0x15:
+		return []string{"??", "??", "??", "??", "custom_payload"}[c.state.Load()]
+	case 0x1b:
+		return []string{"??", "??", "??", "??", "keep_alive"}[c.state.Load()]
+	case 0x1d:
+		return []string{"??", "??", "??", "??", "move_player_pos"}[c.state.Load()]
+	case 0x1e:
+		return []string{"??", "??", "??", "??", "move_player_pos_rot"}[c.state.Load()]
+	case 0x1f:
+		return []string{"??", "??", "??", "??", "move_player_rot"}[c.state.Load()]
+	case 0x20:
+		return []string{"??", "??", "??", "??", "move_player_status_only"}[c.state.Load()]
+	case 0x27:
+		return []string{"??", "??", "??", "??", "player_abilities"}[c.state.Load()]
+	case 0x28:
+		return []string{"??", "??", "??", "??", "player_action"}[c.state.Load()]
+	case 0x29:
+		return []string{"??", "??", "??", "??", "player_command"}[c.state.Load()]
+	case 0x2a:
+		return []string{"??", "??", "??", "??", "player_input"}[c.state.Load()]
+	case 0x30:
+		return []string{"??", "??", "??", "??", "resource_pack"}[c.state.Load()]
+	case 0x34:
+		return []string{"??", "??", "??", "??", "set_carried_item"}[c.state.Load()]
+	case 0x37:
+		return []string{"??", "??", "??", "??", "set_creative_mode_slot"}[c.state.Load()]
+	case 0x3f:
+		return []string{"??", "??", "??", "??", "use_item_on"}[c.state.Load()]
 	}
 
 	return "??"
 }
 
 func router(c *client, id int, data []byte) error {
-	c.logger.Debug("", "state", c.state.string(), "protocol", fmt.Sprintf("0x%02x", id), "resource", resName(c, id))
+	c.logger.Debug("", "state", c.state.Load().string(), "protocol", fmt.Sprintf("0x%02x", id), "resource", resName(c, id))
 
 	switch id {
 	case 0x00:
@@ -55,15 +118,68 @@ func router(c *client, id int, data []byte) error {
 		return protocol2(c, data)
 	case 0x03:
 		return protocol3(c, data)
+	case 0x04:
+		return protocol4(c, data)
+	case 0x06:
+		return protocol6(c, data)
 	case 0x07:
 		return protocol7(c, data)
+	case 0x08:
+		return protocol8(c, data)
+	case 0x0a:
+		return protocola(c, data)
+	case 0x0d:
+		return protocold(c, data)
+	case 0x0e:
+		return protocole(c, data)
+	case 0x0f:
+		return protocolf(c, data)
+	case 0x11:
+		return protocol11(c, data)
+	case 0x12:
+		return protocol12(c, data)
+	case 0x14:
+		return protocol14(c, data)
+	case 0x15:
+		return protocol15(c, data)
+	case 0x1b:
+		return protocol1b(c, data)
+	case 0x1d:
+		return protocol1d(c, data)
+	case 0x1e:
+		return protocol1e(c, data)
+	case 0x1f:
+		return protocol1f(c, data)
+	case 0x20:
+		return protocol20(c, data)
+	case 0x27:
+		return protocol27(c, data)
+	case 0x28:
+		return protocol28(c, data)
+	case 0x29:
+		return protocol29(c, data)
+	case 0x2a:
+		return protocol2a(c, data)
+	case 0x30:
+		return protocol30(c, data)
+	case 0x34:
+		return protocol34(c, data)
+	case 0x37:
+		return protocol37(c, data)
+	case 0x3f:
+		return protocol3f(c, data)
 	default:
+		// The client sends many Play packets the server has no use for yet
+		if c.state.Load() == Play {
+			return nil
+		}
+
 		return fmt.Errorf("Unknown protcol %d", id)
 	}
 }
 
 func protocol0(c *client, data []byte) error {
-	switch c.state {
+	switch c.state.Load() {
 	case Handshaking:
 		// intention
 		c.logger.Debug("", "buffer", data)
@@ -83,7 +199,24 @@ func protocol0(c *client, data []byte) error {
 		c.logger.Debug("", "host", string(m["host"].([]byte)))
 		c.logger.Debug("", "port", m["port"].(int))
 
-		c.state = State(m["intent"].(int))
+		switch m["intent"].(int) {
+		case 1:
+			c.state.Store(Status)
+		case 2:
+			c.state.Store(Login)
+		case 3:
+			// transferred
+			c.state.Store(Login)
+			c.info.transferred = true
+
+			if !c.server.config().AcceptTransfers {
+				// login_disconnect
+				c.send(0x00, `{"text":"Transfers are disabled on this server"}`)
+				return errors.New("Refused a transferred connection")
+			}
+		default:
+			return fmt.Errorf("Unknown intent %d", m["intent"].(int))
+		}
 
 		return nil
 
@@ -148,65 +281,25 @@ func protocol0(c *client, data []byte) error {
 
 	case Config:
 		// client_information
-		m, err := readFromBuffer(data,
-			factoryPair{"locale", bytesFactory},
-			factoryPair{"view_distance", byteFactory},
-			factoryPair{"chat_mode", intFactory},
-			factoryPair{"chat_color", byteFactory},
-			factoryPair{"skin_part", byteFactory},
-			factoryPair{"main_hand", intFactory},
-			factoryPair{"filter_text", byteFactory},
-			factoryPair{"allow_listing", byteFactory},
-			factoryPair{"particul_status", intFactory},
-		)
-
-		if err != nil {
-			return err
-		}
-
-		c.info.cfg = userConfig{
-			locale:       string(m["locale"].([]byte)),
-			viewDistance: int8(m["view_distance"].(byte)),
-			chat:         chatMode(m["chat_mode"].(int)),
-			chatColors:   m["chat_color"].(byte) == 0x01,
-			skinPart:     uint8(m["skin_part"].(byte)),
-			isHandLeft:   m["main_hand"].(int) == 0x00,
-			textFiltered: m["filter_text"].(byte) == 0x01,
-			allowListing: m["allow_listing"].(byte) == 0x01,
-			particul:     particulStatus(m["particul_status"].(int)),
-		}
-
-		c.logger.Debug("",
-			"locale", c.info.cfg.locale,
-			"viewDistance", c.info.cfg.viewDistance,
-			"chatMode", c.info.cfg.chat.string(),
-			"chatColors", c.info.cfg.chatColors,
-			"skinPart", c.info.cfg.skinPart,
-			"isHandLeft", c.info.cfg.isHandLeft,
-			"textFiltered", c.info.cfg.textFiltered,
-			"allowListing", c.info.cfg.allowListing,
-			"particul", c.info.cfg.particul.string(),
-		)
-
-		// custom_payload
-		if err = c.send(0x01, "minecraft:brand", "vanilla"); err != nil {
+		if err := readClientInformation(c, data); err != nil {
 			return err
 		}
 
-		// select_known_packs
-		if err = c.send(0x0e, 1, "minecraft", "core", "1.21.8"); err != nil {
+	case Play:
+		// accept_teleportation
+		if err := readAcceptTeleportation(c, data); err != nil {
 			return err
 		}
 
 	default:
-		return fmt.Errorf("State not handled %s", c.state.string())
+		return fmt.Errorf("State not handled %s", c.state.Load().string())
 	}
 
 	return nil
 }
 
 func protocol1(c *client, data []byte) error {
-	switch c.state {
+	switch c.state.Load() {
 	case Status:
 		// ping_request
 		timestamp := int64(binary.BigEndian.Uint64(data))
@@ -253,63 +346,133 @@ func protocol1(c *client, data []byte) error {
 		c.logger.Debug("", "secret", secret)
 		c.logger.Debug("", "token", plain)
 
+		if server := c.server.config().SessionServer; server != "" {
+			if err := c.authenticate(server, secretKey); err != nil {
+				// login_disconnect
+				c.send(0x00, `{"text":"Failed to verify username!"}`)
+				return fmt.Errorf("Couldn't authenticate: %w", err)
+			}
+		}
+
+		properties, err := encodeProperties(c.info.properties)
+		if err != nil {
+			return err
+		}
+
 		// login_finished
-		if err = c.send(0x02, c.info.uuid, c.info.name, []byte{}); err != nil {
+		if err = c.send(0x02, c.info.uuid, c.info.name, raw(properties)); err != nil {
+			return err
+		}
+
+	case Config:
+		// cookie_response
+		if err := readCookieResponse(c, data); err != nil {
 			return err
 		}
 
 	default:
-		return fmt.Errorf("State not handled %s", c.state.string())
+		return fmt.Errorf("State not handled %s", c.state.Load().string())
 	}
 
 	return nil
 }
 
 func protocol2(c *client, data []byte) error {
-	switch c.state {
+	switch c.state.Load() {
 	case Config:
 		// custom_payload
-		m, err := readFromBuffer(data,
-			factoryPair{"channel", bytesFactory},
-			factoryPair{"data", bytesFactory},
-		)
-
-		if err != nil {
+		if err := readCustomPayload(c, data); err != nil {
 			return err
 		}
 
-		c.logger.Debug("", "channel", string(m["channel"].([]byte)))
-		c.logger.Debug("", "data", m["data"].([]byte))
+	case Play:
+		// bundle_item_selected, only the client showing the item picked
+		if _, err := readFromBuffer(data,
+			factoryPair{"slot", intFactory},
+			factoryPair{"item", intFactory},
+		); err != nil {
+			return err
+		}
 
 	default:
-		return fmt.Errorf("State not handled %v", c.state)
+		return fmt.Errorf("State not handled %v", c.state.Load())
 	}
 
 	return nil
 }
 
 func protocol3(c *client, data []byte) error {
-	switch c.state {
+	switch c.state.Load() {
 	case Login:
 		// login_acknowledged
-		c.state = Config
+		c.state.Store(Config)
+
+		if err := c.configure(); err != nil {
+			return err
+		}
 	case Config:
 		// finish_configuration
-		c.state = Play
+		c.state.Store(Play)
 
-		// login
-		c.send(
-			0x2b, int32(c.id), false, []string{"minecraft", "overworld"}, 1, 10, 10, false, false, false, 0, "minecraft:overworld", 69, byte(1), byte(0), false, true, false,
-			10, 0, false,
-		)
+		// Players join the first world, and stay where they are and in the
+		// tab list of the others on reconfiguration
+		if c.dimension.Load() == nil {
+			if err := c.join(); err != nil {
+				return err
+			}
+		} else if err := c.rejoin(); err != nil {
+			return err
+		}
 	default:
-		return fmt.Errorf("State not handled %s", c.state.string())
+		return fmt.Errorf("State not handled %s", c.state.Load().string())
 	}
 	return nil
 }
 
+func protocol4(c *client, data []byte) error {
+	switch c.state.Load() {
+	case Login:
+		// cookie_response
+		if err := readCookieResponse(c, data); err != nil {
+			return err
+		}
+
+	case Play:
+		// change_game_mode
+		if err := readChangeGameMode(c, data); err != nil {
+			return err
+		}
+
+	default:
+		return fmt.Errorf("State not handled %s", c.state.Load().string())
+	}
+
+	return nil
+}
+
+func protocol6(c *client, data []byte) error {
+	switch c.state.Load() {
+	case Config:
+		// resource_pack
+		if err := readResourcePackResponse(c, data); err != nil {
+			return err
+		}
+
+	case Play:
+		// chat_command
+		if err := readChatCommand(c, data); err != nil {
+			return err
+		}
+
+	default:
+		return fmt.Errorf("State not handled %s", c.state.Load().string())
+	}
+
+	return nil
+}
+
 func protocol7(c *client, data []byte) error {
-	switch c.state {
+	switch c.state.Load() {
 	case Config:
 		// select_known_packs
 		length, sz, err := readVarIntFromBuff(data)
@@ -333,10 +496,363 @@ func protocol7(c *client, data []byte) error {
 			c.logger.Debug("Available pack", "namespace", string(m["namespace"].([]byte)), "id", string(m["id"].([]byte)), "version", string(m["version"].([]byte)))
 		}
 
-		c.send(0x03)
+		if err := c.finishConfiguration(); err != nil {
+			return err
+		}
+
+	case Play:
+		// chat_command_signed, its signatures being ignored
+		if err := readChatCommand(c, data); err != nil {
+			return err
+		}
+
+	default:
+		return fmt.Errorf("State not handled %s", c.state.Load().string())
+	}
+
+	return nil
+}
+
+func protocol8(c *client, data []byte) error {
+	switch c.state.Load() {
+	case Play:
+		// chat
+		if err := readChat(c, data); err != nil {
+			return err
+		}
+
+	default:
+		return fmt.Errorf("State not handled %s", c.state.Load().string())
+	}
+
+	return nil
+}
+
+func protocola(c *client, data []byte) error {
+	switch c.state.Load() {
+	case Play:
+		// chunk_batch_received
+		if err := readChunkBatchReceived(c, data); err != nil {
+			return err
+		}
+
+	default:
+		return fmt.Errorf("State not handled %s", c.state.Load().string())
+	}
+
+	return nil
+}
+
+func protocold(c *client, data []byte) error {
+	switch c.state.Load() {
+	case Play:
+		// client_information
+		if err := readClientInformation(c, data); err != nil {
+			return err
+		}
+
+	default:
+		return fmt.Errorf("State not handled %s", c.state.Load().string())
+	}
+
+	return nil
+}
+
+func protocole(c *client, data []byte) error {
+	switch c.state.Load() {
+	case Play:
+		// command_suggestion
+		if err := readCommandSuggestion(c, data); err != nil {
+			return err
+		}
+
+	default:
+		return fmt.Errorf("State not handled %s", c.state.Load().string())
+	}
+
+	return nil
+}
+
+func protocolf(c *client, data []byte) error {
+	switch c.state.Load() {
+	case Play:
+		// configuration_acknowledged
+		c.state.Store(Config)
+		c.stopKeepAlive()
+		c.stopView()
+		c.chat.reset()
+
+		if err := c.configure(); err != nil {
+			return err
+		}
+
+	default:
+		return fmt.Errorf("State not handled %s", c.state.Load().string())
+	}
+
+	return nil
+}
+
+func protocol11(c *client, data []byte) error {
+	switch c.state.Load() {
+	case Play:
+		// container_click
+		if err := readContainerClick(c, data); err != nil {
+			return err
+		}
+
+	default:
+		return fmt.Errorf("State not handled %s", c.state.Load().string())
+	}
+
+	return nil
+}
+
+func protocol12(c *client, data []byte) error {
+	switch c.state.Load() {
+	case Play:
+		// container_close
+		if err := readContainerClose(c, data); err != nil {
+			return err
+		}
+
+	default:
+		return fmt.Errorf("State not handled %s", c.state.Load().string())
+	}
+
+	return nil
+}
+
+func protocol14(c *client, data []byte) error {
+	switch c.state.Load() {
+	case Play:
+		// cookie_response
+		if err := readCookieResponse(c, data); err != nil {
+			return err
+		}
+
+	default:
+		return fmt.Errorf("State not handled %s", c.state.Load().string())
+	}
+
+	return nil
+}
+
+func protocol15(c *client, data []byte) error {
+	switch c.state.Load() {
+	case Play:
+		// custom_payload
+		if err := readCustomPayload(c, data); err != nil {
+			return err
+		}
+
+	default:
+		return fmt.Errorf("State not handled %s", c.state.Load().string())
+	}
+
+	return nil
+}
+
+func readCustomPayload(c *client, data []byte) error {
+	// The payload spans the rest of the packet, without a length prefix
+	rest, channel, err := bytesFactory(data)
+	if err != nil {
+		return err
+	}
+
+	return c.server.channels.dispatch(c, string(channel.([]byte)), rest)
+}
+
+func protocol1b(c *client, data []byte) error {
+	switch c.state.Load() {
+	case Play:
+		// keep_alive
+		if err := readKeepAlive(c, data); err != nil {
+			return err
+		}
+
+	default:
+		return fmt.Errorf("State not handled %s", c.state.Load().string())
+	}
+
+	return nil
+}
+
+func protocol1d(c *client, data []byte) error {
+	switch c.state.Load() {
+	case Play:
+		// move_player_pos
+		if err := readMovePlayer(c, data, true, false); err != nil {
+			return err
+		}
+
+	default:
+		return fmt.Errorf("State not handled %s", c.state.Load().string())
+	}
+
+	return nil
+}
+
+func protocol1e(c *client, data []byte) error {
+	switch c.state.Load() {
+	case Play:
+		// move_player_pos_rot
+		if err := readMovePlayer(c, data, true, true); err != nil {
+			return err
+		}
+
+	default:
+		return fmt.Errorf("State not handled %s", c.state.Load().string())
+	}
+
+	return nil
+}
+
+func protocol1f(c *client, data []byte) error {
+	switch c.state.Load() {
+	case Play:
+		// move_player_rot
+		if err := readMovePlayer(c, data, false, true); err != nil {
+			return err
+		}
+
+	default:
+		return fmt.Errorf("State not handled %s", c.state.Load().string())
+	}
+
+	return nil
+}
+
+func protocol20(c *client, data []byte) error {
+	switch c.state.Load() {
+	case Play:
+		// move_player_status_only
+		if err := readMovePlayer(c, data, false, false); err != nil {
+			return err
+		}
+
+	default:
+		return fmt.Errorf("State not handled %s", c.state.Load().string())
+	}
+
+	return nil
+}
+
+func protocol27(c *client, data []byte) error {
+	switch c.state.Load() {
+	case Play:
+		// player_abilities
+		if err := readPlayerAbilities(c, data); err != nil {
+			return err
+		}
+
+	default:
+		return fmt.Errorf("State not handled %s", c.state.Load().string())
+	}
+
+	return nil
+}
+
+func protocol28(c *client, data []byte) error {
+	switch c.state.Load() {
+	case Play:
+		// player_action
+		if err := readPlayerAction(c, data); err != nil {
+			return err
+		}
+
+	default:
+		return fmt.Errorf("State not handled %s", c.state.Load().string())
+	}
+
+	return nil
+}
+
+func protocol29(c *client, data []byte) error {
+	switch c.state.Load() {
+	case Play:
+		// player_command
+		if err := readPlayerCommand(c, data); err != nil {
+			return err
+		}
+
+	default:
+		return fmt.Errorf("State not handled %s", c.state.Load().string())
+	}
+
+	return nil
+}
+
+func protocol2a(c *client, data []byte) error {
+	switch c.state.Load() {
+	case Play:
+		// player_input
+		if err := readPlayerInput(c, data); err != nil {
+			return err
+		}
+
+	default:
+		return fmt.Errorf("State not handled %s", c.state.Load().string())
+	}
+
+	return nil
+}
+
+func protocol30(c *client, data []byte) error {
+	switch c.state.Load() {
+	case Play:
+		// resource_pack
+		if err := readResourcePackResponse(c, data); err != nil {
+			return err
+		}
+
+	default:
+		return fmt.Errorf("State not handled %s", c.state.Load().string())
+	}
+
+	return nil
+}
+
+func protocol34(c *client, data []byte) error {
+	switch c.state.Load() {
+	case Play:
+		// set_carried_item
+		if err := readSetCarriedItem(c, data); err != nil {
+			return err
+		}
+
+	default:
+		return fmt.Errorf("State not handled %s", c.state.Load().string())
+	}
+
+	return nil
+}
+
+func protocol37(c *client, data []byte) error {
+	switch c.state.Load() {
+	case Play:
+		// set_creative_mode_slot
+		if err := readSetCreativeModeSlot(c, data); err != nil {
+			return err
+		}
+
+	default:
+		return fmt.Errorf("State not handled %s", c.state.Load().string())
+	}
+
+	return nil
+}
+
+func protocol3f(c *client, data []byte) error {
+	switch c.state.Load() {
+	case Play:
+		// use_item_on
+		if err := readUseItemOn(c, data); err != nil {
+			return err
+		}
 
 	default:
-		return fmt.Errorf("State not handled %s", c.state.string())
+		return fmt.Errorf("State not handled %s", c.state.Load().string())
 	}
 
 	return nil
@@ -0,0 +1,190 @@
+package minecraft
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/keyboard-slayer/minecraft-server/internal/world"
+)
+
+// The vanilla entries of the registries synced with the client, all taken
+// from its core pack. The client builds its registries from the names sent,
+// in that order, the vanilla one being alphabetical.
+var syncedRegistries = []struct {
+	name    string
+	entries []string
+}{
+	{"minecraft:damage_type", namespaced(
+		"arrow", "bad_respawn_point", "cactus", "campfire", "cramming",
+		"dragon_breath", "drown", "dry_out", "ender_pearl", "explosion", "fall",
+		"falling_anvil", "falling_block", "falling_stalactite", "fireball",
+		"fireworks", "fly_into_wall", "freeze", "generic", "generic_kill",
+		"hot_floor", "in_fire", "in_wall", "indirect_magic", "lava",
+		"lightning_bolt", "mace_smash", "magic", "mob_attack",
+		"mob_attack_no_aggro", "mob_projectile", "on_fire", "out_of_world",
+		"outside_border", "player_attack", "player_explosion", "sonic_boom",
+		"spit", "stalagmite", "starve", "sting", "sweet_berry_bush", "thorns",
+		"thrown", "trident", "unattributed_fireball", "wind_charge", "wither",
+		"wither_skull",
+	)},
+	{"minecraft:trim_pattern", namespaced(
+		"bolt", "coast", "dune", "eye", "flow", "host", "raiser", "rib", "sentry",
+		"shaper", "silence", "snout", "spire", "tide", "vex", "ward", "wayfinder",
+		"wild",
+	)},
+	{"minecraft:trim_material", namespaced(
+		"amethyst", "copper", "diamond", "emerald", "gold", "iron", "lapis",
+		"netherite", "quartz", "redstone", "resin",
+	)},
+	{"minecraft:wolf_variant", namespaced(
+		"ashen", "black", "chestnut", "pale", "rusty", "snowy", "spotted",
+		"striped", "woods",
+	)},
+	{"minecraft:wolf_sound_variant", namespaced(
+		"angry", "big", "classic", "cute", "grumpy", "puglin", "sad",
+	)},
+	{"minecraft:pig_variant", namespaced("cold", "temperate", "warm")},
+	{"minecraft:cow_variant", namespaced("cold", "temperate", "warm")},
+	{"minecraft:chicken_variant", namespaced("cold", "temperate", "warm")},
+	{"minecraft:frog_variant", namespaced("cold", "temperate", "warm")},
+	{"minecraft:cat_variant", namespaced(
+		"all_black", "black", "british_shorthair", "calico", "jellie", "persian",
+		"ragdoll", "red", "siamese", "tabby", "white",
+	)},
+	{"minecraft:painting_variant", namespaced(
+		"alban", "aztec", "aztec2", "backyard", "baroque", "bomb", "bouquet",
+		"burning_skull", "bust", "cavebird", "changing", "cotan", "courbet",
+		"creebet", "donkey_kong", "earth", "endboss", "fern", "fighters",
+		"finding", "fire", "graham", "humble", "kebab", "lowmist", "match",
+		"meditative", "orb", "owlemons", "passage", "pigscene", "plant",
+		"pointer", "pond", "pool", "prairie_ride", "sea", "skeleton",
+		"skull_and_roses", "stage", "sunflowers", "sunset", "tides", "unpacked",
+		"void", "wanderer", "wasteland", "water", "wind", "wither",
+	)},
+	{"minecraft:enchantment", namespaced(
+		"aqua_affinity", "bane_of_arthropods", "binding_curse",
+		"blast_protection", "breach", "channeling", "density", "depth_strider",
+		"efficiency", "feather_falling", "fire_aspect", "fire_protection",
+		"flame", "fortune", "frost_walker", "impaling", "infinity", "knockback",
+		"looting", "loyalty", "luck_of_the_sea", "lure", "mending", "multishot",
+		"piercing", "power", "projectile_protection", "protection", "punch",
+		"quick_charge", "respiration", "riptide", "sharpness", "silk_touch",
+		"smite", "soul_speed", "sweeping_edge", "swift_sneak", "thorns",
+		"unbreaking", "vanishing_curse", "wind_burst",
+	)},
+	{"minecraft:banner_pattern", namespaced(
+		"base", "border", "bricks", "circle", "creeper", "cross", "curly_border",
+		"diagonal_left", "diagonal_right", "diagonal_up_left",
+		"diagonal_up_right", "flow", "flower", "globe", "gradient", "gradient_up",
+		"guster", "half_horizontal", "half_horizontal_bottom", "half_vertical",
+		"half_vertical_right", "mojang", "piglin", "rhombus", "skull",
+		"small_stripes", "square_bottom_left", "square_bottom_right",
+		"square_top_left", "square_top_right", "straight_cross",
+		"stripe_bottom", "stripe_center", "stripe_downleft", "stripe_downright",
+		"stripe_left", "stripe_middle", "stripe_right", "stripe_top",
+		"triangle_bottom", "triangle_top", "triangles_bottom", "triangles_top",
+	)},
+	{"minecraft:jukebox_song", namespaced(
+		"11", "13", "5", "blocks", "cat", "chirp", "creator",
+		"creator_music_box", "far", "lava_chicken", "mall", "mellohi",
+		"otherside", "pigstep", "precipice", "relic", "stal", "strad", "tears",
+		"wait", "ward",
+	)},
+	{"minecraft:instrument", namespaced(
+		"admire_goat_horn", "call_goat_horn", "dream_goat_horn",
+		"feel_goat_horn", "ponder_goat_horn", "seek_goat_horn",
+		"sing_goat_horn", "yearn_goat_horn",
+	)},
+	{"minecraft:dialog", namespaced("custom_options", "quick_actions", "server_links")},
+	// The game tests only exist in development
+	{"minecraft:test_environment", nil},
+	{"minecraft:test_instance", nil},
+}
+
+// Fluids in the order of their registry, which the tags refer to
+var fluids = namespaced("empty", "flowing_water", "water", "flowing_lava", "lava")
+
+// Tags the client can't move without: it swims in the water and lava tags
+// and climbs the climbable blocks.
+var syncedTags = map[string]map[string][]string{
+	"minecraft:fluid": {
+		"minecraft:water": namespaced("water", "flowing_water"),
+		"minecraft:lava":  namespaced("lava", "flowing_lava"),
+	},
+	"minecraft:block": {
+		"minecraft:climbable": namespaced(
+			"ladder", "vine", "scaffolding", "weeping_vines",
+			"weeping_vines_plant", "twisting_vines", "twisting_vines_plant",
+			"cave_vines", "cave_vines_plant",
+		),
+	},
+}
+
+func namespaced(names ...string) []string {
+	for i, name := range names {
+		names[i] = "minecraft:" + name
+	}
+
+	return names
+}
+
+// sendSyncedRegistries sends the biomes and the other registries synced
+// with the client, all from its core pack.
+func (self *client) sendSyncedRegistries() error {
+	if err := self.sendRegistry("minecraft:worldgen/biome", world.Biomes, nil); err != nil {
+		return err
+	}
+
+	for _, registry := range syncedRegistries {
+		if err := self.sendRegistry(registry.name, registry.entries, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// tagID returns the ID of an entry of a tagged registry.
+func tagID(registry string, entry string) (int, bool) {
+	if registry == "minecraft:fluid" {
+		id := slices.Index(fluids, entry)
+		return id, id >= 0
+	}
+
+	return world.RegistryID(registry, entry)
+}
+
+// sendTags sends the tags of syncedTags, the others being empty.
+func (self *client) sendTags() error {
+	data := raw(writeVarInt(len(syncedTags)))
+
+	for registry, tags := range syncedTags {
+		header, err := marshal(registry, len(tags))
+		if err != nil {
+			return err
+		}
+
+		data = append(data, header...)
+
+		for tag, entries := range tags {
+			header, err := marshal(tag, len(entries))
+			if err != nil {
+				return err
+			}
+
+			data = append(data, header...)
+
+			for _, entry := range entries {
+				id, ok := tagID(registry, entry)
+				if !ok {
+					return fmt.Errorf("Unknown %s %s in tag %s", registry, entry, tag)
+				}
+
+				data = append(data, writeVarInt(id)...)
+			}
+		}
+	}
+
+	// update_tags
+	return self.send(0x0d, data)
+}
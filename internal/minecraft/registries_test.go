@@ -0,0 +1,26 @@
+package minecraft
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSyncedRegistries(t *testing.T) {
+	// The client gives the entries the IDs of the vanilla registries, which
+	// are in alphabetical order
+	for _, registry := range syncedRegistries {
+		if !slices.IsSorted(registry.entries) || len(slices.Compact(slices.Clone(registry.entries))) != len(registry.entries) {
+			t.Fatalf("Entries of %s aren't sorted", registry.name)
+		}
+	}
+
+	for registry, tags := range syncedTags {
+		for tag, entries := range tags {
+			for _, entry := range entries {
+				if _, ok := tagID(registry, entry); !ok {
+					t.Fatalf("Unknown %s %s in tag %s", registry, entry, tag)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,215 @@
+package minecraft
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/keyboard-slayer/minecraft-server/internal/world"
+)
+
+// Session is the handle on a connected player given to the code plugged
+// into the server.
+type Session struct {
+	c *client
+}
+
+func (self *client) session() *Session {
+	return &Session{c: self}
+}
+
+func (self *Session) Name() string {
+	return self.c.info.name
+}
+
+func (self *Session) UUID() uuid.UUID {
+	return self.c.info.uuid
+}
+
+// Brand returns the client brand, as sent on the minecraft:brand channel.
+func (self *Session) Brand() string {
+	return self.c.info.brand
+}
+
+// Channels returns the plugin channels the client registered through
+// minecraft:register.
+func (self *Session) Channels() []string {
+	return slices.Clone(self.c.info.channels)
+}
+
+// SendPayload sends a clientbound custom_payload on channel. The contents
+// are encoded the same way as packet fields.
+func (self *Session) SendPayload(channel string, contents ...any) error {
+	return self.c.sendPayload(channel, contents...)
+}
+
+// Transferred reports whether the player was sent over by another server.
+func (self *Session) Transferred() bool {
+	return self.c.info.transferred
+}
+
+// StoreCookie asks the client to keep data under key, up to 5 KiB, so it can
+// be requested back later, possibly by another server after a transfer.
+func (self *Session) StoreCookie(key string, data []byte) error {
+	return self.c.storeCookie(key, data)
+}
+
+// RequestCookie asks the client for the cookie stored under key. The handler
+// runs on the player goroutine once the client answers.
+func (self *Session) RequestCookie(key string, handler CookieHandler) error {
+	return self.c.requestCookie(key, handler)
+}
+
+// Transfer sends the player to another server. The client disconnects and
+// opens a new connection with the transfer intent.
+func (self *Session) Transfer(host string, port uint16) error {
+	return self.c.transfer(host, port)
+}
+
+// Reconfigure sends the player back to the Config state. The configuration
+// handlers run again and the player returns to Play without reconnecting.
+func (self *Session) Reconfigure() error {
+	return self.c.startConfiguration()
+}
+
+// PushResourcePack asks the client to download and apply a resource pack. An
+// empty prompt keeps the default message.
+func (self *Session) PushResourcePack(id uuid.UUID, url string, hash string, forced bool, prompt string) error {
+	return self.c.pushResourcePack(id, url, hash, forced, prompt)
+}
+
+// World returns the name of the world the player is in.
+func (self *Session) World() string {
+	d := self.c.dimension.Load()
+	if d == nil {
+		return ""
+	}
+
+	return d.name
+}
+
+// ChangeWorld moves the player to the spawn point of another loaded world,
+// the client respawning there.
+func (self *Session) ChangeWorld(name string) error {
+	return self.c.changeWorld(name)
+}
+
+// Location returns where the player is, as last moved by the client or
+// teleported by the server.
+func (self *Session) Location() Location {
+	return self.c.location()
+}
+
+// Teleport moves the player within their world.
+func (self *Session) Teleport(loc Location) error {
+	return self.c.teleportTo(loc)
+}
+
+// Latency returns the round trip time to the client, smoothed over the
+// keep alives.
+func (self *Session) Latency() time.Duration {
+	return self.c.latency()
+}
+
+// SetDisplayName replaces the name of the player in the tab list, an empty
+// one showing their username again.
+func (self *Session) SetDisplayName(name string) {
+	self.c.setDisplayName(name)
+}
+
+// SendMessage sends a system message to the player, shown in chat unless
+// they hide it.
+func (self *Session) SendMessage(message string) error {
+	return self.c.sendSystem(message, false)
+}
+
+// SendActionBar shows a message above the hotbar of the player.
+func (self *Session) SendActionBar(message string) error {
+	return self.c.sendSystem(message, true)
+}
+
+// GameMode returns the game mode of the player.
+func (self *Session) GameMode() GameMode {
+	return self.c.gameMode()
+}
+
+// SetGameMode changes the game mode of the player.
+func (self *Session) SetGameMode(mode GameMode) error {
+	return self.c.setGameMode(mode)
+}
+
+// Abilities returns what the player may do, such as flying.
+func (self *Session) Abilities() Abilities {
+	return self.c.getAbilities()
+}
+
+// SetAbilities replaces what the player may do, until their game mode
+// changes.
+func (self *Session) SetAbilities(abilities Abilities) error {
+	return self.c.setAbilities(abilities)
+}
+
+// IsOperator reports whether the player is one of ServerConfig.Operators.
+func (self *Session) IsOperator() bool {
+	return self.c.isOperator()
+}
+
+// Give adds a stack to the inventory of the player, returning how many
+// items didn't fit.
+func (self *Session) Give(stack ItemStack) (int, error) {
+	return self.c.give(stack)
+}
+
+// Item returns the stack in a slot of the inventory window, from 0 for the
+// crafting result to 45 for the off hand.
+func (self *Session) Item(slot int) (ItemStack, error) {
+	return self.c.item(slot)
+}
+
+// SetItem replaces the stack in a slot of the inventory window.
+func (self *Session) SetItem(slot int, stack ItemStack) error {
+	return self.c.setItem(slot, stack)
+}
+
+// HeldItem returns the stack in the main hand of the player.
+func (self *Session) HeldItem() ItemStack {
+	self.c.inventory.lock.Lock()
+	defer self.c.inventory.lock.Unlock()
+
+	return self.c.inventory.slots[self.c.inventory.handSlot(mainHand)]
+}
+
+// Kick disconnects the player, showing them reason.
+func (self *Session) Kick(reason string) {
+	self.c.disconnect(reason)
+}
+
+func (self *Session) Locale() string {
+	return self.c.settings().locale
+}
+
+// ViewDistance returns the render distance set by the client, in chunks.
+func (self *Session) ViewDistance() int {
+	return int(self.c.settings().viewDistance)
+}
+
+func (self *Session) IsHandLeft() bool {
+	return self.c.settings().isHandLeft
+}
+
+// SkinParts returns the bit mask of the displayed skin layers: cape, jacket,
+// left and right sleeves, left and right pants legs and hat.
+func (self *Session) SkinParts() uint8 {
+	return self.c.settings().skinPart
+}
+
+// SendChunk shows a chunk column to the player, replacing the one the
+// client had at the same coordinates.
+func (self *Session) SendChunk(chunk *world.Chunk) error {
+	return self.c.sendChunk(chunk)
+}
+
+// ForgetChunk unloads a chunk column on the client side.
+func (self *Session) ForgetChunk(x int32, z int32) error {
+	return self.c.forgetChunk(x, z)
+}
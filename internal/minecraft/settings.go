@@ -0,0 +1,102 @@
+package minecraft
+
+import "fmt"
+
+// SettingsHandler runs on the player goroutine when a player in Play changes
+// their client options.
+type SettingsHandler func(s *Session) error
+
+// OnSettingsChanged registers a handler run every time a player in Play
+// sends new client options.
+func (self *Server) OnSettingsChanged(handler SettingsHandler) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	self.settingsHandlers = append(self.settingsHandlers, handler)
+}
+
+// settings returns a copy of the client options, safe to read from other
+// goroutines.
+func (self *client) settings() userConfig {
+	self.cfgLock.RLock()
+	defer self.cfgLock.RUnlock()
+
+	return self.info.cfg
+}
+
+func (self *client) setSettings(cfg userConfig) {
+	self.cfgLock.Lock()
+	defer self.cfgLock.Unlock()
+
+	self.info.cfg = cfg
+}
+
+func readClientInformation(c *client, data []byte) error {
+	m, err := readFromBuffer(data,
+		factoryPair{"locale", bytesFactory},
+		factoryPair{"view_distance", byteFactory},
+		factoryPair{"chat_mode", intFactory},
+		factoryPair{"chat_color", byteFactory},
+		factoryPair{"skin_part", byteFactory},
+		factoryPair{"main_hand", intFactory},
+		factoryPair{"filter_text", byteFactory},
+		factoryPair{"allow_listing", byteFactory},
+		factoryPair{"particul_status", intFactory},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	// The modes index the names of their values
+	if mode := m["chat_mode"].(int); mode < int(enabled) || mode > int(hidden) {
+		return fmt.Errorf("Unknown chat mode %d", mode)
+	}
+
+	if status := m["particul_status"].(int); status < int(all) || status > int(minimal) {
+		return fmt.Errorf("Unknown particle status %d", status)
+	}
+
+	cfg := userConfig{
+		locale:       string(m["locale"].([]byte)),
+		viewDistance: int8(m["view_distance"].(byte)),
+		chat:         chatMode(m["chat_mode"].(int)),
+		chatColors:   m["chat_color"].(byte) == 0x01,
+		skinPart:     uint8(m["skin_part"].(byte)),
+		isHandLeft:   m["main_hand"].(int) == 0x00,
+		textFiltered: m["filter_text"].(byte) == 0x01,
+		allowListing: m["allow_listing"].(byte) == 0x01,
+		particul:     particulStatus(m["particul_status"].(int)),
+	}
+
+	previous := c.settings()
+	c.setSettings(cfg)
+
+	c.logger.Debug("",
+		"locale", cfg.locale,
+		"viewDistance", cfg.viewDistance,
+		"chatMode", cfg.chat.string(),
+		"chatColors", cfg.chatColors,
+		"skinPart", cfg.skinPart,
+		"isHandLeft", cfg.isHandLeft,
+		"textFiltered", cfg.textFiltered,
+		"allowListing", cfg.allowListing,
+		"particul", cfg.particul.string(),
+	)
+
+	if c.state.Load() != Play || previous == cfg {
+		return nil
+	}
+
+	c.server.lock.RLock()
+	handlers := c.server.settingsHandlers
+	c.server.lock.RUnlock()
+
+	for _, handler := range handlers {
+		if err := handler(c.session()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -1,47 +1,134 @@
 package minecraft
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"net"
+	"sync"
+	"sync/atomic"
+	"text/template"
+	"time"
 
 	"log/slog"
 )
 
 type Server struct {
-	socket net.Listener
+	socket   net.Listener
+	lock     sync.RWMutex
+	cfg      ServerConfig
+	channels *channelRegistry
+	commands *commandRegistry
+	clients  map[int]*client
+	worlds   []*dimension
+	autosave atomic.Bool
+	done     chan struct{}
+	// closing is set once Close started, stopped closed once it is over
+	closing atomic.Bool
+	stopped chan struct{}
+	// handling counts the connections being served, waited for by Close
+	handling sync.WaitGroup
+
+	tickLock  sync.Mutex
+	tickTimes []time.Time
+
+	// tabHeader and tabFooter are TabHeader and TabFooter of the
+	// configuration once parsed
+	tabHeader *template.Template
+	tabFooter *template.Template
+
+	configurationHandlers []ConfigurationHandler
+	settingsHandlers      []SettingsHandler
+	chatHandlers          []ChatHandler
 }
 
-func New(port uint16) (Server, error) {
-	address := fmt.Sprintf("0.0.0.0:%d", port)
+func New(cfg ServerConfig) (*Server, error) {
+	address := fmt.Sprintf("0.0.0.0:%d", cfg.Port)
 
 	listener, err := net.Listen("tcp", address)
 	if err != nil {
-		return Server{}, err
+		return nil, err
+	}
+
+	serv := &Server{
+		socket:   listener,
+		cfg:      cfg,
+		channels: newChannelRegistry(),
+		commands: newCommandRegistry(),
+		clients:  make(map[int]*client),
+		done:     make(chan struct{}),
+		stopped:  make(chan struct{}),
+	}
+
+	serv.tabHeader, serv.tabFooter, err = parseTabList(cfg.TabHeader, cfg.TabFooter)
+	if err != nil {
+		listener.Close()
+		return nil, err
+	}
+
+	if _, err := parseChatFormat(cfg.ChatFormat); err != nil {
+		listener.Close()
+		return nil, err
 	}
 
-	return Server{socket: listener}, nil
+	serv.registerDefaultChannels()
+
+	if err := serv.registerDefaultCommands(); err != nil {
+		listener.Close()
+		return nil, err
+	}
+
+	if err := serv.openWorlds(); err != nil {
+		listener.Close()
+		return nil, err
+	}
+
+	return serv, nil
 }
 
-func (self Server) Serve() {
+func (self *Server) Serve() {
 	slog.Info(fmt.Sprintf("Serving server on %s", self.socket.Addr().String()))
 	clientId := 0
 
+	go self.tickLoop()
+
+	if self.cfg.AutosaveInterval > 0 {
+		go self.autosaveLoop(self.cfg.AutosaveInterval)
+	}
+
 	for {
 		conn, err := self.socket.Accept()
-		if err != nil {
+		if errors.Is(err, net.ErrClosed) {
+			// Let the world be saved before the program exits
+			<-self.stopped
+			return
+		} else if err != nil {
 			fmt.Println("Couldn't handle client: ", err)
 			continue
 		}
 
 		slog.Info(fmt.Sprintf("New connection from %s", conn.RemoteAddr().String()))
 
+		// Close may be waiting for the connections already
+		self.lock.Lock()
+		if self.closing.Load() {
+			self.lock.Unlock()
+			conn.Close()
+			continue
+		}
+
+		self.handling.Add(1)
+		self.lock.Unlock()
+
 		go self.handle(conn, clientId)
 		clientId += 1
 	}
 }
 
-func (self Server) handle(socket net.Conn, clientId int) {
-	c, err := newClient(socket, clientId)
+func (self *Server) handle(socket net.Conn, clientId int) {
+	defer self.handling.Done()
+
+	c, err := newClient(self, socket, clientId)
 
 	if err != nil {
 		slog.Error("Couldn't create client object", "error", err)
@@ -50,9 +137,15 @@ func (self Server) handle(socket net.Conn, clientId int) {
 
 	defer c.close()
 
+	self.join(&c)
+	defer self.leave(&c)
+
 	for {
 		byte, err := c.reader.Peek(1)
-		if err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, net.ErrClosed) {
+			c.logger.Info("Disconnected")
+			return
+		} else if err != nil {
 			continue
 		}
 
@@ -85,3 +178,111 @@ func (self Server) handle(socket net.Conn, clientId int) {
 		}
 	}
 }
+
+// Close stops accepting players, disconnects the ones online and waits
+// for them to be saved, then saves the world, whatever the state of the
+// automatic saving.
+func (self *Server) Close() error {
+	if !self.closing.CompareAndSwap(false, true) {
+		return nil
+	}
+
+	defer close(self.stopped)
+	close(self.done)
+
+	if err := self.socket.Close(); err != nil {
+		return err
+	}
+
+	self.lock.RLock()
+	clients := make([]*client, 0, len(self.clients))
+	for _, c := range self.clients {
+		clients = append(clients, c)
+	}
+	self.lock.RUnlock()
+
+	for _, c := range clients {
+		c.disconnect("Server closed")
+	}
+
+	// The players are saved as they leave, into worlds still open
+	self.handling.Wait()
+
+	if err := self.SaveAll(); err != nil {
+		return err
+	}
+
+	for _, d := range self.worlds {
+		if err := d.world.Close(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Stop closes the server without waiting for it, so the players can stop
+// it from their goroutine, which Close waits for.
+func (self *Server) Stop() {
+	go func() {
+		if err := self.Close(); err != nil {
+			slog.Error("Couldn't stop the server", "error", err)
+		}
+	}()
+}
+
+func (self *Server) join(c *client) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	// Close disconnected the players before this one joined
+	if self.closing.Load() {
+		c.socket.Close()
+	}
+
+	self.clients[c.id] = c
+}
+
+func (self *Server) leave(c *client) {
+	self.lock.Lock()
+	delete(self.clients, c.id)
+	self.lock.Unlock()
+
+	self.unlist(c)
+
+	c.inventory.lock.Lock()
+	c.inventory.putBack()
+	c.inventory.lock.Unlock()
+
+	if err := c.savePlayer(); err != nil {
+		c.logger.Error("Couldn't save the player", "error", err)
+	}
+}
+
+// config returns a copy of the configuration, safe to use while it is being
+// updated.
+func (self *Server) config() ServerConfig {
+	self.lock.RLock()
+	defer self.lock.RUnlock()
+
+	return self.cfg
+}
+
+// broadcast runs fn for every client past the login, logging the failures
+// instead of stopping at the first one.
+func (self *Server) broadcast(fn func(c *client) error) {
+	self.lock.RLock()
+	clients := make([]*client, 0, len(self.clients))
+	for _, c := range self.clients {
+		if c.state.Load() == Config || c.state.Load() == Play {
+			clients = append(clients, c)
+		}
+	}
+	self.lock.RUnlock()
+
+	for _, c := range clients {
+		if err := fn(c); err != nil {
+			c.logger.Error("Couldn't broadcast", "error", err)
+		}
+	}
+}
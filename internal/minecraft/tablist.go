@@ -0,0 +1,294 @@
+package minecraft
+
+import (
+	"strings"
+	"sync"
+	"text/template"
+)
+
+// Actions of player_info_update, each one adding its fields to the entries
+const (
+	addPlayer         byte = 0x01
+	initializeChat    byte = 0x02
+	updateGameMode    byte = 0x04
+	updateListed      byte = 0x08
+	updateLatency     byte = 0x10
+	updateDisplayName byte = 0x20
+)
+
+// Every action a new player is listed with
+const listPlayer = addPlayer | updateGameMode | updateListed | updateLatency | updateDisplayName
+
+// Property is a signed property of a profile, such as the textures of the
+// skin.
+type Property struct {
+	Name      string
+	Value     string
+	Signature string
+}
+
+func encodeProperties(properties []Property) ([]byte, error) {
+	buffer := writeVarInt(len(properties))
+
+	for _, p := range properties {
+		contents := []any{p.Name, p.Value, p.Signature != ""}
+		if p.Signature != "" {
+			contents = append(contents, p.Signature)
+		}
+
+		data, err := marshal(contents...)
+		if err != nil {
+			return []byte{}, err
+		}
+
+		buffer = append(buffer, data...)
+	}
+
+	return buffer, nil
+}
+
+// tabEntry is how a player shows in the tab list of the others.
+type tabEntry struct {
+	lock        sync.Mutex
+	listed      bool
+	displayName string
+}
+
+// infoEntry encodes the fields of the player for the actions.
+func (self *client) infoEntry(actions byte) ([]byte, error) {
+	self.tab.lock.Lock()
+	listed, displayName := self.tab.listed, self.tab.displayName
+	self.tab.lock.Unlock()
+
+	contents := []any{self.info.uuid}
+
+	if actions&addPlayer != 0 {
+		properties, err := encodeProperties(self.info.properties)
+		if err != nil {
+			return []byte{}, err
+		}
+
+		contents = append(contents, self.info.name, raw(properties))
+	}
+
+	if actions&updateGameMode != 0 {
+		contents = append(contents, int(self.gameMode()))
+	}
+
+	if actions&updateListed != 0 {
+		contents = append(contents, listed)
+	}
+
+	if actions&updateLatency != 0 {
+		contents = append(contents, int(self.latency().Milliseconds()))
+	}
+
+	if actions&updateDisplayName != 0 {
+		contents = append(contents, displayName != "")
+		if displayName != "" {
+			contents = append(contents, text(displayName))
+		}
+	}
+
+	return marshal(contents...)
+}
+
+func (self *client) sendInfo(actions byte, players []*client) error {
+	entries := raw(writeVarInt(len(players)))
+
+	for _, p := range players {
+		entry, err := p.infoEntry(actions)
+		if err != nil {
+			return err
+		}
+
+		entries = append(entries, entry...)
+	}
+
+	// player_info_update
+	return self.send(0x3f, actions, entries)
+}
+
+// listed returns the players shown in the tab list, those who made it to
+// Play.
+func (self *Server) listed() []*client {
+	self.lock.RLock()
+	defer self.lock.RUnlock()
+
+	players := make([]*client, 0, len(self.clients))
+	for _, c := range self.clients {
+		c.tab.lock.Lock()
+		if c.tab.listed {
+			players = append(players, c)
+		}
+		c.tab.lock.Unlock()
+	}
+
+	return players
+}
+
+// inPlay runs fn for every player in Play, logging the failures instead of
+// stopping at the first one.
+func (self *Server) inPlay(fn func(c *client) error) {
+	self.broadcast(func(c *client) error {
+		if c.state.Load() != Play {
+			return nil
+		}
+
+		return fn(c)
+	})
+}
+
+// broadcastInfo sends the fields of every listed player for the actions to
+// all the players.
+func (self *Server) broadcastInfo(actions byte) {
+	players := self.listed()
+	if len(players) == 0 {
+		return
+	}
+
+	self.inPlay(func(c *client) error {
+		return c.sendInfo(actions, players)
+	})
+}
+
+// list adds a player entering Play to the tab list, sending them the
+// players already there.
+func (self *Server) list(c *client) error {
+	c.tab.lock.Lock()
+	c.tab.listed = true
+	c.tab.lock.Unlock()
+
+	if err := c.sendInfo(listPlayer, self.listed()); err != nil {
+		return err
+	}
+
+	self.inPlay(func(other *client) error {
+		if other == c {
+			return nil
+		}
+
+		return other.sendInfo(listPlayer, []*client{c})
+	})
+
+	return c.sendTabList()
+}
+
+// unlist removes a leaving player from the tab list of the others.
+func (self *Server) unlist(c *client) {
+	c.tab.lock.Lock()
+	listed := c.tab.listed
+	c.tab.listed = false
+	c.tab.lock.Unlock()
+
+	if !listed {
+		return
+	}
+
+	self.inPlay(func(other *client) error {
+		// player_info_remove
+		return other.send(0x3e, 1, c.info.uuid)
+	})
+}
+
+// setDisplayName replaces the name of the player in the tab list, an empty
+// one showing their username.
+func (self *client) setDisplayName(name string) {
+	self.tab.lock.Lock()
+	self.tab.displayName = name
+	listed := self.tab.listed
+	self.tab.lock.Unlock()
+
+	if !listed {
+		return
+	}
+
+	self.server.inPlay(func(other *client) error {
+		return other.sendInfo(updateDisplayName, []*client{self})
+	})
+}
+
+// tabListData is given to the header and footer templates.
+type tabListData struct {
+	Name   string
+	World  string
+	Ping   int64
+	Online int
+	TPS    float64
+}
+
+func parseTabList(header string, footer string) (*template.Template, *template.Template, error) {
+	h, err := template.New("header").Parse(header)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	f, err := template.New("footer").Parse(footer)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return h, f, nil
+}
+
+// sendTabList sends the header and the footer of the tab list, filled with
+// the current values for the player.
+func (self *client) sendTabList() error {
+	self.server.lock.RLock()
+	cfg := self.server.cfg
+	header, footer := self.server.tabHeader, self.server.tabFooter
+	self.server.lock.RUnlock()
+
+	if cfg.TabHeader == "" && cfg.TabFooter == "" {
+		return nil
+	}
+
+	data := tabListData{
+		Name:   self.info.name,
+		Ping:   self.latency().Milliseconds(),
+		Online: len(self.server.listed()),
+		TPS:    self.server.TPS(),
+	}
+
+	if d := self.dimension.Load(); d != nil {
+		data.World = d.name
+	}
+
+	var h, f strings.Builder
+	if err := header.Execute(&h, data); err != nil {
+		return err
+	}
+
+	if err := footer.Execute(&f, data); err != nil {
+		return err
+	}
+
+	// tab_list
+	return self.send(0x73, text(h.String()), text(f.String()))
+}
+
+// refreshTabList sends the header and the footer again, their values being
+// live.
+func (self *Server) refreshTabList() {
+	self.inPlay(func(c *client) error {
+		return c.sendTabList()
+	})
+}
+
+// SetTabList replaces the templates of the header and the footer of the
+// tab list, see ServerConfig.TabHeader.
+func (self *Server) SetTabList(header string, footer string) error {
+	h, f, err := parseTabList(header, footer)
+	if err != nil {
+		return err
+	}
+
+	self.lock.Lock()
+	self.cfg.TabHeader = header
+	self.cfg.TabFooter = footer
+	self.tabHeader, self.tabFooter = h, f
+	self.lock.Unlock()
+
+	self.refreshTabList()
+	return nil
+}
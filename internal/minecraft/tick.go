@@ -0,0 +1,65 @@
+package minecraft
+
+import (
+	"time"
+)
+
+// Periodic work of the server, counted in ticks
+const (
+	tpsSamples         = 100
+	tabListUpdateTicks = 20
+	latencyUpdateTicks = 600
+	unloadTicks        = 600
+)
+
+// tickLoop runs the periodic work of the server at the vanilla rate,
+// keeping the start of the last ticks to measure the TPS.
+func (self *Server) tickLoop() {
+	ticker := time.NewTicker(tickInterval)
+	defer ticker.Stop()
+
+	tick := 0
+	for {
+		select {
+		case <-self.done:
+			return
+		case <-ticker.C:
+			self.tickLock.Lock()
+			self.tickTimes = append(self.tickTimes, time.Now())
+			if len(self.tickTimes) > tpsSamples {
+				self.tickTimes = self.tickTimes[1:]
+			}
+			self.tickLock.Unlock()
+
+			tick += 1
+			self.trackPlayers()
+
+			if tick%tabListUpdateTicks == 0 {
+				self.refreshTabList()
+			}
+
+			if tick%latencyUpdateTicks == 0 {
+				self.broadcastInfo(updateLatency)
+			}
+
+			if tick%unloadTicks == 0 {
+				self.unloadChunks()
+			}
+		}
+	}
+}
+
+// TPS returns the ticks per second of the last few seconds, at most 20.
+func (self *Server) TPS() float64 {
+	self.tickLock.Lock()
+	defer self.tickLock.Unlock()
+
+	if len(self.tickTimes) < 2 {
+		return float64(time.Second / tickInterval)
+	}
+
+	elapsed := self.tickTimes[len(self.tickTimes)-1].Sub(self.tickTimes[0])
+	tps := float64(len(self.tickTimes)-1) / elapsed.Seconds()
+
+	return min(tps, float64(time.Second/tickInterval))
+}
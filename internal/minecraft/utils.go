@@ -2,6 +2,9 @@ package minecraft
 
 import (
 	"errors"
+	"math"
+
+	"encoding/binary"
 
 	"github.com/google/uuid"
 )
@@ -85,6 +88,20 @@ func bytesFactory(buffer []byte) ([]byte, any, error) {
 	return buffer[sz+length:], ret, nil
 }
 
+// optionalBytesFactory reads a boolean followed, when it is set, by a byte
+// array. A missing array is returned as nil.
+func optionalBytesFactory(buffer []byte) ([]byte, any, error) {
+	if len(buffer) == 0 {
+		return []byte{}, nil, errors.New("unexpected end of buffer while reading optional")
+	}
+
+	if buffer[0] == 0x00 {
+		return buffer[1:], nil, nil
+	}
+
+	return bytesFactory(buffer[1:])
+}
+
 func ushortFactory(buffer []byte) ([]byte, any, error) {
 	return buffer[2:], (int(buffer[0]) << 8) | int(buffer[1]), nil
 }
@@ -93,6 +110,47 @@ func byteFactory(buffer []byte) ([]byte, any, error) {
 	return buffer[1:], buffer[0], nil
 }
 
+func boolFactory(buffer []byte) ([]byte, any, error) {
+	if len(buffer) == 0 {
+		return []byte{}, false, errors.New("unexpected end of buffer while reading bool")
+	}
+
+	return buffer[1:], buffer[0] != 0, nil
+}
+
+// shortFactory reads a signed short, returned as an int.
+func shortFactory(buffer []byte) ([]byte, any, error) {
+	if len(buffer) < 2 {
+		return []byte{}, 0, errors.New("unexpected end of buffer while reading short")
+	}
+
+	return buffer[2:], int(int16(binary.BigEndian.Uint16(buffer))), nil
+}
+
+func floatFactory(buffer []byte) ([]byte, any, error) {
+	if len(buffer) < 4 {
+		return []byte{}, float32(0), errors.New("unexpected end of buffer while reading float")
+	}
+
+	return buffer[4:], math.Float32frombits(binary.BigEndian.Uint32(buffer)), nil
+}
+
+func longFactory(buffer []byte) ([]byte, any, error) {
+	if len(buffer) < 8 {
+		return []byte{}, int64(0), errors.New("unexpected end of buffer while reading long")
+	}
+
+	return buffer[8:], int64(binary.BigEndian.Uint64(buffer)), nil
+}
+
+func doubleFactory(buffer []byte) ([]byte, any, error) {
+	if len(buffer) < 8 {
+		return []byte{}, float64(0), errors.New("unexpected end of buffer while reading double")
+	}
+
+	return buffer[8:], math.Float64frombits(binary.BigEndian.Uint64(buffer)), nil
+}
+
 func readFromBuffer(buffer []byte, pairs ...factoryPair) (map[string]any, error) {
 	results := make(map[string]any)
 
@@ -0,0 +1,370 @@
+package minecraft
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/keyboard-slayer/minecraft-server/internal/world"
+)
+
+// Pace of the chunk sending, following the vanilla server
+const (
+	tickInterval        = 50 * time.Millisecond
+	minViewDistance     = 2
+	startChunksPerTick  = 9
+	maxChunksPerTick    = 64
+	maxUnackedBatches   = 10
+	firstUnackedBatches = 1
+)
+
+// chunkView streams the chunks around a player, nearest first, and unloads
+// the ones leaving the view distance. Chunks go in batches the client
+// acknowledges with the rate it can keep up with.
+//
+// Packets are only sent from the goroutine of the view, the player
+// goroutine merely moving the center and acknowledging the batches.
+type chunkView struct {
+	c       *client
+	world   *world.World
+	lock    sync.Mutex
+	done    chan struct{}
+	stopped chan struct{}
+
+	// Center the player is in, and the one the view was last built around
+	centerX, centerZ int32
+	shownX, shownZ   int32
+	shownRadius      int32
+	recenter         bool
+
+	// loaded is written by the view goroutine only, under the lock
+	loaded map[[2]int32]bool
+	queue  [][2]int32
+
+	chunksPerTick float64
+	quota         float64
+	unacked       int
+	maxUnacked    int
+}
+
+func newChunkView(c *client, w *world.World, x int32, z int32) *chunkView {
+	return &chunkView{
+		c:             c,
+		world:         w,
+		done:          make(chan struct{}),
+		stopped:       make(chan struct{}),
+		centerX:       x,
+		centerZ:       z,
+		recenter:      true,
+		loaded:        make(map[[2]int32]bool),
+		chunksPerTick: startChunksPerTick,
+		maxUnacked:    firstUnackedBatches,
+	}
+}
+
+// startView streams the chunks around a player entering Play or changing
+// world.
+func (self *client) startView() error {
+	w := self.dimension.Load().world
+
+	// game_event
+	if err := self.send(0x22, waitForChunks, float32(0)); err != nil {
+		return err
+	}
+
+	self.stopView()
+
+	x, z := self.location().chunk()
+	view := newChunkView(self, w, x, z)
+
+	self.viewLock.Lock()
+	old := self.view
+	self.view = view
+	self.viewLock.Unlock()
+
+	// Another goroutine may have started a view meanwhile
+	if old != nil {
+		old.stop()
+	}
+
+	go view.run()
+	return nil
+}
+
+// stopView stops the streaming, the client dropping its chunks on its own
+// when it leaves Play or changes world. It waits for the chunk being sent,
+// so none is sent after.
+func (self *client) stopView() {
+	self.viewLock.Lock()
+	view := self.view
+	self.view = nil
+	self.viewLock.Unlock()
+
+	if view != nil {
+		view.stop()
+	}
+}
+
+// stop ends the streaming and waits for it. viewLock isn't held meanwhile,
+// as loading chunks notifies the viewers of their light.
+func (self *chunkView) stop() {
+	close(self.done)
+	<-self.stopped
+}
+
+// currentView returns the view of the player, nil out of Play.
+func (self *client) currentView() *chunkView {
+	self.viewLock.Lock()
+	defer self.viewLock.Unlock()
+
+	return self.view
+}
+
+// radius returns the view distance of the player, which can't be farther
+// than the one of the server.
+func (self *chunkView) radius() int32 {
+	radius := self.c.server.config().ViewDistance
+
+	if client := int(self.c.settings().viewDistance); client > 0 {
+		radius = min(radius, client)
+	}
+
+	return int32(max(radius, minViewDistance))
+}
+
+// move sets the chunk the player is in, the view following on the next
+// tick.
+func (self *chunkView) move(x int32, z int32) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if x != self.centerX || z != self.centerZ {
+		self.centerX, self.centerZ = x, z
+		self.recenter = true
+	}
+}
+
+// has reports whether the chunk at x, z was sent to the player.
+func (self *chunkView) has(x int32, z int32) bool {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	return self.loaded[[2]int32{x, z}]
+}
+
+// sent lists the chunks sent to the player.
+func (self *chunkView) sent() [][2]int32 {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	chunks := make([][2]int32, 0, len(self.loaded))
+	for pos := range self.loaded {
+		chunks = append(chunks, pos)
+	}
+
+	return chunks
+}
+
+// acknowledge handles a chunk_batch_received, the client telling how many
+// chunks per tick it can take.
+func (self *chunkView) acknowledge(chunksPerTick float32) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	rate := float64(chunksPerTick)
+	if math.IsNaN(rate) {
+		rate = 0.01
+	}
+
+	self.chunksPerTick = min(max(rate, 0.01), maxChunksPerTick)
+	self.unacked = max(self.unacked-1, 0)
+	self.maxUnacked = maxUnackedBatches
+}
+
+func (self *chunkView) run() {
+	defer close(self.stopped)
+
+	ticker := time.NewTicker(tickInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-self.done:
+			return
+		case <-self.c.server.done:
+			return
+		case <-ticker.C:
+			if err := self.tick(); err != nil {
+				self.c.logger.Error("Couldn't stream chunks", "error", err)
+				return
+			}
+		}
+	}
+}
+
+func (self *chunkView) tick() error {
+	if err := self.update(); err != nil {
+		return err
+	}
+
+	return self.sendBatch()
+}
+
+func inView(x int32, z int32, centerX int32, centerZ int32, radius int32) bool {
+	return max(abs32(x-centerX), abs32(z-centerZ)) <= radius
+}
+
+func abs32(v int32) int32 {
+	if v < 0 {
+		return -v
+	}
+
+	return v
+}
+
+// update rebuilds the queue when the center or the view distance changed,
+// unloading the chunks out of range.
+func (self *chunkView) update() error {
+	radius := self.radius()
+
+	self.lock.Lock()
+	x, z := self.centerX, self.centerZ
+	recenter := self.recenter
+	self.recenter = false
+	self.lock.Unlock()
+
+	if !recenter && radius == self.shownRadius {
+		return nil
+	}
+
+	if recenter {
+		// set_chunk_cache_center
+		if err := self.c.send(0x57, int(x), int(z)); err != nil {
+			return err
+		}
+	}
+
+	self.shownX, self.shownZ, self.shownRadius = x, z, radius
+
+	for pos := range self.loaded {
+		if inView(pos[0], pos[1], x, z, radius) {
+			continue
+		}
+
+		if err := self.c.forgetChunk(pos[0], pos[1]); err != nil {
+			return err
+		}
+
+		self.lock.Lock()
+		delete(self.loaded, pos)
+		self.lock.Unlock()
+	}
+
+	self.queue = self.queue[:0]
+	for _, pos := range spiral(x, z, radius) {
+		if !self.loaded[pos] {
+			self.queue = append(self.queue, pos)
+		}
+	}
+
+	return nil
+}
+
+// spiral lists the chunks within radius of the center, ring after ring.
+func spiral(x int32, z int32, radius int32) [][2]int32 {
+	positions := make([][2]int32, 0, (2*radius+1)*(2*radius+1))
+	positions = append(positions, [2]int32{x, z})
+
+	for ring := int32(1); ring <= radius; ring++ {
+		// Walk the four sides of the ring, each one stopping before the
+		// corner the next one starts from
+		for i := -ring; i < ring; i++ {
+			positions = append(positions, [2]int32{x + i, z - ring})
+		}
+		for i := -ring; i < ring; i++ {
+			positions = append(positions, [2]int32{x + ring, z + i})
+		}
+		for i := ring; i > -ring; i-- {
+			positions = append(positions, [2]int32{x + i, z + ring})
+		}
+		for i := ring; i > -ring; i-- {
+			positions = append(positions, [2]int32{x - ring, z + i})
+		}
+	}
+
+	return positions
+}
+
+// sendBatch sends as many queued chunks as the client asked for, as long as
+// it keeps acknowledging the previous batches.
+func (self *chunkView) sendBatch() error {
+	self.lock.Lock()
+	if self.unacked >= self.maxUnacked {
+		self.lock.Unlock()
+		return nil
+	}
+
+	self.quota = min(self.quota+self.chunksPerTick, max(1, self.chunksPerTick))
+	count := min(int(self.quota), len(self.queue))
+	self.lock.Unlock()
+
+	if count == 0 {
+		return nil
+	}
+
+	batch := self.queue[:count]
+	self.queue = self.queue[count:]
+
+	// chunk_batch_start
+	if err := self.c.send(0x0c); err != nil {
+		return err
+	}
+
+	sent := 0
+	for _, pos := range batch {
+		chunk, err := self.world.Chunk(pos[0], pos[1])
+		if err != nil {
+			self.c.logger.Error("Couldn't load chunk", "x", pos[0], "z", pos[1], "error", err)
+			continue
+		}
+
+		if chunk == nil {
+			continue
+		}
+
+		if err := self.c.sendChunk(self.world.Snapshot(chunk)); err != nil {
+			return err
+		}
+
+		self.lock.Lock()
+		self.loaded[pos] = true
+		self.lock.Unlock()
+
+		sent += 1
+	}
+
+	// chunk_batch_finished
+	if err := self.c.send(0x0b, sent); err != nil {
+		return err
+	}
+
+	self.lock.Lock()
+	self.quota -= float64(count)
+	self.unacked += 1
+	self.lock.Unlock()
+
+	return nil
+}
+
+func readChunkBatchReceived(c *client, data []byte) error {
+	m, err := readFromBuffer(data, factoryPair{"chunks_per_tick", floatFactory})
+	if err != nil {
+		return err
+	}
+
+	if view := c.currentView(); view != nil {
+		view.acknowledge(m["chunks_per_tick"].(float32))
+	}
+
+	return nil
+}
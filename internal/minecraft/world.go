@@ -0,0 +1,476 @@
+package minecraft
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"time"
+	"unicode/utf16"
+
+	"log/slog"
+
+	"github.com/keyboard-slayer/minecraft-server/internal/world"
+)
+
+// Sea level of the worlds made by the noise generator
+const normalSeaLevel = 63
+
+// Game events, as known by the client
+const (
+	waitForChunks byte = 13
+)
+
+// parseSeed reads a seed like the vanilla server: numbers are used as is,
+// other strings are hashed and no seed at all picks a random one.
+func parseSeed(seed string) int64 {
+	if seed == "" {
+		return rand.Int64()
+	}
+
+	if n, err := strconv.ParseInt(seed, 10, 64); err == nil {
+		return n
+	}
+
+	// Java's String.hashCode
+	var hash int32
+	for _, c := range utf16.Encode([]rune(seed)) {
+		hash = 31*hash + int32(c)
+	}
+
+	return int64(hash)
+}
+
+// levelType returns the level type of a world, normal like vanilla when
+// none is set.
+func levelType(cfg WorldConfig) string {
+	if cfg.LevelType == "" {
+		return "normal"
+	}
+
+	return cfg.LevelType
+}
+
+// newGenerator returns the generator of the level type of a world.
+func newGenerator(cfg WorldConfig, kind DimensionType, seed int64) (world.Generator, error) {
+	switch levelType(cfg) {
+	case "normal":
+		noise := world.NewNoise(seed, kind.MinY, kind.Height)
+		return world.NewPool(noise, runtime.NumCPU()), nil
+	case "flat":
+		preset := cfg.GeneratorSettings
+		if preset == "" {
+			preset = world.DefaultFlatPreset
+		}
+
+		return world.NewFlat(preset, kind.MinY, kind.Height)
+	case "void":
+		biome := cfg.GeneratorSettings
+		if biome == "" {
+			biome = voidBiome(cfg.Name)
+		}
+
+		return world.NewVoid(biome, kind.MinY, kind.Height)
+	default:
+		return nil, fmt.Errorf("Unknown level type %s", cfg.LevelType)
+	}
+}
+
+// voidBiome returns the biome filling an empty world, the one of the
+// vanilla dimension when there is one.
+func voidBiome(name string) string {
+	switch name {
+	case "minecraft:the_nether":
+		return "minecraft:nether_wastes"
+	case "minecraft:the_end":
+		return "minecraft:the_end"
+	default:
+		return "minecraft:the_void"
+	}
+}
+
+// Storages a world can be kept in
+const (
+	AnvilStorage   = "anvil"
+	MemoryStorage  = "memory"
+	CompactStorage = "compact"
+)
+
+// openStore opens the storage of a world. A missing storage is read from a
+// vanilla world folder when there is one, and kept in memory otherwise.
+func openStore(cfg WorldConfig, kind DimensionType) (world.WorldStore, error) {
+	storage := cfg.Storage
+	if storage == "" && cfg.Dir != "" {
+		storage = AnvilStorage
+	} else if storage == "" {
+		storage = MemoryStorage
+	}
+
+	switch storage {
+	case AnvilStorage:
+		return world.OpenDimension(cfg.Dir, cfg.Name, kind.MinY, kind.Height)
+	case CompactStorage:
+		return world.OpenCompact(cfg.Dir, kind.MinY, kind.Height)
+	case MemoryStorage:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("Unknown world storage %s", storage)
+	}
+}
+
+// dimension is a world loaded by the server, along with the dimension type
+// it is sent to the players as.
+type dimension struct {
+	name     string
+	kind     DimensionType
+	typeID   int
+	world    *world.World
+	flat     bool
+	seaLevel int
+}
+
+// openWorlds opens the worlds set in the configuration, closing the ones
+// already opened when one of them fails.
+func (self *Server) openWorlds() error {
+	if len(self.cfg.Worlds) == 0 {
+		return errors.New("There is no world to load")
+	}
+
+	for _, cfg := range self.cfg.Worlds {
+		d, err := self.openWorld(cfg)
+		if err != nil {
+			for _, d := range self.worlds {
+				d.world.Close()
+			}
+
+			return fmt.Errorf("%s: %w", cfg.Name, err)
+		}
+
+		self.worlds = append(self.worlds, d)
+	}
+
+	return nil
+}
+
+// openWorld opens a world from its storage, or makes a world kept in
+// memory when it has none.
+func (self *Server) openWorld(cfg WorldConfig) (*dimension, error) {
+	if self.dimension(cfg.Name) != nil {
+		return nil, errors.New("The world is loaded twice")
+	}
+
+	typeName := cfg.Type
+	if typeName == "" {
+		typeName = cfg.Name
+	}
+
+	kind, id, err := dimensionType(self.cfg, typeName)
+	if err != nil {
+		return nil, err
+	}
+
+	store, err := openStore(cfg, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	var w *world.World
+	if store == nil {
+		seed := parseSeed(cfg.Seed)
+
+		gen, err := newGenerator(cfg, kind, seed)
+		if err != nil {
+			return nil, err
+		}
+
+		w = world.New(cfg.Name, kind.MinY, kind.Height, gen)
+		w.Level.Seed = seed
+	} else {
+		level, err := store.LoadLevel()
+		created := errors.Is(err, os.ErrNotExist)
+		if created {
+			// A new world, made from the seed set and saved right away so
+			// that it generates the same after a restart
+			level = world.Level{
+				Name:        filepath.Base(cfg.Dir),
+				DataVersion: world.DataVersion,
+				Seed:        parseSeed(cfg.Seed),
+			}
+		} else if err != nil {
+			store.Close()
+			return nil, err
+		}
+
+		// The world keeps generating from the seed it was made with
+		gen, err := newGenerator(cfg, kind, level.Seed)
+		if err != nil {
+			store.Close()
+			return nil, err
+		}
+
+		if created {
+			level.SpawnY = gen.SpawnHeight(0, 0)
+			if err := store.SaveLevel(level); err != nil {
+				store.Close()
+				return nil, err
+			}
+		}
+
+		w = world.NewWithStore(store, level, kind.MinY, kind.Height)
+		w.Generator = gen
+
+		slog.Info("Opened world", "name", cfg.Name, "level", level.Name, "spawn", []int{level.SpawnX, level.SpawnY, level.SpawnZ})
+
+		self.autosave.Store(true)
+	}
+
+	d := &dimension{
+		name:   cfg.Name,
+		kind:   kind,
+		typeID: id,
+		world:  w,
+		flat:   levelType(cfg) == "flat",
+	}
+
+	if levelType(cfg) == "normal" {
+		d.seaLevel = normalSeaLevel
+	}
+
+	w.OnLightChanged(func(x int32, z int32) {
+		self.lightChanged(d, x, z)
+	})
+
+	return d, nil
+}
+
+// dimension returns the world named name, or nil when there is none.
+func (self *Server) dimension(name string) *dimension {
+	for _, d := range self.worlds {
+		if d.name == name {
+			return d
+		}
+	}
+
+	return nil
+}
+
+// Worlds lists the names of the loaded worlds, the one players join first.
+func (self *Server) Worlds() []string {
+	names := make([]string, len(self.worlds))
+	for i, d := range self.worlds {
+		names[i] = d.name
+	}
+
+	return names
+}
+
+// World returns the world named name, or nil when it isn't loaded.
+func (self *Server) World(name string) *world.World {
+	if d := self.dimension(name); d != nil {
+		return d.world
+	}
+
+	return nil
+}
+
+// SaveAll writes the players and the modified chunks to the world folder,
+// even when the automatic saving is turned off, like the save-all command.
+func (self *Server) SaveAll() error {
+	if err := self.savePlayers(); err != nil {
+		return err
+	}
+
+	for _, d := range self.worlds {
+		slog.Info("Saving the world", "name", d.name)
+
+		if err := d.world.Save(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SetAutosave turns the periodic saving on or off, like the save-on and
+// save-off commands, letting the world folder be copied safely.
+func (self *Server) SetAutosave(enabled bool) {
+	self.autosave.Store(enabled)
+}
+
+func (self *Server) autosaveLoop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-self.done:
+			return
+		case <-ticker.C:
+			if !self.autosave.Load() {
+				continue
+			}
+
+			if err := self.SaveAll(); err != nil {
+				slog.Error("Couldn't save the world", "error", err)
+			}
+		}
+	}
+}
+
+// unloadChunks drops the saved chunks of the worlds no player sees, so
+// the memory doesn't grow with every chunk ever visited. The modified ones
+// stay until the next save.
+func (self *Server) unloadChunks() {
+	viewed := make(map[*dimension]map[[2]int32]bool)
+
+	self.lock.RLock()
+	for _, c := range self.clients {
+		d, view := c.dimension.Load(), c.currentView()
+		if d == nil || view == nil {
+			continue
+		}
+
+		if viewed[d] == nil {
+			viewed[d] = make(map[[2]int32]bool)
+		}
+
+		for _, pos := range view.sent() {
+			viewed[d][pos] = true
+		}
+	}
+	self.lock.RUnlock()
+
+	for _, d := range self.worlds {
+		count := d.world.Unload(func(x int32, z int32) bool {
+			return viewed[d][[2]int32{x, z}]
+		})
+
+		if count > 0 {
+			slog.Debug("Unloaded chunks", "world", d.name, "count", count)
+		}
+	}
+}
+
+// viewers returns the players of the world d the chunk at x, z was sent to.
+func (self *Server) viewers(d *dimension, x int32, z int32) []*client {
+	self.lock.RLock()
+	defer self.lock.RUnlock()
+
+	viewers := make([]*client, 0)
+	for _, c := range self.clients {
+		if view := c.currentView(); c.state.Load() == Play && c.dimension.Load() == d && view != nil && view.has(x, z) {
+			viewers = append(viewers, c)
+		}
+	}
+
+	return viewers
+}
+
+// SetBlock changes a block of a world, sending it to the players who see
+// it along with the light it changed.
+func (self *Server) SetBlock(name string, x int, y int, z int, state uint16) error {
+	d := self.dimension(name)
+	if d == nil {
+		return fmt.Errorf("Unknown world %s", name)
+	}
+
+	if err := d.world.SetBlock(x, y, z, state); err != nil {
+		return err
+	}
+
+	for _, c := range self.viewers(d, int32(x>>4), int32(z>>4)) {
+		if err := c.sendBlockUpdate(x, y, z, state); err != nil {
+			c.logger.Error("Couldn't send block update", "error", err)
+		}
+	}
+
+	return nil
+}
+
+func (self *Server) lightChanged(d *dimension, x int32, z int32) {
+	chunk, err := d.world.Chunk(x, z)
+	if err != nil || chunk == nil {
+		return
+	}
+
+	chunk = d.world.Snapshot(chunk)
+	for _, c := range self.viewers(d, x, z) {
+		if err := c.sendLightUpdate(chunk); err != nil {
+			c.logger.Error("Couldn't send light update", "error", err)
+		}
+	}
+}
+
+// spawnInfo encodes the world of the player the way the login and respawn
+// packets share it.
+func (self *client) spawnInfo() (raw, error) {
+	d := self.dimension.Load()
+
+	buffer, err := marshal(
+		d.typeID, d.name, hashedSeed(d.world.Level.Seed),
+		byte(self.gameMode()), byte(self.previousGameMode()), false, d.flat, false, 10, d.seaLevel,
+	)
+
+	return raw(buffer), err
+}
+
+// Data kept by the client on respawn
+const keepEverything byte = 0x03
+
+// changeWorld moves the player to the spawn point of another world.
+func (self *client) changeWorld(name string) error {
+	if self.state.Load() != Play {
+		return fmt.Errorf("Can't change world in state %s", self.state.Load().string())
+	}
+
+	d := self.server.dimension(name)
+	if d == nil {
+		return fmt.Errorf("Unknown world %s", name)
+	}
+
+	self.stopView()
+	self.tracker.reset()
+	self.dimension.Store(d)
+
+	info, err := self.spawnInfo()
+	if err != nil {
+		return err
+	}
+
+	// respawn
+	if err := self.send(0x4b, info, keepEverything); err != nil {
+		return err
+	}
+
+	if err := self.teleportTo(spawnLocation(d.world)); err != nil {
+		return err
+	}
+
+	if err := self.sendPlayerState(); err != nil {
+		return err
+	}
+
+	return self.startView()
+}
+
+// sendPlayerState sends what the client resets on login and respawn: the
+// inventory, the selected slot, the health and the experience, and the
+// abilities.
+func (self *client) sendPlayerState() error {
+	if err := self.sendInventory(); err != nil {
+		return err
+	}
+
+	if err := self.sendHeldSlot(); err != nil {
+		return err
+	}
+
+	if err := self.sendStats(); err != nil {
+		return err
+	}
+
+	return self.sendAbilities()
+}
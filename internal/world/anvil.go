@@ -0,0 +1,791 @@
+package world
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"compress/gzip"
+	"compress/zlib"
+	"encoding/binary"
+
+	"github.com/beito123/nbt"
+)
+
+const (
+	sectorSize    = 4096
+	regionChunks  = 32
+	headerSectors = 2
+)
+
+// Chunk compression schemes, the high bit telling the chunk is stored in
+// its own .mcc file because it didn't fit in 255 sectors.
+const (
+	compressionGzip     = 1
+	compressionZlib     = 2
+	compressionNone     = 3
+	compressionLZ4      = 4
+	compressionExternal = 0x80
+)
+
+// Region is a region/r.<x>.<z>.mca file, holding 32x32 chunks. Its header
+// gives the location and the modification time of every chunk.
+type Region struct {
+	path       string
+	file       *os.File
+	locations  [regionChunks * regionChunks]uint32
+	timestamps [regionChunks * regionChunks]uint32
+}
+
+// OpenRegion opens a region file for reading and writing. With create, a
+// missing file is created with an empty header.
+func OpenRegion(path string, create bool) (*Region, error) {
+	flags := os.O_RDWR
+	if create {
+		flags |= os.O_CREATE
+	}
+
+	file, err := os.OpenFile(path, flags, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Region{path: path, file: file}
+
+	header := make([]byte, headerSectors*sectorSize)
+	n, err := io.ReadFull(file, header)
+
+	if n == 0 && create {
+		if _, err := file.WriteAt(header, 0); err != nil {
+			file.Close()
+			return nil, err
+		}
+	} else if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("%s: couldn't read header: %w", path, err)
+	}
+
+	for i := range r.locations {
+		r.locations[i] = binary.BigEndian.Uint32(header[i*4:])
+		r.timestamps[i] = binary.BigEndian.Uint32(header[sectorSize+i*4:])
+	}
+
+	return r, nil
+}
+
+func (self *Region) Close() error {
+	return self.file.Close()
+}
+
+func chunkIndex(x int32, z int32) int {
+	return int(x&(regionChunks-1)) + int(z&(regionChunks-1))*regionChunks
+}
+
+// Read returns the uncompressed NBT of the chunk at x, z, or nil when the
+// chunk was never saved.
+func (self *Region) Read(x int32, z int32) ([]byte, error) {
+	location := self.locations[chunkIndex(x, z)]
+	if location == 0 {
+		return nil, nil
+	}
+
+	offset := int64(location>>8) * sectorSize
+	sectors := int(location & 0xff)
+
+	buffer := make([]byte, sectors*sectorSize)
+	if _, err := self.file.ReadAt(buffer, offset); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	length := int(binary.BigEndian.Uint32(buffer))
+	if length == 0 || length+4 > len(buffer) {
+		return nil, fmt.Errorf("%s: chunk %d %d has a bad length", self.path, x, z)
+	}
+
+	compression := buffer[4]
+	data := buffer[5 : 4+length]
+
+	if compression&compressionExternal != 0 {
+		external := filepath.Join(filepath.Dir(self.path), fmt.Sprintf("c.%d.%d.mcc", x, z))
+
+		content, err := os.ReadFile(external)
+		if err != nil {
+			return nil, err
+		}
+
+		compression &^= compressionExternal
+		data = content
+	}
+
+	return decompress(compression, data)
+}
+
+func decompress(compression byte, data []byte) ([]byte, error) {
+	switch compression {
+	case compressionGzip:
+		reader, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+
+		defer reader.Close()
+		return io.ReadAll(reader)
+	case compressionZlib:
+		reader, err := zlib.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+
+		defer reader.Close()
+		return io.ReadAll(reader)
+	case compressionNone:
+		return data, nil
+	case compressionLZ4:
+		return decompressLZ4(data)
+	default:
+		return nil, fmt.Errorf("Unsupported chunk compression %d", compression)
+	}
+}
+
+// Write compresses and stores the NBT of the chunk at x, z. The data goes to
+// free sectors before the header points to it, so a crash leaves either the
+// old or the new chunk, never a mix of both.
+func (self *Region) Write(x int32, z int32, data []byte) error {
+	var compressed bytes.Buffer
+
+	writer := zlib.NewWriter(&compressed)
+	if _, err := writer.Write(data); err != nil {
+		return err
+	}
+
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	external := filepath.Join(filepath.Dir(self.path), fmt.Sprintf("c.%d.%d.mcc", x, z))
+	payload := binary.BigEndian.AppendUint32([]byte{}, uint32(compressed.Len()+1))
+	payload = append(payload, compressionZlib)
+	payload = append(payload, compressed.Bytes()...)
+
+	sectors := (len(payload) + sectorSize - 1) / sectorSize
+	isExternal := sectors > 0xff
+
+	if isExternal {
+		if err := writeFileAtomic(external, compressed.Bytes()); err != nil {
+			return err
+		}
+
+		payload = []byte{0, 0, 0, 1, compressionZlib | compressionExternal}
+		sectors = 1
+	}
+
+	payload = append(payload, make([]byte, sectors*sectorSize-len(payload))...)
+
+	index := chunkIndex(x, z)
+	offset := self.allocate(sectors)
+
+	if _, err := self.file.WriteAt(payload, int64(offset)*sectorSize); err != nil {
+		return err
+	}
+
+	if err := self.file.Sync(); err != nil {
+		return err
+	}
+
+	self.locations[index] = uint32(offset)<<8 | uint32(sectors)
+	self.timestamps[index] = uint32(time.Now().Unix())
+
+	header := binary.BigEndian.AppendUint32([]byte{}, self.locations[index])
+	if _, err := self.file.WriteAt(header, int64(index*4)); err != nil {
+		return err
+	}
+
+	timestamp := binary.BigEndian.AppendUint32([]byte{}, self.timestamps[index])
+	if _, err := self.file.WriteAt(timestamp, int64(sectorSize+index*4)); err != nil {
+		return err
+	}
+
+	if err := self.file.Sync(); err != nil {
+		return err
+	}
+
+	if !isExternal {
+		os.Remove(external)
+	}
+
+	return nil
+}
+
+// allocate returns the first run of count sectors used by no chunk, the
+// chunk being rewritten included.
+func (self *Region) allocate(count int) int {
+	used := make([]bool, headerSectors)
+	for i := range used {
+		used[i] = true
+	}
+
+	for _, location := range self.locations {
+		offset := int(location >> 8)
+		for i := offset; i < offset+int(location&0xff); i++ {
+			for len(used) <= i {
+				used = append(used, false)
+			}
+
+			used[i] = true
+		}
+	}
+
+	run := 0
+	for i, u := range used {
+		if u {
+			run = 0
+			continue
+		}
+
+		run += 1
+		if run == count {
+			return i - count + 1
+		}
+	}
+
+	return len(used) - run
+}
+
+func writeFileAtomic(path string, data []byte) error {
+	tmp := path + ".tmp"
+
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
+
+// Anvil stores a world in a vanilla world folder: the chunks and the
+// entities in region files, opened as they are needed, and the metadata in
+// level.dat.
+type Anvil struct {
+	root    string
+	dir     string
+	minY    int
+	height  int
+	lock    sync.Mutex
+	regions map[regionKey]*Region
+}
+
+type regionKey struct {
+	folder string
+	x, z   int32
+}
+
+// Folders of the region files
+const (
+	chunkFolder  = "region"
+	entityFolder = "entities"
+)
+
+// OpenAnvil opens the dimension stored in dir, the folder holding the
+// region folder, creating it for a new world. minY and height are those of
+// the dimension type.
+func OpenAnvil(dir string, minY int, height int) (*Anvil, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	return &Anvil{
+		root:    dir,
+		dir:     dir,
+		minY:    minY,
+		height:  height,
+		regions: make(map[regionKey]*Region),
+	}, nil
+}
+
+// DimensionFolder returns the folder of a dimension in the world folder
+// root, where vanilla keeps it.
+func DimensionFolder(root string, dimension string) string {
+	switch dimension {
+	case "minecraft:overworld":
+		return root
+	case "minecraft:the_nether":
+		return filepath.Join(root, "DIM-1")
+	case "minecraft:the_end":
+		return filepath.Join(root, "DIM1")
+	}
+
+	namespace, path, ok := strings.Cut(dimension, ":")
+	if !ok {
+		namespace, path = "minecraft", dimension
+	}
+
+	return filepath.Join(root, "dimensions", namespace, path)
+}
+
+// OpenDimension opens a dimension of the world folder root, which doesn't
+// need to have been generated yet, creating the folder of a new world. The
+// level is the one of the world, only saved along with the overworld, and
+// its loading fails with os.ErrNotExist until it is first saved.
+func OpenDimension(root string, dimension string, minY int, height int) (*Anvil, error) {
+	if err := os.MkdirAll(root, 0755); err != nil {
+		return nil, err
+	}
+
+	return &Anvil{
+		root:    root,
+		dir:     DimensionFolder(root, dimension),
+		minY:    minY,
+		height:  height,
+		regions: make(map[regionKey]*Region),
+	}, nil
+}
+
+func (self *Anvil) region(folder string, x int32, z int32, create bool) (*Region, error) {
+	key := regionKey{folder, x >> 5, z >> 5}
+
+	if r, ok := self.regions[key]; ok {
+		return r, nil
+	}
+
+	if create {
+		if err := os.MkdirAll(filepath.Join(self.dir, folder), 0755); err != nil {
+			return nil, err
+		}
+	}
+
+	path := filepath.Join(self.dir, folder, fmt.Sprintf("r.%d.%d.mca", key.x, key.z))
+
+	r, err := OpenRegion(path, create)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	self.regions[key] = r
+	return r, nil
+}
+
+// read returns the uncompressed NBT of a chunk stored in folder, or nil
+// when there is none.
+func (self *Anvil) read(folder string, x int32, z int32) (nbt.Tag, error) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	r, err := self.region(folder, x, z, false)
+	if err != nil || r == nil {
+		return nil, err
+	}
+
+	data, err := r.Read(x, z)
+	if err != nil || data == nil {
+		return nil, err
+	}
+
+	return readNBT(data)
+}
+
+func (self *Anvil) write(folder string, x int32, z int32, tag nbt.Tag) error {
+	data, err := writeNBT(tag)
+	if err != nil {
+		return err
+	}
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	r, err := self.region(folder, x, z, true)
+	if err != nil {
+		return err
+	}
+
+	return r.Write(x, z, data)
+}
+
+func (self *Anvil) LoadLevel() (Level, error) {
+	return ReadLevel(self.root)
+}
+
+func (self *Anvil) SaveLevel(level Level) error {
+	if self.dir != self.root {
+		return nil
+	}
+
+	return WriteLevel(self.root, level)
+}
+
+// LoadChunk returns the chunk at x, z or nil when it wasn't generated.
+func (self *Anvil) LoadChunk(x int32, z int32) (*Chunk, error) {
+	tag, err := self.read(chunkFolder, x, z)
+	if err != nil || tag == nil {
+		return nil, err
+	}
+
+	// Partially generated chunks are left to the generator
+	if status := stringOf(tag, "Status"); status != "minecraft:full" && status != "full" {
+		return nil, nil
+	}
+
+	return decodeChunk(tag, x, z, self.minY, self.height)
+}
+
+// SaveChunk writes chunk to its region file, creating it when needed.
+func (self *Anvil) SaveChunk(chunk *Chunk) error {
+	tag, err := encodeChunk(chunk)
+	if err != nil {
+		return err
+	}
+
+	return self.write(chunkFolder, chunk.X, chunk.Z, tag)
+}
+
+// LoadEntities returns the entities saved in the chunk at x, z.
+func (self *Anvil) LoadEntities(x int32, z int32) ([]nbt.Tag, error) {
+	tag, err := self.read(entityFolder, x, z)
+	if err != nil || tag == nil {
+		return []nbt.Tag{}, err
+	}
+
+	return listOf(tag, "Entities"), nil
+}
+
+// LoadPlayer reads the player data kept at the root of the world folder,
+// shared by all its dimensions.
+func (self *Anvil) LoadPlayer(id string) (*PlayerData, error) {
+	return ReadPlayerData(self.root, id)
+}
+
+func (self *Anvil) SavePlayer(id string, player PlayerData) error {
+	return WritePlayerData(self.root, id, player)
+}
+
+// encodeEntities wraps the entities of a chunk the way the entity region
+// files store them.
+func encodeEntities(x int32, z int32, entities []nbt.Tag) nbt.Tag {
+	return nbt.NewCompoundTag("", map[string]nbt.Tag{
+		"DataVersion": nbt.NewIntTag("DataVersion", DataVersion),
+		"Position":    nbt.NewIntArrayTag("Position", []int32{x, z}),
+		"Entities":    nbt.NewListTag("Entities", entities, nbt.IDTagCompound),
+	})
+}
+
+// Chunks lists the position of every chunk in the region files.
+func (self *Anvil) Chunks() ([][2]int32, error) {
+	files, err := filepath.Glob(filepath.Join(self.dir, chunkFolder, "r.*.*.mca"))
+	if err != nil {
+		return nil, err
+	}
+
+	positions := make([][2]int32, 0)
+	for _, file := range files {
+		var rx, rz int32
+		if _, err := fmt.Sscanf(filepath.Base(file), "r.%d.%d.mca", &rx, &rz); err != nil {
+			continue
+		}
+
+		self.lock.Lock()
+		r, err := self.region(chunkFolder, rx<<5, rz<<5, false)
+		if err != nil {
+			self.lock.Unlock()
+			return nil, err
+		}
+
+		for i, location := range r.locations {
+			if location != 0 {
+				positions = append(positions, [2]int32{rx<<5 | int32(i%regionChunks), rz<<5 | int32(i/regionChunks)})
+			}
+		}
+		self.lock.Unlock()
+	}
+
+	return positions, nil
+}
+
+func (self *Anvil) Close() error {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	for key, r := range self.regions {
+		r.Close()
+		delete(self.regions, key)
+	}
+
+	return nil
+}
+
+// decodeChunk reads the chunk format used since 1.18, where every section
+// has its own block state and biome palettes.
+func decodeChunk(tag nbt.Tag, x int32, z int32, minY int, height int) (*Chunk, error) {
+	chunk := NewChunk(x, z, minY, height)
+
+	for _, s := range listOf(tag, "sections") {
+		i := intOf(s, "Y") - minY/SectionSize
+		if i < 0 || i >= len(chunk.Sections) {
+			continue
+		}
+
+		section := chunk.Sections[i]
+
+		if err := decodeBlockStates(compoundOf(s, "block_states"), section); err != nil {
+			return nil, fmt.Errorf("chunk %d %d: %w", x, z, err)
+		}
+
+		if err := decodeBiomes(compoundOf(s, "biomes"), section); err != nil {
+			return nil, fmt.Errorf("chunk %d %d: %w", x, z, err)
+		}
+
+		section.SkyLight = bytesOf(s, "SkyLight")
+		section.BlockLight = bytesOf(s, "BlockLight")
+	}
+
+	if root, ok := tag.(*nbt.Compound); ok {
+		chunk.extra = root.Value
+	}
+
+	for _, e := range listOf(tag, "block_entities") {
+		kind, ok := BlockEntityTypeID(stringOf(e, "id"))
+		if !ok {
+			continue
+		}
+
+		chunk.BlockEntities = append(chunk.BlockEntities, BlockEntity{
+			X:    intOf(e, "x"),
+			Y:    intOf(e, "y"),
+			Z:    intOf(e, "z"),
+			Type: kind,
+			Data: e,
+		})
+	}
+
+	return chunk, nil
+}
+
+func decodeBlockStates(tag nbt.Tag, section *Section) error {
+	if tag == nil {
+		return nil
+	}
+
+	entries := listOf(tag, "palette")
+	palette := make([]uint16, len(entries))
+
+	for i, entry := range entries {
+		properties := make(map[string]string)
+		if props, ok := child(entry, "Properties").(*nbt.Compound); ok {
+			for k, v := range props.Value {
+				properties[k], _ = v.ToString()
+			}
+		}
+
+		palette[i] = LookupState(stringOf(entry, "Name"), properties)
+	}
+
+	return unpackPalette(palette, longsOf(tag, "data"), max(4, BitsFor(len(palette))), section.Blocks[:])
+}
+
+func decodeBiomes(tag nbt.Tag, section *Section) error {
+	if tag == nil {
+		return nil
+	}
+
+	entries := listOf(tag, "palette")
+	palette := make([]uint16, len(entries))
+
+	for i, entry := range entries {
+		name, _ := entry.ToString()
+		palette[i], _ = BiomeID(name)
+	}
+
+	return unpackPalette(palette, longsOf(tag, "data"), BitsFor(len(palette)), section.Biomes[:])
+}
+
+func unpackPalette(palette []uint16, data []int64, bits int, values []uint16) error {
+	if len(palette) == 0 {
+		return errors.New("empty palette")
+	}
+
+	if len(palette) == 1 || data == nil {
+		for i := range values {
+			values[i] = palette[0]
+		}
+
+		return nil
+	}
+
+	Unpack(data, bits, values)
+
+	for i, v := range values {
+		if int(v) >= len(palette) {
+			return fmt.Errorf("palette index %d out of %d entries", v, len(palette))
+		}
+
+		values[i] = palette[v]
+	}
+
+	return nil
+}
+
+// DataVersion of the chunks written, the one of 1.21.8
+const DataVersion = 4440
+
+func encodeChunk(chunk *Chunk) (nbt.Tag, error) {
+	values := make(map[string]nbt.Tag)
+	for k, v := range chunk.extra {
+		values[k] = v
+	}
+
+	sections := make([]nbt.Tag, len(chunk.Sections))
+	for i, s := range chunk.Sections {
+		section := map[string]nbt.Tag{
+			"Y":            nbt.NewByteTag("Y", int8(chunk.MinY/SectionSize+i)),
+			"block_states": encodeBlockStates(s),
+			"biomes":       encodeBiomes(s),
+		}
+
+		if s.SkyLight != nil {
+			section["SkyLight"] = nbt.NewByteArrayTag("SkyLight", s.SkyLight)
+		}
+
+		if s.BlockLight != nil {
+			section["BlockLight"] = nbt.NewByteArrayTag("BlockLight", s.BlockLight)
+		}
+
+		sections[i] = nbt.NewCompoundTag("", section)
+	}
+
+	entities := make([]nbt.Tag, 0, len(chunk.BlockEntities))
+	for _, e := range chunk.BlockEntities {
+		entity := make(map[string]nbt.Tag)
+		if c, ok := e.Data.(*nbt.Compound); ok {
+			for k, v := range c.Value {
+				entity[k] = v
+			}
+		}
+
+		entity["id"] = nbt.NewStringTag("id", BlockEntityTypes[e.Type])
+		entity["x"] = nbt.NewIntTag("x", int32(e.X))
+		entity["y"] = nbt.NewIntTag("y", int32(e.Y))
+		entity["z"] = nbt.NewIntTag("z", int32(e.Z))
+
+		entities = append(entities, nbt.NewCompoundTag("", entity))
+	}
+
+	heightmaps := make(map[string]nbt.Tag)
+	for name, kind := range map[string]HeightmapKind{"WORLD_SURFACE": WorldSurface, "MOTION_BLOCKING": MotionBlocking} {
+		heights := chunk.Heightmap(kind)
+		packed := make([]uint16, len(heights))
+		for i, h := range heights {
+			packed[i] = uint16(h)
+		}
+
+		heightmaps[name] = newLongArray(name, Pack(packed, BitsFor(chunk.Height()+1)))
+	}
+
+	values["DataVersion"] = nbt.NewIntTag("DataVersion", DataVersion)
+	values["xPos"] = nbt.NewIntTag("xPos", chunk.X)
+	values["zPos"] = nbt.NewIntTag("zPos", chunk.Z)
+	values["yPos"] = nbt.NewIntTag("yPos", int32(chunk.MinY/SectionSize))
+	values["Status"] = nbt.NewStringTag("Status", "minecraft:full")
+	if _, ok := values["LastUpdate"]; !ok {
+		values["LastUpdate"] = nbt.NewLongTag("LastUpdate", 0)
+	}
+
+	values["sections"] = nbt.NewListTag("sections", sections, nbt.IDTagCompound)
+	values["block_entities"] = nbt.NewListTag("block_entities", entities, nbt.IDTagCompound)
+	values["Heightmaps"] = nbt.NewCompoundTag("Heightmaps", heightmaps)
+
+	// The light is computed again by the game when it isn't known
+	isLightOn := int8(1)
+	for _, s := range chunk.Sections {
+		if s.SkyLight == nil && s.BlockLight == nil {
+			isLightOn = 0
+		}
+	}
+
+	values["isLightOn"] = nbt.NewByteTag("isLightOn", isLightOn)
+
+	return nbt.NewCompoundTag("", values), nil
+}
+
+func encodeBlockStates(s *Section) nbt.Tag {
+	palette, indices := makePalette(s.Blocks[:])
+
+	entries := make([]nbt.Tag, len(palette))
+	for i, id := range palette {
+		state, _ := State(id)
+
+		entry := map[string]nbt.Tag{
+			"Name": nbt.NewStringTag("Name", state.Name),
+		}
+
+		if len(state.Properties) > 0 {
+			properties := make(map[string]nbt.Tag)
+			for k, v := range state.Properties {
+				properties[k] = nbt.NewStringTag(k, v)
+			}
+
+			entry["Properties"] = nbt.NewCompoundTag("Properties", properties)
+		}
+
+		entries[i] = nbt.NewCompoundTag("", entry)
+	}
+
+	values := map[string]nbt.Tag{
+		"palette": nbt.NewListTag("palette", entries, nbt.IDTagCompound),
+	}
+
+	if len(palette) > 1 {
+		values["data"] = newLongArray("data", Pack(indices, max(4, BitsFor(len(palette)))))
+	}
+
+	return nbt.NewCompoundTag("block_states", values)
+}
+
+func encodeBiomes(s *Section) nbt.Tag {
+	palette, indices := makePalette(s.Biomes[:])
+
+	entries := make([]nbt.Tag, len(palette))
+	for i, id := range palette {
+		name := "minecraft:plains"
+		if int(id) < len(Biomes) {
+			name = Biomes[id]
+		}
+
+		entries[i] = nbt.NewStringTag("", name)
+	}
+
+	values := map[string]nbt.Tag{
+		"palette": nbt.NewListTag("palette", entries, nbt.IDTagString),
+	}
+
+	if len(palette) > 1 {
+		values["data"] = newLongArray("data", Pack(indices, BitsFor(len(palette))))
+	}
+
+	return nbt.NewCompoundTag("biomes", values)
+}
+
+// makePalette returns the distinct values, in order of appearance, and the
+// index of every value in them.
+func makePalette(values []uint16) ([]uint16, []uint16) {
+	palette := make([]uint16, 0)
+	lookup := make(map[uint16]uint16)
+	indices := make([]uint16, len(values))
+
+	for i, v := range values {
+		index, ok := lookup[v]
+		if !ok {
+			index = uint16(len(palette))
+			lookup[v] = index
+			palette = append(palette, v)
+		}
+
+		indices[i] = index
+	}
+
+	return palette, indices
+}
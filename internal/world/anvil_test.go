@@ -0,0 +1,293 @@
+package world
+
+import (
+	"bytes"
+	"errors"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRegionReadWrite(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "r.0.0.mca")
+
+	region, err := OpenRegion(path, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	small := []byte("a chunk")
+	if err := region.Write(3, 7, small); err != nil {
+		t.Fatal(err)
+	}
+
+	// Random bytes don't compress, taking several sectors
+	large := make([]byte, 3*sectorSize)
+	rng := rand.New(rand.NewPCG(1, 2))
+	for i := range large {
+		large[i] = byte(rng.Uint32())
+	}
+
+	if err := region.Write(31, 31, large); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := region.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	region, err = OpenRegion(path, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer region.Close()
+
+	if data, err := region.Read(3, 7); err != nil || !bytes.Equal(data, small) {
+		t.Fatalf("Read %q, %v instead of %q", data, err, small)
+	}
+
+	if data, err := region.Read(31, 31); err != nil || !bytes.Equal(data, large) {
+		t.Fatalf("Read %d bytes, %v instead of %d", len(data), err, len(large))
+	}
+
+	if data, err := region.Read(0, 0); err != nil || data != nil {
+		t.Fatalf("Read %q, %v from a missing chunk", data, err)
+	}
+}
+
+func TestRegionSectors(t *testing.T) {
+	region, err := OpenRegion(filepath.Join(t.TempDir(), "r.0.0.mca"), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer region.Close()
+
+	for x := range int32(3) {
+		if err := region.Write(x, 0, []byte{byte(x)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The chunks follow the header, one sector each
+	for x := range int32(3) {
+		location := region.locations[chunkIndex(x, 0)]
+		if offset, count := location>>8, location&0xff; offset != uint32(headerSectors+x) || count != 1 {
+			t.Fatalf("Chunk %d is at sector %d over %d sectors", x, offset, count)
+		}
+	}
+
+	// A rewritten chunk never overwrites its previous sectors
+	if err := region.Write(1, 0, []byte{4}); err != nil {
+		t.Fatal(err)
+	}
+
+	if offset := region.locations[chunkIndex(1, 0)] >> 8; offset != headerSectors+3 {
+		t.Fatalf("Rewritten chunk is at sector %d", offset)
+	}
+
+	// Its old sector is free for the next chunk
+	if err := region.Write(5, 0, []byte{5}); err != nil {
+		t.Fatal(err)
+	}
+
+	if offset := region.locations[chunkIndex(5, 0)] >> 8; offset != headerSectors+1 {
+		t.Fatalf("Chunk is at sector %d instead of the freed one", offset)
+	}
+
+	for x, want := range map[int32]byte{0: 0, 1: 4, 2: 2, 5: 5} {
+		if data, err := region.Read(x, 0); err != nil || !bytes.Equal(data, []byte{want}) {
+			t.Fatalf("Chunk %d reads %v, %v", x, data, err)
+		}
+	}
+}
+
+func TestRegionExternal(t *testing.T) {
+	dir := t.TempDir()
+
+	region, err := OpenRegion(filepath.Join(dir, "r.0.0.mca"), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer region.Close()
+
+	// More than 255 sectors even once compressed
+	huge := make([]byte, 256*sectorSize)
+	rng := rand.New(rand.NewPCG(3, 4))
+	for i := range huge {
+		huge[i] = byte(rng.Uint32())
+	}
+
+	if err := region.Write(2, 2, huge); err != nil {
+		t.Fatal(err)
+	}
+
+	external := filepath.Join(dir, "c.2.2.mcc")
+	if _, err := os.Stat(external); err != nil {
+		t.Fatal(err)
+	}
+
+	if data, err := region.Read(2, 2); err != nil || !bytes.Equal(data, huge) {
+		t.Fatalf("Read %d bytes, %v instead of %d", len(data), err, len(huge))
+	}
+
+	// The external file goes away once the chunk fits again
+	if err := region.Write(2, 2, []byte("small")); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := os.Stat(external); !os.IsNotExist(err) {
+		t.Fatalf("External file is still there: %v", err)
+	}
+}
+
+func TestPalettedContainers(t *testing.T) {
+	stone, _ := DefaultState("minecraft:stone")
+	dirt, _ := DefaultState("minecraft:dirt")
+
+	sections := map[string]*Section{
+		"single": {},
+		"two":    {},
+		"many":   {},
+	}
+
+	for i := range SectionVolume {
+		sections["single"].Blocks[i] = stone
+
+		if i%3 == 0 {
+			sections["two"].Blocks[i] = dirt
+		} else {
+			sections["two"].Blocks[i] = stone
+		}
+
+		// More than 16 states, past the 4 bits of the smallest palette
+		sections["many"].Blocks[i] = uint16(i % 40)
+	}
+
+	for name, section := range sections {
+		// The containers go through bytes as in a store, the long array
+		// being only readable once written
+		data, err := writeNBT(encodeBlockStates(section))
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+
+		tag, err := readNBT(data)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+
+		var decoded Section
+		if err := decodeBlockStates(tag, &decoded); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+
+		if decoded.Blocks != section.Blocks {
+			t.Fatalf("%s: the blocks differ once decoded", name)
+		}
+	}
+
+	if data := child(encodeBlockStates(sections["single"]), "data"); data != nil {
+		t.Fatal("A single state palette has data")
+	}
+}
+
+func TestChunkThroughStore(t *testing.T) {
+	store := NewMemoryStore(Level{}, -64, 384)
+
+	stone, _ := DefaultState("minecraft:stone")
+	desert, _ := BiomeID("minecraft:desert")
+
+	chunk := NewChunk(4, -9, -64, 384)
+	for i := range SectionSize {
+		chunk.SetBlock(i, -64+i*7, 15-i, stone)
+		chunk.SetBiome(i, 100, i, desert)
+	}
+
+	chunk.Sections[3].SkyLight = bytes.Repeat([]byte{0xf0}, LightLength)
+
+	if err := store.SaveChunk(chunk); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := store.LoadChunk(4, -9)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, section := range chunk.Sections {
+		if loaded.Sections[i].Blocks != section.Blocks || loaded.Sections[i].Biomes != section.Biomes {
+			t.Fatalf("Section %d differs once loaded", i)
+		}
+	}
+
+	if !bytes.Equal(loaded.Sections[3].SkyLight, chunk.Sections[3].SkyLight) {
+		t.Fatal("Sky light differs once loaded")
+	}
+}
+
+func TestPack(t *testing.T) {
+	values := make([]uint16, SectionVolume)
+	for i := range values {
+		values[i] = uint16(i % 32)
+	}
+
+	// 5 bits fit 12 times in a long, leaving 4 bits unused
+	longs := Pack(values, 5)
+	if len(longs) != (SectionVolume+11)/12 {
+		t.Fatalf("Packed into %d longs", len(longs))
+	}
+
+	unpacked := make([]uint16, len(values))
+	Unpack(longs, 5, unpacked)
+
+	for i := range values {
+		if unpacked[i] != values[i] {
+			t.Fatalf("Value %d is %d instead of %d", i, unpacked[i], values[i])
+		}
+	}
+}
+
+func TestBadPalette(t *testing.T) {
+	values := make([]uint16, 16)
+	data := Pack([]uint16{0, 1, 2, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3}, 4)
+
+	if err := unpackPalette([]uint16{7, 8}, data, 4, values); err == nil {
+		t.Fatal("Indices out of the palette were accepted")
+	}
+
+	if err := unpackPalette(nil, data, 4, values); err == nil {
+		t.Fatal("An empty palette was accepted")
+	}
+}
+
+func TestNewWorldFolder(t *testing.T) {
+	root := filepath.Join(t.TempDir(), "world")
+
+	store, err := OpenDimension(root, "minecraft:overworld", -64, 384)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A new world has no level until it is first saved
+	if _, err := store.LoadLevel(); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("Loaded the level of a new world: %v", err)
+	}
+
+	level := Level{Name: "world", DataVersion: DataVersion, Seed: 42, SpawnY: 70}
+	if err := store.SaveLevel(level); err != nil {
+		t.Fatal(err)
+	}
+
+	store.Close()
+
+	store, err = OpenDimension(root, "minecraft:the_nether", -64, 384)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+
+	if loaded, err := store.LoadLevel(); err != nil || loaded != level {
+		t.Fatalf("Loaded %+v, %v instead of %+v", loaded, err, level)
+	}
+}
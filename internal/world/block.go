@@ -0,0 +1,273 @@
+package world
+
+import (
+	"slices"
+	"strings"
+	"sync"
+)
+
+// BlockState is a block with a value for every one of its properties.
+type BlockState struct {
+	Name       string
+	Properties map[string]string
+}
+
+type property struct {
+	name   string
+	values []string
+}
+
+type blockDefinition struct {
+	name       string
+	properties []property
+	// defaults holds the index of the default value of every property
+	defaults []int
+}
+
+func simple(name string) blockDefinition {
+	return blockDefinition{name: name}
+}
+
+func withProperties(name string, defaults []int, properties ...property) blockDefinition {
+	return blockDefinition{name: name, properties: properties, defaults: defaults}
+}
+
+// blockDefinitions, in blocks_gen.go, lists the blocks in registry order.
+// State IDs are assigned by walking the definitions, every block taking
+// one ID per combination of its properties.
+
+var (
+	states   = make([]BlockState, 0)
+	stateIDs = make(map[string]uint16)
+	defaults = make(map[string]uint16)
+)
+
+func init() {
+	for _, def := range blockDefinitions {
+		combinations := 1
+		for _, p := range def.properties {
+			combinations *= len(p.values)
+		}
+
+		for i := range combinations {
+			props := make(map[string]string)
+			isDefault := true
+
+			// The last property changes the fastest
+			rest := i
+			for j := len(def.properties) - 1; j >= 0; j-- {
+				p := def.properties[j]
+				value := rest % len(p.values)
+				rest /= len(p.values)
+
+				props[p.name] = p.values[value]
+				isDefault = isDefault && value == def.defaults[j]
+			}
+
+			id := uint16(len(states))
+			state := BlockState{Name: def.name, Properties: props}
+
+			states = append(states, state)
+			stateIDs[state.String()] = id
+
+			if isDefault {
+				defaults[def.name] = id
+			}
+		}
+	}
+}
+
+// String formats the state as in commands, name[key=value,...], with the
+// properties sorted by name.
+func (self BlockState) String() string {
+	if len(self.Properties) == 0 {
+		return self.Name
+	}
+
+	keys := make([]string, 0, len(self.Properties))
+	for k := range self.Properties {
+		keys = append(keys, k)
+	}
+
+	slices.Sort(keys)
+
+	pairs := make([]string, len(keys))
+	for i, k := range keys {
+		pairs[i] = k + "=" + self.Properties[k]
+	}
+
+	return self.Name + "[" + strings.Join(pairs, ",") + "]"
+}
+
+// DefaultState returns the state ID a block is placed with.
+func DefaultState(name string) (uint16, bool) {
+	id, ok := defaults[name]
+	return id, ok
+}
+
+// StateID returns the ID of a block state. Missing properties take the
+// value of the default state.
+func StateID(name string, properties map[string]string) (uint16, bool) {
+	id, ok := defaults[name]
+	if !ok {
+		return 0, false
+	}
+
+	if len(properties) == 0 {
+		return id, true
+	}
+
+	state := BlockState{Name: name, Properties: make(map[string]string)}
+	for k, v := range states[id].Properties {
+		state.Properties[k] = v
+	}
+
+	for k, v := range properties {
+		state.Properties[k] = v
+	}
+
+	id, ok = stateIDs[state.String()]
+	return id, ok
+}
+
+// State returns the block state behind an ID, foreign states included.
+func State(id uint16) (BlockState, bool) {
+	if id >= foreignBase {
+		foreign.lock.RLock()
+		defer foreign.lock.RUnlock()
+
+		if int(id-foreignBase) >= len(foreign.states) {
+			return BlockState{}, false
+		}
+
+		return foreign.states[id-foreignBase], true
+	}
+
+	if int(id) >= len(states) {
+		return BlockState{}, false
+	}
+
+	return states[id], true
+}
+
+// Blocks of a world missing from the table are given foreign IDs, from
+// foreignBase up, so they survive being loaded and saved again. Clients
+// don't know them and are sent the closest state of the table instead.
+const foreignBase uint16 = 1 << 15
+
+var foreign = struct {
+	lock   sync.RWMutex
+	states []BlockState
+	ids    map[string]uint16
+	// sent are the states sent in place of the foreign ones
+	sent []uint16
+}{
+	states: make([]BlockState, 0),
+	ids:    make(map[string]uint16),
+	sent:   make([]uint16, 0),
+}
+
+// LookupState returns the ID of a block state, giving a foreign ID to the
+// states missing from the table.
+func LookupState(name string, properties map[string]string) uint16 {
+	if id, ok := StateID(name, properties); ok {
+		return id
+	}
+
+	state := BlockState{Name: name, Properties: properties}
+	key := state.String()
+
+	foreign.lock.Lock()
+	defer foreign.lock.Unlock()
+
+	if id, ok := foreign.ids[key]; ok {
+		return id
+	}
+
+	if len(foreign.states) >= int(^uint16(0)-foreignBase) {
+		return Air
+	}
+
+	id := foreignBase + uint16(len(foreign.states))
+	foreign.states = append(foreign.states, state)
+	foreign.ids[key] = id
+	foreign.sent = append(foreign.sent, closestState(state))
+
+	return id
+}
+
+// closestState returns the state of the table closest to a foreign one:
+// the same block with the properties the table knows, or air for the
+// blocks it doesn't know.
+func closestState(state BlockState) uint16 {
+	if _, ok := defaults[state.Name]; !ok {
+		return Air
+	}
+
+	properties := make(map[string]string)
+	for k, v := range state.Properties {
+		properties[k] = v
+		if _, ok := StateID(state.Name, properties); !ok {
+			delete(properties, k)
+		}
+	}
+
+	id, _ := StateID(state.Name, properties)
+	return id
+}
+
+// IsForeign reports whether state was read from a world without being in
+// the table, and can't be sent to the clients.
+func IsForeign(state uint16) bool {
+	return state >= foreignBase
+}
+
+// SentState returns the state the clients are sent for state, which is
+// itself unless it is foreign.
+func SentState(state uint16) uint16 {
+	if !IsForeign(state) {
+		return state
+	}
+
+	foreign.lock.RLock()
+	defer foreign.lock.RUnlock()
+
+	if int(state-foreignBase) >= len(foreign.sent) {
+		return Air
+	}
+
+	return foreign.sent[state-foreignBase]
+}
+
+// Air is the block state every new section is filled with.
+const Air uint16 = 0
+
+// The other air blocks, found in caves and under the world
+var caveAir, voidAir uint16
+
+func init() {
+	caveAir = mustState("minecraft:cave_air")
+	voidAir = mustState("minecraft:void_air")
+}
+
+// IsAir reports whether state is one of the air blocks.
+func IsAir(state uint16) bool {
+	return state == Air || state == caveAir || state == voidAir
+}
+
+// mustState returns the default state of a block known to be in the table.
+func mustState(name string) uint16 {
+	id, ok := DefaultState(name)
+	if !ok {
+		panic("unknown block " + name)
+	}
+
+	return id
+}
+
+// BlockEntityTypes lists the block entity types in registry order.
+var BlockEntityTypes = registries["minecraft:block_entity_type"]
+
+func BlockEntityTypeID(name string) (int, bool) {
+	return RegistryID("minecraft:block_entity_type", name)
+}
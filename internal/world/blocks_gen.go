@@ -0,0 +1,3622 @@
+// Code generated by gendata from minecraft-data. DO NOT EDIT.
+
+package world
+
+var blockDefinitions = []blockDefinition{
+	simple("minecraft:air"),
+	simple("minecraft:stone"),
+	simple("minecraft:granite"),
+	simple("minecraft:polished_granite"),
+	simple("minecraft:diorite"),
+	simple("minecraft:polished_diorite"),
+	simple("minecraft:andesite"),
+	simple("minecraft:polished_andesite"),
+	withProperties("minecraft:grass_block", []int{1},
+		property{"snowy", []string{"true", "false"}},
+	),
+	simple("minecraft:dirt"),
+	simple("minecraft:coarse_dirt"),
+	withProperties("minecraft:podzol", []int{1},
+		property{"snowy", []string{"true", "false"}},
+	),
+	simple("minecraft:cobblestone"),
+	simple("minecraft:oak_planks"),
+	simple("minecraft:spruce_planks"),
+	simple("minecraft:birch_planks"),
+	simple("minecraft:jungle_planks"),
+	simple("minecraft:acacia_planks"),
+	simple("minecraft:cherry_planks"),
+	simple("minecraft:dark_oak_planks"),
+	withProperties("minecraft:pale_oak_wood", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	simple("minecraft:pale_oak_planks"),
+	simple("minecraft:mangrove_planks"),
+	simple("minecraft:bamboo_planks"),
+	simple("minecraft:bamboo_mosaic"),
+	withProperties("minecraft:oak_sapling", []int{0},
+		property{"stage", []string{"0", "1"}},
+	),
+	withProperties("minecraft:spruce_sapling", []int{0},
+		property{"stage", []string{"0", "1"}},
+	),
+	withProperties("minecraft:birch_sapling", []int{0},
+		property{"stage", []string{"0", "1"}},
+	),
+	withProperties("minecraft:jungle_sapling", []int{0},
+		property{"stage", []string{"0", "1"}},
+	),
+	withProperties("minecraft:acacia_sapling", []int{0},
+		property{"stage", []string{"0", "1"}},
+	),
+	withProperties("minecraft:cherry_sapling", []int{0},
+		property{"stage", []string{"0", "1"}},
+	),
+	withProperties("minecraft:dark_oak_sapling", []int{0},
+		property{"stage", []string{"0", "1"}},
+	),
+	withProperties("minecraft:pale_oak_sapling", []int{0},
+		property{"stage", []string{"0", "1"}},
+	),
+	withProperties("minecraft:mangrove_propagule", []int{0, 1, 0, 1},
+		property{"age", []string{"0", "1", "2", "3", "4"}},
+		property{"hanging", []string{"true", "false"}},
+		property{"stage", []string{"0", "1"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	simple("minecraft:bedrock"),
+	withProperties("minecraft:water", []int{0},
+		property{"level", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+	),
+	withProperties("minecraft:lava", []int{0},
+		property{"level", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+	),
+	simple("minecraft:sand"),
+	withProperties("minecraft:suspicious_sand", []int{0},
+		property{"dusted", []string{"0", "1", "2", "3"}},
+	),
+	simple("minecraft:red_sand"),
+	simple("minecraft:gravel"),
+	withProperties("minecraft:suspicious_gravel", []int{0},
+		property{"dusted", []string{"0", "1", "2", "3"}},
+	),
+	simple("minecraft:gold_ore"),
+	simple("minecraft:deepslate_gold_ore"),
+	simple("minecraft:iron_ore"),
+	simple("minecraft:deepslate_iron_ore"),
+	simple("minecraft:coal_ore"),
+	simple("minecraft:deepslate_coal_ore"),
+	simple("minecraft:nether_gold_ore"),
+	withProperties("minecraft:oak_log", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:spruce_log", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:birch_log", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:jungle_log", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:acacia_log", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:cherry_log", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:dark_oak_log", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:pale_oak_log", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:mangrove_log", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:mangrove_roots", []int{1},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:muddy_mangrove_roots", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:bamboo_block", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:stripped_spruce_log", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:stripped_birch_log", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:stripped_jungle_log", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:stripped_acacia_log", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:stripped_cherry_log", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:stripped_dark_oak_log", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:stripped_pale_oak_log", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:stripped_oak_log", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:stripped_mangrove_log", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:stripped_bamboo_block", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:oak_wood", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:spruce_wood", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:birch_wood", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:jungle_wood", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:acacia_wood", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:cherry_wood", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:dark_oak_wood", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:mangrove_wood", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:stripped_oak_wood", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:stripped_spruce_wood", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:stripped_birch_wood", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:stripped_jungle_wood", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:stripped_acacia_wood", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:stripped_cherry_wood", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:stripped_dark_oak_wood", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:stripped_pale_oak_wood", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:stripped_mangrove_wood", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:oak_leaves", []int{6, 1, 1},
+		property{"distance", []string{"1", "2", "3", "4", "5", "6", "7"}},
+		property{"persistent", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:spruce_leaves", []int{6, 1, 1},
+		property{"distance", []string{"1", "2", "3", "4", "5", "6", "7"}},
+		property{"persistent", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:birch_leaves", []int{6, 1, 1},
+		property{"distance", []string{"1", "2", "3", "4", "5", "6", "7"}},
+		property{"persistent", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:jungle_leaves", []int{6, 1, 1},
+		property{"distance", []string{"1", "2", "3", "4", "5", "6", "7"}},
+		property{"persistent", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:acacia_leaves", []int{6, 1, 1},
+		property{"distance", []string{"1", "2", "3", "4", "5", "6", "7"}},
+		property{"persistent", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:cherry_leaves", []int{6, 1, 1},
+		property{"distance", []string{"1", "2", "3", "4", "5", "6", "7"}},
+		property{"persistent", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:dark_oak_leaves", []int{6, 1, 1},
+		property{"distance", []string{"1", "2", "3", "4", "5", "6", "7"}},
+		property{"persistent", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:pale_oak_leaves", []int{6, 1, 1},
+		property{"distance", []string{"1", "2", "3", "4", "5", "6", "7"}},
+		property{"persistent", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:mangrove_leaves", []int{6, 1, 1},
+		property{"distance", []string{"1", "2", "3", "4", "5", "6", "7"}},
+		property{"persistent", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:azalea_leaves", []int{6, 1, 1},
+		property{"distance", []string{"1", "2", "3", "4", "5", "6", "7"}},
+		property{"persistent", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:flowering_azalea_leaves", []int{6, 1, 1},
+		property{"distance", []string{"1", "2", "3", "4", "5", "6", "7"}},
+		property{"persistent", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	simple("minecraft:sponge"),
+	simple("minecraft:wet_sponge"),
+	simple("minecraft:glass"),
+	simple("minecraft:lapis_ore"),
+	simple("minecraft:deepslate_lapis_ore"),
+	simple("minecraft:lapis_block"),
+	withProperties("minecraft:dispenser", []int{0, 1},
+		property{"facing", []string{"north", "east", "south", "west", "up", "down"}},
+		property{"triggered", []string{"true", "false"}},
+	),
+	simple("minecraft:sandstone"),
+	simple("minecraft:chiseled_sandstone"),
+	simple("minecraft:cut_sandstone"),
+	withProperties("minecraft:note_block", []int{0, 0, 1},
+		property{"instrument", []string{"harp", "basedrum", "snare", "hat", "bass", "flute", "bell", "guitar", "chime", "xylophone", "iron_xylophone", "cow_bell", "didgeridoo", "bit", "banjo", "pling", "zombie", "skeleton", "creeper", "dragon", "wither_skeleton", "piglin", "custom_head"}},
+		property{"note", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "21", "22", "23", "24"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:white_bed", []int{0, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"occupied", []string{"true", "false"}},
+		property{"part", []string{"head", "foot"}},
+	),
+	withProperties("minecraft:orange_bed", []int{0, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"occupied", []string{"true", "false"}},
+		property{"part", []string{"head", "foot"}},
+	),
+	withProperties("minecraft:magenta_bed", []int{0, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"occupied", []string{"true", "false"}},
+		property{"part", []string{"head", "foot"}},
+	),
+	withProperties("minecraft:light_blue_bed", []int{0, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"occupied", []string{"true", "false"}},
+		property{"part", []string{"head", "foot"}},
+	),
+	withProperties("minecraft:yellow_bed", []int{0, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"occupied", []string{"true", "false"}},
+		property{"part", []string{"head", "foot"}},
+	),
+	withProperties("minecraft:lime_bed", []int{0, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"occupied", []string{"true", "false"}},
+		property{"part", []string{"head", "foot"}},
+	),
+	withProperties("minecraft:pink_bed", []int{0, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"occupied", []string{"true", "false"}},
+		property{"part", []string{"head", "foot"}},
+	),
+	withProperties("minecraft:gray_bed", []int{0, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"occupied", []string{"true", "false"}},
+		property{"part", []string{"head", "foot"}},
+	),
+	withProperties("minecraft:light_gray_bed", []int{0, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"occupied", []string{"true", "false"}},
+		property{"part", []string{"head", "foot"}},
+	),
+	withProperties("minecraft:cyan_bed", []int{0, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"occupied", []string{"true", "false"}},
+		property{"part", []string{"head", "foot"}},
+	),
+	withProperties("minecraft:purple_bed", []int{0, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"occupied", []string{"true", "false"}},
+		property{"part", []string{"head", "foot"}},
+	),
+	withProperties("minecraft:blue_bed", []int{0, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"occupied", []string{"true", "false"}},
+		property{"part", []string{"head", "foot"}},
+	),
+	withProperties("minecraft:brown_bed", []int{0, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"occupied", []string{"true", "false"}},
+		property{"part", []string{"head", "foot"}},
+	),
+	withProperties("minecraft:green_bed", []int{0, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"occupied", []string{"true", "false"}},
+		property{"part", []string{"head", "foot"}},
+	),
+	withProperties("minecraft:red_bed", []int{0, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"occupied", []string{"true", "false"}},
+		property{"part", []string{"head", "foot"}},
+	),
+	withProperties("minecraft:black_bed", []int{0, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"occupied", []string{"true", "false"}},
+		property{"part", []string{"head", "foot"}},
+	),
+	withProperties("minecraft:powered_rail", []int{1, 0, 1},
+		property{"powered", []string{"true", "false"}},
+		property{"shape", []string{"north_south", "east_west", "ascending_east", "ascending_west", "ascending_north", "ascending_south"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:detector_rail", []int{1, 0, 1},
+		property{"powered", []string{"true", "false"}},
+		property{"shape", []string{"north_south", "east_west", "ascending_east", "ascending_west", "ascending_north", "ascending_south"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:sticky_piston", []int{1, 0},
+		property{"extended", []string{"true", "false"}},
+		property{"facing", []string{"north", "east", "south", "west", "up", "down"}},
+	),
+	simple("minecraft:cobweb"),
+	simple("minecraft:short_grass"),
+	simple("minecraft:fern"),
+	simple("minecraft:dead_bush"),
+	simple("minecraft:bush"),
+	simple("minecraft:short_dry_grass"),
+	simple("minecraft:tall_dry_grass"),
+	simple("minecraft:seagrass"),
+	withProperties("minecraft:tall_seagrass", []int{1},
+		property{"half", []string{"upper", "lower"}},
+	),
+	withProperties("minecraft:piston", []int{1, 0},
+		property{"extended", []string{"true", "false"}},
+		property{"facing", []string{"north", "east", "south", "west", "up", "down"}},
+	),
+	withProperties("minecraft:piston_head", []int{0, 1, 0},
+		property{"facing", []string{"north", "east", "south", "west", "up", "down"}},
+		property{"short", []string{"true", "false"}},
+		property{"type", []string{"normal", "sticky"}},
+	),
+	simple("minecraft:white_wool"),
+	simple("minecraft:orange_wool"),
+	simple("minecraft:magenta_wool"),
+	simple("minecraft:light_blue_wool"),
+	simple("minecraft:yellow_wool"),
+	simple("minecraft:lime_wool"),
+	simple("minecraft:pink_wool"),
+	simple("minecraft:gray_wool"),
+	simple("minecraft:light_gray_wool"),
+	simple("minecraft:cyan_wool"),
+	simple("minecraft:purple_wool"),
+	simple("minecraft:blue_wool"),
+	simple("minecraft:brown_wool"),
+	simple("minecraft:green_wool"),
+	simple("minecraft:red_wool"),
+	simple("minecraft:black_wool"),
+	withProperties("minecraft:moving_piston", []int{0, 0},
+		property{"facing", []string{"north", "east", "south", "west", "up", "down"}},
+		property{"type", []string{"normal", "sticky"}},
+	),
+	simple("minecraft:dandelion"),
+	simple("minecraft:torchflower"),
+	simple("minecraft:poppy"),
+	simple("minecraft:blue_orchid"),
+	simple("minecraft:allium"),
+	simple("minecraft:azure_bluet"),
+	simple("minecraft:red_tulip"),
+	simple("minecraft:orange_tulip"),
+	simple("minecraft:white_tulip"),
+	simple("minecraft:pink_tulip"),
+	simple("minecraft:oxeye_daisy"),
+	simple("minecraft:cornflower"),
+	simple("minecraft:wither_rose"),
+	simple("minecraft:lily_of_the_valley"),
+	simple("minecraft:brown_mushroom"),
+	simple("minecraft:red_mushroom"),
+	simple("minecraft:gold_block"),
+	simple("minecraft:iron_block"),
+	simple("minecraft:bricks"),
+	withProperties("minecraft:tnt", []int{1},
+		property{"unstable", []string{"true", "false"}},
+	),
+	simple("minecraft:bookshelf"),
+	withProperties("minecraft:chiseled_bookshelf", []int{0, 1, 1, 1, 1, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"slot_0_occupied", []string{"true", "false"}},
+		property{"slot_1_occupied", []string{"true", "false"}},
+		property{"slot_2_occupied", []string{"true", "false"}},
+		property{"slot_3_occupied", []string{"true", "false"}},
+		property{"slot_4_occupied", []string{"true", "false"}},
+		property{"slot_5_occupied", []string{"true", "false"}},
+	),
+	simple("minecraft:mossy_cobblestone"),
+	simple("minecraft:obsidian"),
+	simple("minecraft:torch"),
+	withProperties("minecraft:wall_torch", []int{0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+	),
+	withProperties("minecraft:fire", []int{0, 1, 1, 1, 1, 1},
+		property{"age", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+		property{"east", []string{"true", "false"}},
+		property{"north", []string{"true", "false"}},
+		property{"south", []string{"true", "false"}},
+		property{"up", []string{"true", "false"}},
+		property{"west", []string{"true", "false"}},
+	),
+	simple("minecraft:soul_fire"),
+	simple("minecraft:spawner"),
+	withProperties("minecraft:creaking_heart", []int{1, 0, 1},
+		property{"axis", []string{"x", "y", "z"}},
+		property{"creaking_heart_state", []string{"uprooted", "dormant", "awake"}},
+		property{"natural", []string{"true", "false"}},
+	),
+	withProperties("minecraft:oak_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:chest", []int{0, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"type", []string{"single", "left", "right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:redstone_wire", []int{2, 2, 0, 2, 2},
+		property{"east", []string{"up", "side", "none"}},
+		property{"north", []string{"up", "side", "none"}},
+		property{"power", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+		property{"south", []string{"up", "side", "none"}},
+		property{"west", []string{"up", "side", "none"}},
+	),
+	simple("minecraft:diamond_ore"),
+	simple("minecraft:deepslate_diamond_ore"),
+	simple("minecraft:diamond_block"),
+	simple("minecraft:crafting_table"),
+	withProperties("minecraft:wheat", []int{0},
+		property{"age", []string{"0", "1", "2", "3", "4", "5", "6", "7"}},
+	),
+	withProperties("minecraft:farmland", []int{0},
+		property{"moisture", []string{"0", "1", "2", "3", "4", "5", "6", "7"}},
+	),
+	withProperties("minecraft:furnace", []int{0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"lit", []string{"true", "false"}},
+	),
+	withProperties("minecraft:oak_sign", []int{0, 1},
+		property{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:spruce_sign", []int{0, 1},
+		property{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:birch_sign", []int{0, 1},
+		property{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:acacia_sign", []int{0, 1},
+		property{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:cherry_sign", []int{0, 1},
+		property{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:jungle_sign", []int{0, 1},
+		property{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:dark_oak_sign", []int{0, 1},
+		property{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:pale_oak_sign", []int{0, 1},
+		property{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:mangrove_sign", []int{0, 1},
+		property{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:bamboo_sign", []int{0, 1},
+		property{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:oak_door", []int{0, 1, 0, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"upper", "lower"}},
+		property{"hinge", []string{"left", "right"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:ladder", []int{0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:rail", []int{0, 1},
+		property{"shape", []string{"north_south", "east_west", "ascending_east", "ascending_west", "ascending_north", "ascending_south", "south_east", "south_west", "north_west", "north_east"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:cobblestone_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:oak_wall_sign", []int{0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:spruce_wall_sign", []int{0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:birch_wall_sign", []int{0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:acacia_wall_sign", []int{0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:cherry_wall_sign", []int{0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:jungle_wall_sign", []int{0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:dark_oak_wall_sign", []int{0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:pale_oak_wall_sign", []int{0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:mangrove_wall_sign", []int{0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:bamboo_wall_sign", []int{0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:oak_hanging_sign", []int{1, 0, 1},
+		property{"attached", []string{"true", "false"}},
+		property{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:spruce_hanging_sign", []int{1, 0, 1},
+		property{"attached", []string{"true", "false"}},
+		property{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:birch_hanging_sign", []int{1, 0, 1},
+		property{"attached", []string{"true", "false"}},
+		property{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:acacia_hanging_sign", []int{1, 0, 1},
+		property{"attached", []string{"true", "false"}},
+		property{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:cherry_hanging_sign", []int{1, 0, 1},
+		property{"attached", []string{"true", "false"}},
+		property{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:jungle_hanging_sign", []int{1, 0, 1},
+		property{"attached", []string{"true", "false"}},
+		property{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:dark_oak_hanging_sign", []int{1, 0, 1},
+		property{"attached", []string{"true", "false"}},
+		property{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:pale_oak_hanging_sign", []int{1, 0, 1},
+		property{"attached", []string{"true", "false"}},
+		property{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:crimson_hanging_sign", []int{1, 0, 1},
+		property{"attached", []string{"true", "false"}},
+		property{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:warped_hanging_sign", []int{1, 0, 1},
+		property{"attached", []string{"true", "false"}},
+		property{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:mangrove_hanging_sign", []int{1, 0, 1},
+		property{"attached", []string{"true", "false"}},
+		property{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:bamboo_hanging_sign", []int{1, 0, 1},
+		property{"attached", []string{"true", "false"}},
+		property{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:oak_wall_hanging_sign", []int{0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:spruce_wall_hanging_sign", []int{0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:birch_wall_hanging_sign", []int{0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:acacia_wall_hanging_sign", []int{0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:cherry_wall_hanging_sign", []int{0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:jungle_wall_hanging_sign", []int{0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:dark_oak_wall_hanging_sign", []int{0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:pale_oak_wall_hanging_sign", []int{0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:mangrove_wall_hanging_sign", []int{0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:crimson_wall_hanging_sign", []int{0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:warped_wall_hanging_sign", []int{0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:bamboo_wall_hanging_sign", []int{0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:lever", []int{1, 0, 1},
+		property{"face", []string{"floor", "wall", "ceiling"}},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:stone_pressure_plate", []int{1},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:iron_door", []int{0, 1, 0, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"upper", "lower"}},
+		property{"hinge", []string{"left", "right"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:oak_pressure_plate", []int{1},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:spruce_pressure_plate", []int{1},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:birch_pressure_plate", []int{1},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:jungle_pressure_plate", []int{1},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:acacia_pressure_plate", []int{1},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:cherry_pressure_plate", []int{1},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:dark_oak_pressure_plate", []int{1},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:pale_oak_pressure_plate", []int{1},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:mangrove_pressure_plate", []int{1},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:bamboo_pressure_plate", []int{1},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:redstone_ore", []int{1},
+		property{"lit", []string{"true", "false"}},
+	),
+	withProperties("minecraft:deepslate_redstone_ore", []int{1},
+		property{"lit", []string{"true", "false"}},
+	),
+	withProperties("minecraft:redstone_torch", []int{0},
+		property{"lit", []string{"true", "false"}},
+	),
+	withProperties("minecraft:redstone_wall_torch", []int{0, 0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"lit", []string{"true", "false"}},
+	),
+	withProperties("minecraft:stone_button", []int{1, 0, 1},
+		property{"face", []string{"floor", "wall", "ceiling"}},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:snow", []int{0},
+		property{"layers", []string{"1", "2", "3", "4", "5", "6", "7", "8"}},
+	),
+	simple("minecraft:ice"),
+	simple("minecraft:snow_block"),
+	withProperties("minecraft:cactus", []int{0},
+		property{"age", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+	),
+	simple("minecraft:cactus_flower"),
+	simple("minecraft:clay"),
+	withProperties("minecraft:sugar_cane", []int{0},
+		property{"age", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+	),
+	withProperties("minecraft:jukebox", []int{1},
+		property{"has_record", []string{"true", "false"}},
+	),
+	withProperties("minecraft:oak_fence", []int{1, 1, 1, 1, 1},
+		property{"east", []string{"true", "false"}},
+		property{"north", []string{"true", "false"}},
+		property{"south", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"true", "false"}},
+	),
+	simple("minecraft:netherrack"),
+	simple("minecraft:soul_sand"),
+	simple("minecraft:soul_soil"),
+	withProperties("minecraft:basalt", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:polished_basalt", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	simple("minecraft:soul_torch"),
+	withProperties("minecraft:soul_wall_torch", []int{0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+	),
+	simple("minecraft:glowstone"),
+	withProperties("minecraft:nether_portal", []int{0},
+		property{"axis", []string{"x", "z"}},
+	),
+	withProperties("minecraft:carved_pumpkin", []int{0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+	),
+	withProperties("minecraft:jack_o_lantern", []int{0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+	),
+	withProperties("minecraft:cake", []int{0},
+		property{"bites", []string{"0", "1", "2", "3", "4", "5", "6"}},
+	),
+	withProperties("minecraft:repeater", []int{0, 0, 1, 1},
+		property{"delay", []string{"1", "2", "3", "4"}},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"locked", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	simple("minecraft:white_stained_glass"),
+	simple("minecraft:orange_stained_glass"),
+	simple("minecraft:magenta_stained_glass"),
+	simple("minecraft:light_blue_stained_glass"),
+	simple("minecraft:yellow_stained_glass"),
+	simple("minecraft:lime_stained_glass"),
+	simple("minecraft:pink_stained_glass"),
+	simple("minecraft:gray_stained_glass"),
+	simple("minecraft:light_gray_stained_glass"),
+	simple("minecraft:cyan_stained_glass"),
+	simple("minecraft:purple_stained_glass"),
+	simple("minecraft:blue_stained_glass"),
+	simple("minecraft:brown_stained_glass"),
+	simple("minecraft:green_stained_glass"),
+	simple("minecraft:red_stained_glass"),
+	simple("minecraft:black_stained_glass"),
+	withProperties("minecraft:oak_trapdoor", []int{0, 1, 1, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:spruce_trapdoor", []int{0, 1, 1, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:birch_trapdoor", []int{0, 1, 1, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:jungle_trapdoor", []int{0, 1, 1, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:acacia_trapdoor", []int{0, 1, 1, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:cherry_trapdoor", []int{0, 1, 1, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:dark_oak_trapdoor", []int{0, 1, 1, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:pale_oak_trapdoor", []int{0, 1, 1, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:mangrove_trapdoor", []int{0, 1, 1, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:bamboo_trapdoor", []int{0, 1, 1, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	simple("minecraft:stone_bricks"),
+	simple("minecraft:mossy_stone_bricks"),
+	simple("minecraft:cracked_stone_bricks"),
+	simple("minecraft:chiseled_stone_bricks"),
+	simple("minecraft:packed_mud"),
+	simple("minecraft:mud_bricks"),
+	simple("minecraft:infested_stone"),
+	simple("minecraft:infested_cobblestone"),
+	simple("minecraft:infested_stone_bricks"),
+	simple("minecraft:infested_mossy_stone_bricks"),
+	simple("minecraft:infested_cracked_stone_bricks"),
+	simple("minecraft:infested_chiseled_stone_bricks"),
+	withProperties("minecraft:brown_mushroom_block", []int{0, 0, 0, 0, 0, 0},
+		property{"down", []string{"true", "false"}},
+		property{"east", []string{"true", "false"}},
+		property{"north", []string{"true", "false"}},
+		property{"south", []string{"true", "false"}},
+		property{"up", []string{"true", "false"}},
+		property{"west", []string{"true", "false"}},
+	),
+	withProperties("minecraft:red_mushroom_block", []int{0, 0, 0, 0, 0, 0},
+		property{"down", []string{"true", "false"}},
+		property{"east", []string{"true", "false"}},
+		property{"north", []string{"true", "false"}},
+		property{"south", []string{"true", "false"}},
+		property{"up", []string{"true", "false"}},
+		property{"west", []string{"true", "false"}},
+	),
+	withProperties("minecraft:mushroom_stem", []int{0, 0, 0, 0, 0, 0},
+		property{"down", []string{"true", "false"}},
+		property{"east", []string{"true", "false"}},
+		property{"north", []string{"true", "false"}},
+		property{"south", []string{"true", "false"}},
+		property{"up", []string{"true", "false"}},
+		property{"west", []string{"true", "false"}},
+	),
+	withProperties("minecraft:iron_bars", []int{1, 1, 1, 1, 1},
+		property{"east", []string{"true", "false"}},
+		property{"north", []string{"true", "false"}},
+		property{"south", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"true", "false"}},
+	),
+	withProperties("minecraft:chain", []int{1, 1},
+		property{"axis", []string{"x", "y", "z"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:glass_pane", []int{1, 1, 1, 1, 1},
+		property{"east", []string{"true", "false"}},
+		property{"north", []string{"true", "false"}},
+		property{"south", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"true", "false"}},
+	),
+	simple("minecraft:pumpkin"),
+	simple("minecraft:melon"),
+	withProperties("minecraft:attached_pumpkin_stem", []int{0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+	),
+	withProperties("minecraft:attached_melon_stem", []int{0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+	),
+	withProperties("minecraft:pumpkin_stem", []int{0},
+		property{"age", []string{"0", "1", "2", "3", "4", "5", "6", "7"}},
+	),
+	withProperties("minecraft:melon_stem", []int{0},
+		property{"age", []string{"0", "1", "2", "3", "4", "5", "6", "7"}},
+	),
+	withProperties("minecraft:vine", []int{1, 1, 1, 1, 1},
+		property{"east", []string{"true", "false"}},
+		property{"north", []string{"true", "false"}},
+		property{"south", []string{"true", "false"}},
+		property{"up", []string{"true", "false"}},
+		property{"west", []string{"true", "false"}},
+	),
+	withProperties("minecraft:glow_lichen", []int{1, 1, 1, 1, 1, 1, 1},
+		property{"down", []string{"true", "false"}},
+		property{"east", []string{"true", "false"}},
+		property{"north", []string{"true", "false"}},
+		property{"south", []string{"true", "false"}},
+		property{"up", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"true", "false"}},
+	),
+	withProperties("minecraft:resin_clump", []int{1, 1, 1, 1, 1, 1, 1},
+		property{"down", []string{"true", "false"}},
+		property{"east", []string{"true", "false"}},
+		property{"north", []string{"true", "false"}},
+		property{"south", []string{"true", "false"}},
+		property{"up", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"true", "false"}},
+	),
+	withProperties("minecraft:oak_fence_gate", []int{0, 1, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"in_wall", []string{"true", "false"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:brick_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:stone_brick_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:mud_brick_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:mycelium", []int{1},
+		property{"snowy", []string{"true", "false"}},
+	),
+	simple("minecraft:lily_pad"),
+	simple("minecraft:resin_block"),
+	simple("minecraft:resin_bricks"),
+	withProperties("minecraft:resin_brick_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:resin_brick_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:resin_brick_wall", []int{0, 0, 0, 0, 1, 0},
+		property{"east", []string{"none", "low", "tall"}},
+		property{"north", []string{"none", "low", "tall"}},
+		property{"south", []string{"none", "low", "tall"}},
+		property{"up", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"none", "low", "tall"}},
+	),
+	simple("minecraft:chiseled_resin_bricks"),
+	simple("minecraft:nether_bricks"),
+	withProperties("minecraft:nether_brick_fence", []int{1, 1, 1, 1, 1},
+		property{"east", []string{"true", "false"}},
+		property{"north", []string{"true", "false"}},
+		property{"south", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"true", "false"}},
+	),
+	withProperties("minecraft:nether_brick_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:nether_wart", []int{0},
+		property{"age", []string{"0", "1", "2", "3"}},
+	),
+	simple("minecraft:enchanting_table"),
+	withProperties("minecraft:brewing_stand", []int{1, 1, 1},
+		property{"has_bottle_0", []string{"true", "false"}},
+		property{"has_bottle_1", []string{"true", "false"}},
+		property{"has_bottle_2", []string{"true", "false"}},
+	),
+	simple("minecraft:cauldron"),
+	withProperties("minecraft:water_cauldron", []int{0},
+		property{"level", []string{"1", "2", "3"}},
+	),
+	simple("minecraft:lava_cauldron"),
+	withProperties("minecraft:powder_snow_cauldron", []int{0},
+		property{"level", []string{"1", "2", "3"}},
+	),
+	simple("minecraft:end_portal"),
+	withProperties("minecraft:end_portal_frame", []int{1, 0},
+		property{"eye", []string{"true", "false"}},
+		property{"facing", []string{"north", "south", "west", "east"}},
+	),
+	simple("minecraft:end_stone"),
+	simple("minecraft:dragon_egg"),
+	withProperties("minecraft:redstone_lamp", []int{1},
+		property{"lit", []string{"true", "false"}},
+	),
+	withProperties("minecraft:cocoa", []int{0, 0},
+		property{"age", []string{"0", "1", "2"}},
+		property{"facing", []string{"north", "south", "west", "east"}},
+	),
+	withProperties("minecraft:sandstone_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	simple("minecraft:emerald_ore"),
+	simple("minecraft:deepslate_emerald_ore"),
+	withProperties("minecraft:ender_chest", []int{0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:tripwire_hook", []int{1, 0, 1},
+		property{"attached", []string{"true", "false"}},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:tripwire", []int{1, 1, 1, 1, 1, 1, 1},
+		property{"attached", []string{"true", "false"}},
+		property{"disarmed", []string{"true", "false"}},
+		property{"east", []string{"true", "false"}},
+		property{"north", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+		property{"south", []string{"true", "false"}},
+		property{"west", []string{"true", "false"}},
+	),
+	simple("minecraft:emerald_block"),
+	withProperties("minecraft:spruce_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:birch_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:jungle_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:command_block", []int{1, 0},
+		property{"conditional", []string{"true", "false"}},
+		property{"facing", []string{"north", "east", "south", "west", "up", "down"}},
+	),
+	simple("minecraft:beacon"),
+	withProperties("minecraft:cobblestone_wall", []int{0, 0, 0, 0, 1, 0},
+		property{"east", []string{"none", "low", "tall"}},
+		property{"north", []string{"none", "low", "tall"}},
+		property{"south", []string{"none", "low", "tall"}},
+		property{"up", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"none", "low", "tall"}},
+	),
+	withProperties("minecraft:mossy_cobblestone_wall", []int{0, 0, 0, 0, 1, 0},
+		property{"east", []string{"none", "low", "tall"}},
+		property{"north", []string{"none", "low", "tall"}},
+		property{"south", []string{"none", "low", "tall"}},
+		property{"up", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"none", "low", "tall"}},
+	),
+	simple("minecraft:flower_pot"),
+	simple("minecraft:potted_torchflower"),
+	simple("minecraft:potted_oak_sapling"),
+	simple("minecraft:potted_spruce_sapling"),
+	simple("minecraft:potted_birch_sapling"),
+	simple("minecraft:potted_jungle_sapling"),
+	simple("minecraft:potted_acacia_sapling"),
+	simple("minecraft:potted_cherry_sapling"),
+	simple("minecraft:potted_dark_oak_sapling"),
+	simple("minecraft:potted_pale_oak_sapling"),
+	simple("minecraft:potted_mangrove_propagule"),
+	simple("minecraft:potted_fern"),
+	simple("minecraft:potted_dandelion"),
+	simple("minecraft:potted_poppy"),
+	simple("minecraft:potted_blue_orchid"),
+	simple("minecraft:potted_allium"),
+	simple("minecraft:potted_azure_bluet"),
+	simple("minecraft:potted_red_tulip"),
+	simple("minecraft:potted_orange_tulip"),
+	simple("minecraft:potted_white_tulip"),
+	simple("minecraft:potted_pink_tulip"),
+	simple("minecraft:potted_oxeye_daisy"),
+	simple("minecraft:potted_cornflower"),
+	simple("minecraft:potted_lily_of_the_valley"),
+	simple("minecraft:potted_wither_rose"),
+	simple("minecraft:potted_red_mushroom"),
+	simple("minecraft:potted_brown_mushroom"),
+	simple("minecraft:potted_dead_bush"),
+	simple("minecraft:potted_cactus"),
+	withProperties("minecraft:carrots", []int{0},
+		property{"age", []string{"0", "1", "2", "3", "4", "5", "6", "7"}},
+	),
+	withProperties("minecraft:potatoes", []int{0},
+		property{"age", []string{"0", "1", "2", "3", "4", "5", "6", "7"}},
+	),
+	withProperties("minecraft:oak_button", []int{1, 0, 1},
+		property{"face", []string{"floor", "wall", "ceiling"}},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:spruce_button", []int{1, 0, 1},
+		property{"face", []string{"floor", "wall", "ceiling"}},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:birch_button", []int{1, 0, 1},
+		property{"face", []string{"floor", "wall", "ceiling"}},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:jungle_button", []int{1, 0, 1},
+		property{"face", []string{"floor", "wall", "ceiling"}},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:acacia_button", []int{1, 0, 1},
+		property{"face", []string{"floor", "wall", "ceiling"}},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:cherry_button", []int{1, 0, 1},
+		property{"face", []string{"floor", "wall", "ceiling"}},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:dark_oak_button", []int{1, 0, 1},
+		property{"face", []string{"floor", "wall", "ceiling"}},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:pale_oak_button", []int{1, 0, 1},
+		property{"face", []string{"floor", "wall", "ceiling"}},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:mangrove_button", []int{1, 0, 1},
+		property{"face", []string{"floor", "wall", "ceiling"}},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:bamboo_button", []int{1, 0, 1},
+		property{"face", []string{"floor", "wall", "ceiling"}},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:skeleton_skull", []int{1, 0},
+		property{"powered", []string{"true", "false"}},
+		property{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+	),
+	withProperties("minecraft:skeleton_wall_skull", []int{0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:wither_skeleton_skull", []int{1, 0},
+		property{"powered", []string{"true", "false"}},
+		property{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+	),
+	withProperties("minecraft:wither_skeleton_wall_skull", []int{0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:zombie_head", []int{1, 0},
+		property{"powered", []string{"true", "false"}},
+		property{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+	),
+	withProperties("minecraft:zombie_wall_head", []int{0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:player_head", []int{1, 0},
+		property{"powered", []string{"true", "false"}},
+		property{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+	),
+	withProperties("minecraft:player_wall_head", []int{0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:creeper_head", []int{1, 0},
+		property{"powered", []string{"true", "false"}},
+		property{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+	),
+	withProperties("minecraft:creeper_wall_head", []int{0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:dragon_head", []int{1, 0},
+		property{"powered", []string{"true", "false"}},
+		property{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+	),
+	withProperties("minecraft:dragon_wall_head", []int{0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:piglin_head", []int{1, 0},
+		property{"powered", []string{"true", "false"}},
+		property{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+	),
+	withProperties("minecraft:piglin_wall_head", []int{0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:anvil", []int{0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+	),
+	withProperties("minecraft:chipped_anvil", []int{0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+	),
+	withProperties("minecraft:damaged_anvil", []int{0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+	),
+	withProperties("minecraft:trapped_chest", []int{0, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"type", []string{"single", "left", "right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:light_weighted_pressure_plate", []int{0},
+		property{"power", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+	),
+	withProperties("minecraft:heavy_weighted_pressure_plate", []int{0},
+		property{"power", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+	),
+	withProperties("minecraft:comparator", []int{0, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"mode", []string{"compare", "subtract"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:daylight_detector", []int{1, 0},
+		property{"inverted", []string{"true", "false"}},
+		property{"power", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+	),
+	simple("minecraft:redstone_block"),
+	simple("minecraft:nether_quartz_ore"),
+	withProperties("minecraft:hopper", []int{0, 0},
+		property{"enabled", []string{"true", "false"}},
+		property{"facing", []string{"down", "north", "south", "west", "east"}},
+	),
+	simple("minecraft:quartz_block"),
+	simple("minecraft:chiseled_quartz_block"),
+	withProperties("minecraft:quartz_pillar", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:quartz_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:activator_rail", []int{1, 0, 1},
+		property{"powered", []string{"true", "false"}},
+		property{"shape", []string{"north_south", "east_west", "ascending_east", "ascending_west", "ascending_north", "ascending_south"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:dropper", []int{0, 1},
+		property{"facing", []string{"north", "east", "south", "west", "up", "down"}},
+		property{"triggered", []string{"true", "false"}},
+	),
+	simple("minecraft:white_terracotta"),
+	simple("minecraft:orange_terracotta"),
+	simple("minecraft:magenta_terracotta"),
+	simple("minecraft:light_blue_terracotta"),
+	simple("minecraft:yellow_terracotta"),
+	simple("minecraft:lime_terracotta"),
+	simple("minecraft:pink_terracotta"),
+	simple("minecraft:gray_terracotta"),
+	simple("minecraft:light_gray_terracotta"),
+	simple("minecraft:cyan_terracotta"),
+	simple("minecraft:purple_terracotta"),
+	simple("minecraft:blue_terracotta"),
+	simple("minecraft:brown_terracotta"),
+	simple("minecraft:green_terracotta"),
+	simple("minecraft:red_terracotta"),
+	simple("minecraft:black_terracotta"),
+	withProperties("minecraft:white_stained_glass_pane", []int{1, 1, 1, 1, 1},
+		property{"east", []string{"true", "false"}},
+		property{"north", []string{"true", "false"}},
+		property{"south", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"true", "false"}},
+	),
+	withProperties("minecraft:orange_stained_glass_pane", []int{1, 1, 1, 1, 1},
+		property{"east", []string{"true", "false"}},
+		property{"north", []string{"true", "false"}},
+		property{"south", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"true", "false"}},
+	),
+	withProperties("minecraft:magenta_stained_glass_pane", []int{1, 1, 1, 1, 1},
+		property{"east", []string{"true", "false"}},
+		property{"north", []string{"true", "false"}},
+		property{"south", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"true", "false"}},
+	),
+	withProperties("minecraft:light_blue_stained_glass_pane", []int{1, 1, 1, 1, 1},
+		property{"east", []string{"true", "false"}},
+		property{"north", []string{"true", "false"}},
+		property{"south", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"true", "false"}},
+	),
+	withProperties("minecraft:yellow_stained_glass_pane", []int{1, 1, 1, 1, 1},
+		property{"east", []string{"true", "false"}},
+		property{"north", []string{"true", "false"}},
+		property{"south", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"true", "false"}},
+	),
+	withProperties("minecraft:lime_stained_glass_pane", []int{1, 1, 1, 1, 1},
+		property{"east", []string{"true", "false"}},
+		property{"north", []string{"true", "false"}},
+		property{"south", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"true", "false"}},
+	),
+	withProperties("minecraft:pink_stained_glass_pane", []int{1, 1, 1, 1, 1},
+		property{"east", []string{"true", "false"}},
+		property{"north", []string{"true", "false"}},
+		property{"south", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"true", "false"}},
+	),
+	withProperties("minecraft:gray_stained_glass_pane", []int{1, 1, 1, 1, 1},
+		property{"east", []string{"true", "false"}},
+		property{"north", []string{"true", "false"}},
+		property{"south", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"true", "false"}},
+	),
+	withProperties("minecraft:light_gray_stained_glass_pane", []int{1, 1, 1, 1, 1},
+		property{"east", []string{"true", "false"}},
+		property{"north", []string{"true", "false"}},
+		property{"south", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"true", "false"}},
+	),
+	withProperties("minecraft:cyan_stained_glass_pane", []int{1, 1, 1, 1, 1},
+		property{"east", []string{"true", "false"}},
+		property{"north", []string{"true", "false"}},
+		property{"south", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"true", "false"}},
+	),
+	withProperties("minecraft:purple_stained_glass_pane", []int{1, 1, 1, 1, 1},
+		property{"east", []string{"true", "false"}},
+		property{"north", []string{"true", "false"}},
+		property{"south", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"true", "false"}},
+	),
+	withProperties("minecraft:blue_stained_glass_pane", []int{1, 1, 1, 1, 1},
+		property{"east", []string{"true", "false"}},
+		property{"north", []string{"true", "false"}},
+		property{"south", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"true", "false"}},
+	),
+	withProperties("minecraft:brown_stained_glass_pane", []int{1, 1, 1, 1, 1},
+		property{"east", []string{"true", "false"}},
+		property{"north", []string{"true", "false"}},
+		property{"south", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"true", "false"}},
+	),
+	withProperties("minecraft:green_stained_glass_pane", []int{1, 1, 1, 1, 1},
+		property{"east", []string{"true", "false"}},
+		property{"north", []string{"true", "false"}},
+		property{"south", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"true", "false"}},
+	),
+	withProperties("minecraft:red_stained_glass_pane", []int{1, 1, 1, 1, 1},
+		property{"east", []string{"true", "false"}},
+		property{"north", []string{"true", "false"}},
+		property{"south", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"true", "false"}},
+	),
+	withProperties("minecraft:black_stained_glass_pane", []int{1, 1, 1, 1, 1},
+		property{"east", []string{"true", "false"}},
+		property{"north", []string{"true", "false"}},
+		property{"south", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"true", "false"}},
+	),
+	withProperties("minecraft:acacia_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:cherry_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:dark_oak_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:pale_oak_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:mangrove_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:bamboo_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:bamboo_mosaic_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	simple("minecraft:slime_block"),
+	withProperties("minecraft:barrier", []int{1},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:light", []int{15, 1},
+		property{"level", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:iron_trapdoor", []int{0, 1, 1, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	simple("minecraft:prismarine"),
+	simple("minecraft:prismarine_bricks"),
+	simple("minecraft:dark_prismarine"),
+	withProperties("minecraft:prismarine_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:prismarine_brick_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:dark_prismarine_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:prismarine_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:prismarine_brick_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:dark_prismarine_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	simple("minecraft:sea_lantern"),
+	withProperties("minecraft:hay_block", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	simple("minecraft:white_carpet"),
+	simple("minecraft:orange_carpet"),
+	simple("minecraft:magenta_carpet"),
+	simple("minecraft:light_blue_carpet"),
+	simple("minecraft:yellow_carpet"),
+	simple("minecraft:lime_carpet"),
+	simple("minecraft:pink_carpet"),
+	simple("minecraft:gray_carpet"),
+	simple("minecraft:light_gray_carpet"),
+	simple("minecraft:cyan_carpet"),
+	simple("minecraft:purple_carpet"),
+	simple("minecraft:blue_carpet"),
+	simple("minecraft:brown_carpet"),
+	simple("minecraft:green_carpet"),
+	simple("minecraft:red_carpet"),
+	simple("minecraft:black_carpet"),
+	simple("minecraft:terracotta"),
+	simple("minecraft:coal_block"),
+	simple("minecraft:packed_ice"),
+	withProperties("minecraft:sunflower", []int{1},
+		property{"half", []string{"upper", "lower"}},
+	),
+	withProperties("minecraft:lilac", []int{1},
+		property{"half", []string{"upper", "lower"}},
+	),
+	withProperties("minecraft:rose_bush", []int{1},
+		property{"half", []string{"upper", "lower"}},
+	),
+	withProperties("minecraft:peony", []int{1},
+		property{"half", []string{"upper", "lower"}},
+	),
+	withProperties("minecraft:tall_grass", []int{1},
+		property{"half", []string{"upper", "lower"}},
+	),
+	withProperties("minecraft:large_fern", []int{1},
+		property{"half", []string{"upper", "lower"}},
+	),
+	withProperties("minecraft:white_banner", []int{0},
+		property{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+	),
+	withProperties("minecraft:orange_banner", []int{0},
+		property{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+	),
+	withProperties("minecraft:magenta_banner", []int{0},
+		property{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+	),
+	withProperties("minecraft:light_blue_banner", []int{0},
+		property{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+	),
+	withProperties("minecraft:yellow_banner", []int{0},
+		property{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+	),
+	withProperties("minecraft:lime_banner", []int{0},
+		property{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+	),
+	withProperties("minecraft:pink_banner", []int{0},
+		property{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+	),
+	withProperties("minecraft:gray_banner", []int{0},
+		property{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+	),
+	withProperties("minecraft:light_gray_banner", []int{0},
+		property{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+	),
+	withProperties("minecraft:cyan_banner", []int{0},
+		property{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+	),
+	withProperties("minecraft:purple_banner", []int{0},
+		property{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+	),
+	withProperties("minecraft:blue_banner", []int{0},
+		property{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+	),
+	withProperties("minecraft:brown_banner", []int{0},
+		property{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+	),
+	withProperties("minecraft:green_banner", []int{0},
+		property{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+	),
+	withProperties("minecraft:red_banner", []int{0},
+		property{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+	),
+	withProperties("minecraft:black_banner", []int{0},
+		property{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+	),
+	withProperties("minecraft:white_wall_banner", []int{0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+	),
+	withProperties("minecraft:orange_wall_banner", []int{0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+	),
+	withProperties("minecraft:magenta_wall_banner", []int{0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+	),
+	withProperties("minecraft:light_blue_wall_banner", []int{0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+	),
+	withProperties("minecraft:yellow_wall_banner", []int{0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+	),
+	withProperties("minecraft:lime_wall_banner", []int{0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+	),
+	withProperties("minecraft:pink_wall_banner", []int{0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+	),
+	withProperties("minecraft:gray_wall_banner", []int{0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+	),
+	withProperties("minecraft:light_gray_wall_banner", []int{0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+	),
+	withProperties("minecraft:cyan_wall_banner", []int{0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+	),
+	withProperties("minecraft:purple_wall_banner", []int{0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+	),
+	withProperties("minecraft:blue_wall_banner", []int{0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+	),
+	withProperties("minecraft:brown_wall_banner", []int{0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+	),
+	withProperties("minecraft:green_wall_banner", []int{0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+	),
+	withProperties("minecraft:red_wall_banner", []int{0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+	),
+	withProperties("minecraft:black_wall_banner", []int{0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+	),
+	simple("minecraft:red_sandstone"),
+	simple("minecraft:chiseled_red_sandstone"),
+	simple("minecraft:cut_red_sandstone"),
+	withProperties("minecraft:red_sandstone_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:oak_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:spruce_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:birch_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:jungle_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:acacia_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:cherry_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:dark_oak_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:pale_oak_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:mangrove_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:bamboo_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:bamboo_mosaic_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:stone_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:smooth_stone_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:sandstone_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:cut_sandstone_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:petrified_oak_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:cobblestone_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:brick_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:stone_brick_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:mud_brick_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:nether_brick_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:quartz_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:red_sandstone_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:cut_red_sandstone_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:purpur_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	simple("minecraft:smooth_stone"),
+	simple("minecraft:smooth_sandstone"),
+	simple("minecraft:smooth_quartz"),
+	simple("minecraft:smooth_red_sandstone"),
+	withProperties("minecraft:spruce_fence_gate", []int{0, 1, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"in_wall", []string{"true", "false"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:birch_fence_gate", []int{0, 1, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"in_wall", []string{"true", "false"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:jungle_fence_gate", []int{0, 1, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"in_wall", []string{"true", "false"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:acacia_fence_gate", []int{0, 1, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"in_wall", []string{"true", "false"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:cherry_fence_gate", []int{0, 1, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"in_wall", []string{"true", "false"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:dark_oak_fence_gate", []int{0, 1, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"in_wall", []string{"true", "false"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:pale_oak_fence_gate", []int{0, 1, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"in_wall", []string{"true", "false"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:mangrove_fence_gate", []int{0, 1, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"in_wall", []string{"true", "false"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:bamboo_fence_gate", []int{0, 1, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"in_wall", []string{"true", "false"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:spruce_fence", []int{1, 1, 1, 1, 1},
+		property{"east", []string{"true", "false"}},
+		property{"north", []string{"true", "false"}},
+		property{"south", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"true", "false"}},
+	),
+	withProperties("minecraft:birch_fence", []int{1, 1, 1, 1, 1},
+		property{"east", []string{"true", "false"}},
+		property{"north", []string{"true", "false"}},
+		property{"south", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"true", "false"}},
+	),
+	withProperties("minecraft:jungle_fence", []int{1, 1, 1, 1, 1},
+		property{"east", []string{"true", "false"}},
+		property{"north", []string{"true", "false"}},
+		property{"south", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"true", "false"}},
+	),
+	withProperties("minecraft:acacia_fence", []int{1, 1, 1, 1, 1},
+		property{"east", []string{"true", "false"}},
+		property{"north", []string{"true", "false"}},
+		property{"south", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"true", "false"}},
+	),
+	withProperties("minecraft:cherry_fence", []int{1, 1, 1, 1, 1},
+		property{"east", []string{"true", "false"}},
+		property{"north", []string{"true", "false"}},
+		property{"south", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"true", "false"}},
+	),
+	withProperties("minecraft:dark_oak_fence", []int{1, 1, 1, 1, 1},
+		property{"east", []string{"true", "false"}},
+		property{"north", []string{"true", "false"}},
+		property{"south", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"true", "false"}},
+	),
+	withProperties("minecraft:pale_oak_fence", []int{1, 1, 1, 1, 1},
+		property{"east", []string{"true", "false"}},
+		property{"north", []string{"true", "false"}},
+		property{"south", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"true", "false"}},
+	),
+	withProperties("minecraft:mangrove_fence", []int{1, 1, 1, 1, 1},
+		property{"east", []string{"true", "false"}},
+		property{"north", []string{"true", "false"}},
+		property{"south", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"true", "false"}},
+	),
+	withProperties("minecraft:bamboo_fence", []int{1, 1, 1, 1, 1},
+		property{"east", []string{"true", "false"}},
+		property{"north", []string{"true", "false"}},
+		property{"south", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"true", "false"}},
+	),
+	withProperties("minecraft:spruce_door", []int{0, 1, 0, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"upper", "lower"}},
+		property{"hinge", []string{"left", "right"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:birch_door", []int{0, 1, 0, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"upper", "lower"}},
+		property{"hinge", []string{"left", "right"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:jungle_door", []int{0, 1, 0, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"upper", "lower"}},
+		property{"hinge", []string{"left", "right"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:acacia_door", []int{0, 1, 0, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"upper", "lower"}},
+		property{"hinge", []string{"left", "right"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:cherry_door", []int{0, 1, 0, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"upper", "lower"}},
+		property{"hinge", []string{"left", "right"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:dark_oak_door", []int{0, 1, 0, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"upper", "lower"}},
+		property{"hinge", []string{"left", "right"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:pale_oak_door", []int{0, 1, 0, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"upper", "lower"}},
+		property{"hinge", []string{"left", "right"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:mangrove_door", []int{0, 1, 0, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"upper", "lower"}},
+		property{"hinge", []string{"left", "right"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:bamboo_door", []int{0, 1, 0, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"upper", "lower"}},
+		property{"hinge", []string{"left", "right"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:end_rod", []int{4},
+		property{"facing", []string{"north", "east", "south", "west", "up", "down"}},
+	),
+	withProperties("minecraft:chorus_plant", []int{1, 1, 1, 1, 1, 1},
+		property{"down", []string{"true", "false"}},
+		property{"east", []string{"true", "false"}},
+		property{"north", []string{"true", "false"}},
+		property{"south", []string{"true", "false"}},
+		property{"up", []string{"true", "false"}},
+		property{"west", []string{"true", "false"}},
+	),
+	withProperties("minecraft:chorus_flower", []int{0},
+		property{"age", []string{"0", "1", "2", "3", "4", "5"}},
+	),
+	simple("minecraft:purpur_block"),
+	withProperties("minecraft:purpur_pillar", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:purpur_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	simple("minecraft:end_stone_bricks"),
+	withProperties("minecraft:torchflower_crop", []int{0},
+		property{"age", []string{"0", "1"}},
+	),
+	withProperties("minecraft:pitcher_crop", []int{0, 1},
+		property{"age", []string{"0", "1", "2", "3", "4"}},
+		property{"half", []string{"upper", "lower"}},
+	),
+	withProperties("minecraft:pitcher_plant", []int{1},
+		property{"half", []string{"upper", "lower"}},
+	),
+	withProperties("minecraft:beetroots", []int{0},
+		property{"age", []string{"0", "1", "2", "3"}},
+	),
+	simple("minecraft:dirt_path"),
+	simple("minecraft:end_gateway"),
+	withProperties("minecraft:repeating_command_block", []int{1, 0},
+		property{"conditional", []string{"true", "false"}},
+		property{"facing", []string{"north", "east", "south", "west", "up", "down"}},
+	),
+	withProperties("minecraft:chain_command_block", []int{1, 0},
+		property{"conditional", []string{"true", "false"}},
+		property{"facing", []string{"north", "east", "south", "west", "up", "down"}},
+	),
+	withProperties("minecraft:frosted_ice", []int{0},
+		property{"age", []string{"0", "1", "2", "3"}},
+	),
+	simple("minecraft:magma_block"),
+	simple("minecraft:nether_wart_block"),
+	simple("minecraft:red_nether_bricks"),
+	withProperties("minecraft:bone_block", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	simple("minecraft:structure_void"),
+	withProperties("minecraft:observer", []int{2, 1},
+		property{"facing", []string{"north", "east", "south", "west", "up", "down"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:shulker_box", []int{4},
+		property{"facing", []string{"north", "east", "south", "west", "up", "down"}},
+	),
+	withProperties("minecraft:white_shulker_box", []int{4},
+		property{"facing", []string{"north", "east", "south", "west", "up", "down"}},
+	),
+	withProperties("minecraft:orange_shulker_box", []int{4},
+		property{"facing", []string{"north", "east", "south", "west", "up", "down"}},
+	),
+	withProperties("minecraft:magenta_shulker_box", []int{4},
+		property{"facing", []string{"north", "east", "south", "west", "up", "down"}},
+	),
+	withProperties("minecraft:light_blue_shulker_box", []int{4},
+		property{"facing", []string{"north", "east", "south", "west", "up", "down"}},
+	),
+	withProperties("minecraft:yellow_shulker_box", []int{4},
+		property{"facing", []string{"north", "east", "south", "west", "up", "down"}},
+	),
+	withProperties("minecraft:lime_shulker_box", []int{4},
+		property{"facing", []string{"north", "east", "south", "west", "up", "down"}},
+	),
+	withProperties("minecraft:pink_shulker_box", []int{4},
+		property{"facing", []string{"north", "east", "south", "west", "up", "down"}},
+	),
+	withProperties("minecraft:gray_shulker_box", []int{4},
+		property{"facing", []string{"north", "east", "south", "west", "up", "down"}},
+	),
+	withProperties("minecraft:light_gray_shulker_box", []int{4},
+		property{"facing", []string{"north", "east", "south", "west", "up", "down"}},
+	),
+	withProperties("minecraft:cyan_shulker_box", []int{4},
+		property{"facing", []string{"north", "east", "south", "west", "up", "down"}},
+	),
+	withProperties("minecraft:purple_shulker_box", []int{4},
+		property{"facing", []string{"north", "east", "south", "west", "up", "down"}},
+	),
+	withProperties("minecraft:blue_shulker_box", []int{4},
+		property{"facing", []string{"north", "east", "south", "west", "up", "down"}},
+	),
+	withProperties("minecraft:brown_shulker_box", []int{4},
+		property{"facing", []string{"north", "east", "south", "west", "up", "down"}},
+	),
+	withProperties("minecraft:green_shulker_box", []int{4},
+		property{"facing", []string{"north", "east", "south", "west", "up", "down"}},
+	),
+	withProperties("minecraft:red_shulker_box", []int{4},
+		property{"facing", []string{"north", "east", "south", "west", "up", "down"}},
+	),
+	withProperties("minecraft:black_shulker_box", []int{4},
+		property{"facing", []string{"north", "east", "south", "west", "up", "down"}},
+	),
+	withProperties("minecraft:white_glazed_terracotta", []int{0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+	),
+	withProperties("minecraft:orange_glazed_terracotta", []int{0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+	),
+	withProperties("minecraft:magenta_glazed_terracotta", []int{0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+	),
+	withProperties("minecraft:light_blue_glazed_terracotta", []int{0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+	),
+	withProperties("minecraft:yellow_glazed_terracotta", []int{0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+	),
+	withProperties("minecraft:lime_glazed_terracotta", []int{0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+	),
+	withProperties("minecraft:pink_glazed_terracotta", []int{0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+	),
+	withProperties("minecraft:gray_glazed_terracotta", []int{0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+	),
+	withProperties("minecraft:light_gray_glazed_terracotta", []int{0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+	),
+	withProperties("minecraft:cyan_glazed_terracotta", []int{0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+	),
+	withProperties("minecraft:purple_glazed_terracotta", []int{0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+	),
+	withProperties("minecraft:blue_glazed_terracotta", []int{0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+	),
+	withProperties("minecraft:brown_glazed_terracotta", []int{0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+	),
+	withProperties("minecraft:green_glazed_terracotta", []int{0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+	),
+	withProperties("minecraft:red_glazed_terracotta", []int{0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+	),
+	withProperties("minecraft:black_glazed_terracotta", []int{0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+	),
+	simple("minecraft:white_concrete"),
+	simple("minecraft:orange_concrete"),
+	simple("minecraft:magenta_concrete"),
+	simple("minecraft:light_blue_concrete"),
+	simple("minecraft:yellow_concrete"),
+	simple("minecraft:lime_concrete"),
+	simple("minecraft:pink_concrete"),
+	simple("minecraft:gray_concrete"),
+	simple("minecraft:light_gray_concrete"),
+	simple("minecraft:cyan_concrete"),
+	simple("minecraft:purple_concrete"),
+	simple("minecraft:blue_concrete"),
+	simple("minecraft:brown_concrete"),
+	simple("minecraft:green_concrete"),
+	simple("minecraft:red_concrete"),
+	simple("minecraft:black_concrete"),
+	simple("minecraft:white_concrete_powder"),
+	simple("minecraft:orange_concrete_powder"),
+	simple("minecraft:magenta_concrete_powder"),
+	simple("minecraft:light_blue_concrete_powder"),
+	simple("minecraft:yellow_concrete_powder"),
+	simple("minecraft:lime_concrete_powder"),
+	simple("minecraft:pink_concrete_powder"),
+	simple("minecraft:gray_concrete_powder"),
+	simple("minecraft:light_gray_concrete_powder"),
+	simple("minecraft:cyan_concrete_powder"),
+	simple("minecraft:purple_concrete_powder"),
+	simple("minecraft:blue_concrete_powder"),
+	simple("minecraft:brown_concrete_powder"),
+	simple("minecraft:green_concrete_powder"),
+	simple("minecraft:red_concrete_powder"),
+	simple("minecraft:black_concrete_powder"),
+	withProperties("minecraft:kelp", []int{0},
+		property{"age", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "21", "22", "23", "24", "25"}},
+	),
+	simple("minecraft:kelp_plant"),
+	simple("minecraft:dried_kelp_block"),
+	withProperties("minecraft:turtle_egg", []int{0, 0},
+		property{"eggs", []string{"1", "2", "3", "4"}},
+		property{"hatch", []string{"0", "1", "2"}},
+	),
+	withProperties("minecraft:sniffer_egg", []int{0},
+		property{"hatch", []string{"0", "1", "2"}},
+	),
+	withProperties("minecraft:dried_ghast", []int{0, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"hydration", []string{"0", "1", "2", "3"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	simple("minecraft:dead_tube_coral_block"),
+	simple("minecraft:dead_brain_coral_block"),
+	simple("minecraft:dead_bubble_coral_block"),
+	simple("minecraft:dead_fire_coral_block"),
+	simple("minecraft:dead_horn_coral_block"),
+	simple("minecraft:tube_coral_block"),
+	simple("minecraft:brain_coral_block"),
+	simple("minecraft:bubble_coral_block"),
+	simple("minecraft:fire_coral_block"),
+	simple("minecraft:horn_coral_block"),
+	withProperties("minecraft:dead_tube_coral", []int{0},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:dead_brain_coral", []int{0},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:dead_bubble_coral", []int{0},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:dead_fire_coral", []int{0},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:dead_horn_coral", []int{0},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:tube_coral", []int{0},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:brain_coral", []int{0},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:bubble_coral", []int{0},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:fire_coral", []int{0},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:horn_coral", []int{0},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:dead_tube_coral_fan", []int{0},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:dead_brain_coral_fan", []int{0},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:dead_bubble_coral_fan", []int{0},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:dead_fire_coral_fan", []int{0},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:dead_horn_coral_fan", []int{0},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:tube_coral_fan", []int{0},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:brain_coral_fan", []int{0},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:bubble_coral_fan", []int{0},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:fire_coral_fan", []int{0},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:horn_coral_fan", []int{0},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:dead_tube_coral_wall_fan", []int{0, 0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:dead_brain_coral_wall_fan", []int{0, 0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:dead_bubble_coral_wall_fan", []int{0, 0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:dead_fire_coral_wall_fan", []int{0, 0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:dead_horn_coral_wall_fan", []int{0, 0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:tube_coral_wall_fan", []int{0, 0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:brain_coral_wall_fan", []int{0, 0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:bubble_coral_wall_fan", []int{0, 0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:fire_coral_wall_fan", []int{0, 0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:horn_coral_wall_fan", []int{0, 0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:sea_pickle", []int{0, 0},
+		property{"pickles", []string{"1", "2", "3", "4"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	simple("minecraft:blue_ice"),
+	withProperties("minecraft:conduit", []int{0},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	simple("minecraft:bamboo_sapling"),
+	withProperties("minecraft:bamboo", []int{0, 0, 0},
+		property{"age", []string{"0", "1"}},
+		property{"leaves", []string{"none", "small", "large"}},
+		property{"stage", []string{"0", "1"}},
+	),
+	simple("minecraft:potted_bamboo"),
+	simple("minecraft:void_air"),
+	simple("minecraft:cave_air"),
+	withProperties("minecraft:bubble_column", []int{0},
+		property{"drag", []string{"true", "false"}},
+	),
+	withProperties("minecraft:polished_granite_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:smooth_red_sandstone_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:mossy_stone_brick_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:polished_diorite_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:mossy_cobblestone_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:end_stone_brick_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:stone_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:smooth_sandstone_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:smooth_quartz_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:granite_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:andesite_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:red_nether_brick_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:polished_andesite_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:diorite_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:polished_granite_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:smooth_red_sandstone_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:mossy_stone_brick_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:polished_diorite_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:mossy_cobblestone_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:end_stone_brick_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:smooth_sandstone_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:smooth_quartz_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:granite_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:andesite_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:red_nether_brick_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:polished_andesite_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:diorite_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:brick_wall", []int{0, 0, 0, 0, 1, 0},
+		property{"east", []string{"none", "low", "tall"}},
+		property{"north", []string{"none", "low", "tall"}},
+		property{"south", []string{"none", "low", "tall"}},
+		property{"up", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"none", "low", "tall"}},
+	),
+	withProperties("minecraft:prismarine_wall", []int{0, 0, 0, 0, 1, 0},
+		property{"east", []string{"none", "low", "tall"}},
+		property{"north", []string{"none", "low", "tall"}},
+		property{"south", []string{"none", "low", "tall"}},
+		property{"up", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"none", "low", "tall"}},
+	),
+	withProperties("minecraft:red_sandstone_wall", []int{0, 0, 0, 0, 1, 0},
+		property{"east", []string{"none", "low", "tall"}},
+		property{"north", []string{"none", "low", "tall"}},
+		property{"south", []string{"none", "low", "tall"}},
+		property{"up", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"none", "low", "tall"}},
+	),
+	withProperties("minecraft:mossy_stone_brick_wall", []int{0, 0, 0, 0, 1, 0},
+		property{"east", []string{"none", "low", "tall"}},
+		property{"north", []string{"none", "low", "tall"}},
+		property{"south", []string{"none", "low", "tall"}},
+		property{"up", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"none", "low", "tall"}},
+	),
+	withProperties("minecraft:granite_wall", []int{0, 0, 0, 0, 1, 0},
+		property{"east", []string{"none", "low", "tall"}},
+		property{"north", []string{"none", "low", "tall"}},
+		property{"south", []string{"none", "low", "tall"}},
+		property{"up", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"none", "low", "tall"}},
+	),
+	withProperties("minecraft:stone_brick_wall", []int{0, 0, 0, 0, 1, 0},
+		property{"east", []string{"none", "low", "tall"}},
+		property{"north", []string{"none", "low", "tall"}},
+		property{"south", []string{"none", "low", "tall"}},
+		property{"up", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"none", "low", "tall"}},
+	),
+	withProperties("minecraft:mud_brick_wall", []int{0, 0, 0, 0, 1, 0},
+		property{"east", []string{"none", "low", "tall"}},
+		property{"north", []string{"none", "low", "tall"}},
+		property{"south", []string{"none", "low", "tall"}},
+		property{"up", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"none", "low", "tall"}},
+	),
+	withProperties("minecraft:nether_brick_wall", []int{0, 0, 0, 0, 1, 0},
+		property{"east", []string{"none", "low", "tall"}},
+		property{"north", []string{"none", "low", "tall"}},
+		property{"south", []string{"none", "low", "tall"}},
+		property{"up", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"none", "low", "tall"}},
+	),
+	withProperties("minecraft:andesite_wall", []int{0, 0, 0, 0, 1, 0},
+		property{"east", []string{"none", "low", "tall"}},
+		property{"north", []string{"none", "low", "tall"}},
+		property{"south", []string{"none", "low", "tall"}},
+		property{"up", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"none", "low", "tall"}},
+	),
+	withProperties("minecraft:red_nether_brick_wall", []int{0, 0, 0, 0, 1, 0},
+		property{"east", []string{"none", "low", "tall"}},
+		property{"north", []string{"none", "low", "tall"}},
+		property{"south", []string{"none", "low", "tall"}},
+		property{"up", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"none", "low", "tall"}},
+	),
+	withProperties("minecraft:sandstone_wall", []int{0, 0, 0, 0, 1, 0},
+		property{"east", []string{"none", "low", "tall"}},
+		property{"north", []string{"none", "low", "tall"}},
+		property{"south", []string{"none", "low", "tall"}},
+		property{"up", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"none", "low", "tall"}},
+	),
+	withProperties("minecraft:end_stone_brick_wall", []int{0, 0, 0, 0, 1, 0},
+		property{"east", []string{"none", "low", "tall"}},
+		property{"north", []string{"none", "low", "tall"}},
+		property{"south", []string{"none", "low", "tall"}},
+		property{"up", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"none", "low", "tall"}},
+	),
+	withProperties("minecraft:diorite_wall", []int{0, 0, 0, 0, 1, 0},
+		property{"east", []string{"none", "low", "tall"}},
+		property{"north", []string{"none", "low", "tall"}},
+		property{"south", []string{"none", "low", "tall"}},
+		property{"up", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"none", "low", "tall"}},
+	),
+	withProperties("minecraft:scaffolding", []int{1, 7, 1},
+		property{"bottom", []string{"true", "false"}},
+		property{"distance", []string{"0", "1", "2", "3", "4", "5", "6", "7"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:loom", []int{0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+	),
+	withProperties("minecraft:barrel", []int{0, 1},
+		property{"facing", []string{"north", "east", "south", "west", "up", "down"}},
+		property{"open", []string{"true", "false"}},
+	),
+	withProperties("minecraft:smoker", []int{0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"lit", []string{"true", "false"}},
+	),
+	withProperties("minecraft:blast_furnace", []int{0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"lit", []string{"true", "false"}},
+	),
+	simple("minecraft:cartography_table"),
+	simple("minecraft:fletching_table"),
+	withProperties("minecraft:grindstone", []int{1, 0},
+		property{"face", []string{"floor", "wall", "ceiling"}},
+		property{"facing", []string{"north", "south", "west", "east"}},
+	),
+	withProperties("minecraft:lectern", []int{0, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"has_book", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	simple("minecraft:smithing_table"),
+	withProperties("minecraft:stonecutter", []int{0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+	),
+	withProperties("minecraft:bell", []int{0, 0, 1},
+		property{"attachment", []string{"floor", "ceiling", "single_wall", "double_wall"}},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:lantern", []int{1, 1},
+		property{"hanging", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:soul_lantern", []int{1, 1},
+		property{"hanging", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:campfire", []int{0, 0, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"lit", []string{"true", "false"}},
+		property{"signal_fire", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:soul_campfire", []int{0, 0, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"lit", []string{"true", "false"}},
+		property{"signal_fire", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:sweet_berry_bush", []int{0},
+		property{"age", []string{"0", "1", "2", "3"}},
+	),
+	withProperties("minecraft:warped_stem", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:stripped_warped_stem", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:warped_hyphae", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:stripped_warped_hyphae", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	simple("minecraft:warped_nylium"),
+	simple("minecraft:warped_fungus"),
+	simple("minecraft:warped_wart_block"),
+	simple("minecraft:warped_roots"),
+	simple("minecraft:nether_sprouts"),
+	withProperties("minecraft:crimson_stem", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:stripped_crimson_stem", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:crimson_hyphae", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:stripped_crimson_hyphae", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	simple("minecraft:crimson_nylium"),
+	simple("minecraft:crimson_fungus"),
+	simple("minecraft:shroomlight"),
+	withProperties("minecraft:weeping_vines", []int{0},
+		property{"age", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "21", "22", "23", "24", "25"}},
+	),
+	simple("minecraft:weeping_vines_plant"),
+	withProperties("minecraft:twisting_vines", []int{0},
+		property{"age", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "21", "22", "23", "24", "25"}},
+	),
+	simple("minecraft:twisting_vines_plant"),
+	simple("minecraft:crimson_roots"),
+	simple("minecraft:crimson_planks"),
+	simple("minecraft:warped_planks"),
+	withProperties("minecraft:crimson_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:warped_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:crimson_pressure_plate", []int{1},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:warped_pressure_plate", []int{1},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:crimson_fence", []int{1, 1, 1, 1, 1},
+		property{"east", []string{"true", "false"}},
+		property{"north", []string{"true", "false"}},
+		property{"south", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"true", "false"}},
+	),
+	withProperties("minecraft:warped_fence", []int{1, 1, 1, 1, 1},
+		property{"east", []string{"true", "false"}},
+		property{"north", []string{"true", "false"}},
+		property{"south", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"true", "false"}},
+	),
+	withProperties("minecraft:crimson_trapdoor", []int{0, 1, 1, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:warped_trapdoor", []int{0, 1, 1, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:crimson_fence_gate", []int{0, 1, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"in_wall", []string{"true", "false"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:warped_fence_gate", []int{0, 1, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"in_wall", []string{"true", "false"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:crimson_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:warped_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:crimson_button", []int{1, 0, 1},
+		property{"face", []string{"floor", "wall", "ceiling"}},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:warped_button", []int{1, 0, 1},
+		property{"face", []string{"floor", "wall", "ceiling"}},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:crimson_door", []int{0, 1, 0, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"upper", "lower"}},
+		property{"hinge", []string{"left", "right"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:warped_door", []int{0, 1, 0, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"upper", "lower"}},
+		property{"hinge", []string{"left", "right"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:crimson_sign", []int{0, 1},
+		property{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:warped_sign", []int{0, 1},
+		property{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:crimson_wall_sign", []int{0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:warped_wall_sign", []int{0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:structure_block", []int{1},
+		property{"mode", []string{"save", "load", "corner", "data"}},
+	),
+	withProperties("minecraft:jigsaw", []int{10},
+		property{"orientation", []string{"down_east", "down_north", "down_south", "down_west", "up_east", "up_north", "up_south", "up_west", "west_up", "east_up", "north_up", "south_up"}},
+	),
+	withProperties("minecraft:test_block", []int{0},
+		property{"mode", []string{"start", "log", "fail", "accept"}},
+	),
+	simple("minecraft:test_instance_block"),
+	withProperties("minecraft:composter", []int{0},
+		property{"level", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8"}},
+	),
+	withProperties("minecraft:target", []int{0},
+		property{"power", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+	),
+	withProperties("minecraft:bee_nest", []int{0, 0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"honey_level", []string{"0", "1", "2", "3", "4", "5"}},
+	),
+	withProperties("minecraft:beehive", []int{0, 0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"honey_level", []string{"0", "1", "2", "3", "4", "5"}},
+	),
+	simple("minecraft:honey_block"),
+	simple("minecraft:honeycomb_block"),
+	simple("minecraft:netherite_block"),
+	simple("minecraft:ancient_debris"),
+	simple("minecraft:crying_obsidian"),
+	withProperties("minecraft:respawn_anchor", []int{0},
+		property{"charges", []string{"0", "1", "2", "3", "4"}},
+	),
+	simple("minecraft:potted_crimson_fungus"),
+	simple("minecraft:potted_warped_fungus"),
+	simple("minecraft:potted_crimson_roots"),
+	simple("minecraft:potted_warped_roots"),
+	simple("minecraft:lodestone"),
+	simple("minecraft:blackstone"),
+	withProperties("minecraft:blackstone_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:blackstone_wall", []int{0, 0, 0, 0, 1, 0},
+		property{"east", []string{"none", "low", "tall"}},
+		property{"north", []string{"none", "low", "tall"}},
+		property{"south", []string{"none", "low", "tall"}},
+		property{"up", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"none", "low", "tall"}},
+	),
+	withProperties("minecraft:blackstone_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	simple("minecraft:polished_blackstone"),
+	simple("minecraft:polished_blackstone_bricks"),
+	simple("minecraft:cracked_polished_blackstone_bricks"),
+	simple("minecraft:chiseled_polished_blackstone"),
+	withProperties("minecraft:polished_blackstone_brick_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:polished_blackstone_brick_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:polished_blackstone_brick_wall", []int{0, 0, 0, 0, 1, 0},
+		property{"east", []string{"none", "low", "tall"}},
+		property{"north", []string{"none", "low", "tall"}},
+		property{"south", []string{"none", "low", "tall"}},
+		property{"up", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"none", "low", "tall"}},
+	),
+	simple("minecraft:gilded_blackstone"),
+	withProperties("minecraft:polished_blackstone_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:polished_blackstone_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:polished_blackstone_pressure_plate", []int{1},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:polished_blackstone_button", []int{1, 0, 1},
+		property{"face", []string{"floor", "wall", "ceiling"}},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:polished_blackstone_wall", []int{0, 0, 0, 0, 1, 0},
+		property{"east", []string{"none", "low", "tall"}},
+		property{"north", []string{"none", "low", "tall"}},
+		property{"south", []string{"none", "low", "tall"}},
+		property{"up", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"none", "low", "tall"}},
+	),
+	simple("minecraft:chiseled_nether_bricks"),
+	simple("minecraft:cracked_nether_bricks"),
+	simple("minecraft:quartz_bricks"),
+	withProperties("minecraft:candle", []int{0, 1, 1},
+		property{"candles", []string{"1", "2", "3", "4"}},
+		property{"lit", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:white_candle", []int{0, 1, 1},
+		property{"candles", []string{"1", "2", "3", "4"}},
+		property{"lit", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:orange_candle", []int{0, 1, 1},
+		property{"candles", []string{"1", "2", "3", "4"}},
+		property{"lit", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:magenta_candle", []int{0, 1, 1},
+		property{"candles", []string{"1", "2", "3", "4"}},
+		property{"lit", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:light_blue_candle", []int{0, 1, 1},
+		property{"candles", []string{"1", "2", "3", "4"}},
+		property{"lit", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:yellow_candle", []int{0, 1, 1},
+		property{"candles", []string{"1", "2", "3", "4"}},
+		property{"lit", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:lime_candle", []int{0, 1, 1},
+		property{"candles", []string{"1", "2", "3", "4"}},
+		property{"lit", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:pink_candle", []int{0, 1, 1},
+		property{"candles", []string{"1", "2", "3", "4"}},
+		property{"lit", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:gray_candle", []int{0, 1, 1},
+		property{"candles", []string{"1", "2", "3", "4"}},
+		property{"lit", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:light_gray_candle", []int{0, 1, 1},
+		property{"candles", []string{"1", "2", "3", "4"}},
+		property{"lit", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:cyan_candle", []int{0, 1, 1},
+		property{"candles", []string{"1", "2", "3", "4"}},
+		property{"lit", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:purple_candle", []int{0, 1, 1},
+		property{"candles", []string{"1", "2", "3", "4"}},
+		property{"lit", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:blue_candle", []int{0, 1, 1},
+		property{"candles", []string{"1", "2", "3", "4"}},
+		property{"lit", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:brown_candle", []int{0, 1, 1},
+		property{"candles", []string{"1", "2", "3", "4"}},
+		property{"lit", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:green_candle", []int{0, 1, 1},
+		property{"candles", []string{"1", "2", "3", "4"}},
+		property{"lit", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:red_candle", []int{0, 1, 1},
+		property{"candles", []string{"1", "2", "3", "4"}},
+		property{"lit", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:black_candle", []int{0, 1, 1},
+		property{"candles", []string{"1", "2", "3", "4"}},
+		property{"lit", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:candle_cake", []int{1},
+		property{"lit", []string{"true", "false"}},
+	),
+	withProperties("minecraft:white_candle_cake", []int{1},
+		property{"lit", []string{"true", "false"}},
+	),
+	withProperties("minecraft:orange_candle_cake", []int{1},
+		property{"lit", []string{"true", "false"}},
+	),
+	withProperties("minecraft:magenta_candle_cake", []int{1},
+		property{"lit", []string{"true", "false"}},
+	),
+	withProperties("minecraft:light_blue_candle_cake", []int{1},
+		property{"lit", []string{"true", "false"}},
+	),
+	withProperties("minecraft:yellow_candle_cake", []int{1},
+		property{"lit", []string{"true", "false"}},
+	),
+	withProperties("minecraft:lime_candle_cake", []int{1},
+		property{"lit", []string{"true", "false"}},
+	),
+	withProperties("minecraft:pink_candle_cake", []int{1},
+		property{"lit", []string{"true", "false"}},
+	),
+	withProperties("minecraft:gray_candle_cake", []int{1},
+		property{"lit", []string{"true", "false"}},
+	),
+	withProperties("minecraft:light_gray_candle_cake", []int{1},
+		property{"lit", []string{"true", "false"}},
+	),
+	withProperties("minecraft:cyan_candle_cake", []int{1},
+		property{"lit", []string{"true", "false"}},
+	),
+	withProperties("minecraft:purple_candle_cake", []int{1},
+		property{"lit", []string{"true", "false"}},
+	),
+	withProperties("minecraft:blue_candle_cake", []int{1},
+		property{"lit", []string{"true", "false"}},
+	),
+	withProperties("minecraft:brown_candle_cake", []int{1},
+		property{"lit", []string{"true", "false"}},
+	),
+	withProperties("minecraft:green_candle_cake", []int{1},
+		property{"lit", []string{"true", "false"}},
+	),
+	withProperties("minecraft:red_candle_cake", []int{1},
+		property{"lit", []string{"true", "false"}},
+	),
+	withProperties("minecraft:black_candle_cake", []int{1},
+		property{"lit", []string{"true", "false"}},
+	),
+	simple("minecraft:amethyst_block"),
+	simple("minecraft:budding_amethyst"),
+	withProperties("minecraft:amethyst_cluster", []int{4, 1},
+		property{"facing", []string{"north", "east", "south", "west", "up", "down"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:large_amethyst_bud", []int{4, 1},
+		property{"facing", []string{"north", "east", "south", "west", "up", "down"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:medium_amethyst_bud", []int{4, 1},
+		property{"facing", []string{"north", "east", "south", "west", "up", "down"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:small_amethyst_bud", []int{4, 1},
+		property{"facing", []string{"north", "east", "south", "west", "up", "down"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	simple("minecraft:tuff"),
+	withProperties("minecraft:tuff_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:tuff_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:tuff_wall", []int{0, 0, 0, 0, 1, 0},
+		property{"east", []string{"none", "low", "tall"}},
+		property{"north", []string{"none", "low", "tall"}},
+		property{"south", []string{"none", "low", "tall"}},
+		property{"up", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"none", "low", "tall"}},
+	),
+	simple("minecraft:polished_tuff"),
+	withProperties("minecraft:polished_tuff_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:polished_tuff_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:polished_tuff_wall", []int{0, 0, 0, 0, 1, 0},
+		property{"east", []string{"none", "low", "tall"}},
+		property{"north", []string{"none", "low", "tall"}},
+		property{"south", []string{"none", "low", "tall"}},
+		property{"up", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"none", "low", "tall"}},
+	),
+	simple("minecraft:chiseled_tuff"),
+	simple("minecraft:tuff_bricks"),
+	withProperties("minecraft:tuff_brick_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:tuff_brick_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:tuff_brick_wall", []int{0, 0, 0, 0, 1, 0},
+		property{"east", []string{"none", "low", "tall"}},
+		property{"north", []string{"none", "low", "tall"}},
+		property{"south", []string{"none", "low", "tall"}},
+		property{"up", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"none", "low", "tall"}},
+	),
+	simple("minecraft:chiseled_tuff_bricks"),
+	simple("minecraft:calcite"),
+	simple("minecraft:tinted_glass"),
+	simple("minecraft:powder_snow"),
+	withProperties("minecraft:sculk_sensor", []int{0, 0, 1},
+		property{"power", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+		property{"sculk_sensor_phase", []string{"inactive", "active", "cooldown"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:calibrated_sculk_sensor", []int{0, 0, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"power", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}},
+		property{"sculk_sensor_phase", []string{"inactive", "active", "cooldown"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	simple("minecraft:sculk"),
+	withProperties("minecraft:sculk_vein", []int{1, 1, 1, 1, 1, 1, 1},
+		property{"down", []string{"true", "false"}},
+		property{"east", []string{"true", "false"}},
+		property{"north", []string{"true", "false"}},
+		property{"south", []string{"true", "false"}},
+		property{"up", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"true", "false"}},
+	),
+	withProperties("minecraft:sculk_catalyst", []int{1},
+		property{"bloom", []string{"true", "false"}},
+	),
+	withProperties("minecraft:sculk_shrieker", []int{1, 1, 1},
+		property{"can_summon", []string{"true", "false"}},
+		property{"shrieking", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	simple("minecraft:copper_block"),
+	simple("minecraft:exposed_copper"),
+	simple("minecraft:weathered_copper"),
+	simple("minecraft:oxidized_copper"),
+	simple("minecraft:copper_ore"),
+	simple("minecraft:deepslate_copper_ore"),
+	simple("minecraft:oxidized_cut_copper"),
+	simple("minecraft:weathered_cut_copper"),
+	simple("minecraft:exposed_cut_copper"),
+	simple("minecraft:cut_copper"),
+	simple("minecraft:oxidized_chiseled_copper"),
+	simple("minecraft:weathered_chiseled_copper"),
+	simple("minecraft:exposed_chiseled_copper"),
+	simple("minecraft:chiseled_copper"),
+	simple("minecraft:waxed_oxidized_chiseled_copper"),
+	simple("minecraft:waxed_weathered_chiseled_copper"),
+	simple("minecraft:waxed_exposed_chiseled_copper"),
+	simple("minecraft:waxed_chiseled_copper"),
+	withProperties("minecraft:oxidized_cut_copper_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:weathered_cut_copper_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:exposed_cut_copper_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:cut_copper_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:oxidized_cut_copper_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:weathered_cut_copper_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:exposed_cut_copper_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:cut_copper_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	simple("minecraft:waxed_copper_block"),
+	simple("minecraft:waxed_weathered_copper"),
+	simple("minecraft:waxed_exposed_copper"),
+	simple("minecraft:waxed_oxidized_copper"),
+	simple("minecraft:waxed_oxidized_cut_copper"),
+	simple("minecraft:waxed_weathered_cut_copper"),
+	simple("minecraft:waxed_exposed_cut_copper"),
+	simple("minecraft:waxed_cut_copper"),
+	withProperties("minecraft:waxed_oxidized_cut_copper_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:waxed_weathered_cut_copper_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:waxed_exposed_cut_copper_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:waxed_cut_copper_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:waxed_oxidized_cut_copper_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:waxed_weathered_cut_copper_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:waxed_exposed_cut_copper_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:waxed_cut_copper_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:copper_door", []int{0, 1, 0, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"upper", "lower"}},
+		property{"hinge", []string{"left", "right"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:exposed_copper_door", []int{0, 1, 0, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"upper", "lower"}},
+		property{"hinge", []string{"left", "right"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:oxidized_copper_door", []int{0, 1, 0, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"upper", "lower"}},
+		property{"hinge", []string{"left", "right"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:weathered_copper_door", []int{0, 1, 0, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"upper", "lower"}},
+		property{"hinge", []string{"left", "right"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:waxed_copper_door", []int{0, 1, 0, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"upper", "lower"}},
+		property{"hinge", []string{"left", "right"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:waxed_exposed_copper_door", []int{0, 1, 0, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"upper", "lower"}},
+		property{"hinge", []string{"left", "right"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:waxed_oxidized_copper_door", []int{0, 1, 0, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"upper", "lower"}},
+		property{"hinge", []string{"left", "right"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:waxed_weathered_copper_door", []int{0, 1, 0, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"upper", "lower"}},
+		property{"hinge", []string{"left", "right"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:copper_trapdoor", []int{0, 1, 1, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:exposed_copper_trapdoor", []int{0, 1, 1, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:oxidized_copper_trapdoor", []int{0, 1, 1, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:weathered_copper_trapdoor", []int{0, 1, 1, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:waxed_copper_trapdoor", []int{0, 1, 1, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:waxed_exposed_copper_trapdoor", []int{0, 1, 1, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:waxed_oxidized_copper_trapdoor", []int{0, 1, 1, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:waxed_weathered_copper_trapdoor", []int{0, 1, 1, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"open", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:copper_grate", []int{1},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:exposed_copper_grate", []int{1},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:weathered_copper_grate", []int{1},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:oxidized_copper_grate", []int{1},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:waxed_copper_grate", []int{1},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:waxed_exposed_copper_grate", []int{1},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:waxed_weathered_copper_grate", []int{1},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:waxed_oxidized_copper_grate", []int{1},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:copper_bulb", []int{1, 1},
+		property{"lit", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:exposed_copper_bulb", []int{1, 1},
+		property{"lit", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:weathered_copper_bulb", []int{1, 1},
+		property{"lit", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:oxidized_copper_bulb", []int{1, 1},
+		property{"lit", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:waxed_copper_bulb", []int{1, 1},
+		property{"lit", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:waxed_exposed_copper_bulb", []int{1, 1},
+		property{"lit", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:waxed_weathered_copper_bulb", []int{1, 1},
+		property{"lit", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:waxed_oxidized_copper_bulb", []int{1, 1},
+		property{"lit", []string{"true", "false"}},
+		property{"powered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:lightning_rod", []int{4, 1, 1},
+		property{"facing", []string{"north", "east", "south", "west", "up", "down"}},
+		property{"powered", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:pointed_dripstone", []int{1, 0, 1},
+		property{"thickness", []string{"tip_merge", "tip", "frustum", "middle", "base"}},
+		property{"vertical_direction", []string{"up", "down"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	simple("minecraft:dripstone_block"),
+	withProperties("minecraft:cave_vines", []int{0, 1},
+		property{"age", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "21", "22", "23", "24", "25"}},
+		property{"berries", []string{"true", "false"}},
+	),
+	withProperties("minecraft:cave_vines_plant", []int{1},
+		property{"berries", []string{"true", "false"}},
+	),
+	simple("minecraft:spore_blossom"),
+	simple("minecraft:azalea"),
+	simple("minecraft:flowering_azalea"),
+	simple("minecraft:moss_carpet"),
+	withProperties("minecraft:pink_petals", []int{0, 0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"flower_amount", []string{"1", "2", "3", "4"}},
+	),
+	withProperties("minecraft:wildflowers", []int{0, 0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"flower_amount", []string{"1", "2", "3", "4"}},
+	),
+	withProperties("minecraft:leaf_litter", []int{0, 0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"segment_amount", []string{"1", "2", "3", "4"}},
+	),
+	simple("minecraft:moss_block"),
+	withProperties("minecraft:big_dripleaf", []int{0, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"tilt", []string{"none", "unstable", "partial", "full"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:big_dripleaf_stem", []int{0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:small_dripleaf", []int{0, 1, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"upper", "lower"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:hanging_roots", []int{1},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	simple("minecraft:rooted_dirt"),
+	simple("minecraft:mud"),
+	withProperties("minecraft:deepslate", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	simple("minecraft:cobbled_deepslate"),
+	withProperties("minecraft:cobbled_deepslate_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:cobbled_deepslate_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:cobbled_deepslate_wall", []int{0, 0, 0, 0, 1, 0},
+		property{"east", []string{"none", "low", "tall"}},
+		property{"north", []string{"none", "low", "tall"}},
+		property{"south", []string{"none", "low", "tall"}},
+		property{"up", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"none", "low", "tall"}},
+	),
+	simple("minecraft:polished_deepslate"),
+	withProperties("minecraft:polished_deepslate_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:polished_deepslate_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:polished_deepslate_wall", []int{0, 0, 0, 0, 1, 0},
+		property{"east", []string{"none", "low", "tall"}},
+		property{"north", []string{"none", "low", "tall"}},
+		property{"south", []string{"none", "low", "tall"}},
+		property{"up", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"none", "low", "tall"}},
+	),
+	simple("minecraft:deepslate_tiles"),
+	withProperties("minecraft:deepslate_tile_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:deepslate_tile_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:deepslate_tile_wall", []int{0, 0, 0, 0, 1, 0},
+		property{"east", []string{"none", "low", "tall"}},
+		property{"north", []string{"none", "low", "tall"}},
+		property{"south", []string{"none", "low", "tall"}},
+		property{"up", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"none", "low", "tall"}},
+	),
+	simple("minecraft:deepslate_bricks"),
+	withProperties("minecraft:deepslate_brick_stairs", []int{0, 1, 0, 1},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"half", []string{"top", "bottom"}},
+		property{"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:deepslate_brick_slab", []int{1, 1},
+		property{"type", []string{"top", "bottom", "double"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:deepslate_brick_wall", []int{0, 0, 0, 0, 1, 0},
+		property{"east", []string{"none", "low", "tall"}},
+		property{"north", []string{"none", "low", "tall"}},
+		property{"south", []string{"none", "low", "tall"}},
+		property{"up", []string{"true", "false"}},
+		property{"waterlogged", []string{"true", "false"}},
+		property{"west", []string{"none", "low", "tall"}},
+	),
+	simple("minecraft:chiseled_deepslate"),
+	simple("minecraft:cracked_deepslate_bricks"),
+	simple("minecraft:cracked_deepslate_tiles"),
+	withProperties("minecraft:infested_deepslate", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	simple("minecraft:smooth_basalt"),
+	simple("minecraft:raw_iron_block"),
+	simple("minecraft:raw_copper_block"),
+	simple("minecraft:raw_gold_block"),
+	simple("minecraft:potted_azalea_bush"),
+	simple("minecraft:potted_flowering_azalea_bush"),
+	withProperties("minecraft:ochre_froglight", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:verdant_froglight", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	withProperties("minecraft:pearlescent_froglight", []int{1},
+		property{"axis", []string{"x", "y", "z"}},
+	),
+	simple("minecraft:frogspawn"),
+	simple("minecraft:reinforced_deepslate"),
+	withProperties("minecraft:decorated_pot", []int{1, 0, 1},
+		property{"cracked", []string{"true", "false"}},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	withProperties("minecraft:crafter", []int{1, 10, 1},
+		property{"crafting", []string{"true", "false"}},
+		property{"orientation", []string{"down_east", "down_north", "down_south", "down_west", "up_east", "up_north", "up_south", "up_west", "west_up", "east_up", "north_up", "south_up"}},
+		property{"triggered", []string{"true", "false"}},
+	),
+	withProperties("minecraft:trial_spawner", []int{1, 0},
+		property{"ominous", []string{"true", "false"}},
+		property{"trial_spawner_state", []string{"inactive", "waiting_for_players", "active", "waiting_for_reward_ejection", "ejecting_reward", "cooldown"}},
+	),
+	withProperties("minecraft:vault", []int{0, 1, 0},
+		property{"facing", []string{"north", "south", "west", "east"}},
+		property{"ominous", []string{"true", "false"}},
+		property{"vault_state", []string{"inactive", "active", "unlocking", "ejecting"}},
+	),
+	withProperties("minecraft:heavy_core", []int{1},
+		property{"waterlogged", []string{"true", "false"}},
+	),
+	simple("minecraft:pale_moss_block"),
+	withProperties("minecraft:pale_moss_carpet", []int{0, 0, 0, 0, 0},
+		property{"bottom", []string{"true", "false"}},
+		property{"east", []string{"none", "low", "tall"}},
+		property{"north", []string{"none", "low", "tall"}},
+		property{"south", []string{"none", "low", "tall"}},
+		property{"west", []string{"none", "low", "tall"}},
+	),
+	withProperties("minecraft:pale_hanging_moss", []int{0},
+		property{"tip", []string{"true", "false"}},
+	),
+	simple("minecraft:open_eyeblossom"),
+	simple("minecraft:closed_eyeblossom"),
+	simple("minecraft:potted_open_eyeblossom"),
+	simple("minecraft:potted_closed_eyeblossom"),
+	simple("minecraft:firefly_bush"),
+}
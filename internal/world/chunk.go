@@ -0,0 +1,288 @@
+package world
+
+import (
+	"math/bits"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/beito123/nbt"
+)
+
+const (
+	SectionSize   = 16
+	SectionVolume = SectionSize * SectionSize * SectionSize
+	// Biomes are stored per cell of 4x4x4 blocks
+	BiomeSize   = 4
+	BiomeVolume = (SectionSize / BiomeSize) * (SectionSize / BiomeSize) * (SectionSize / BiomeSize)
+	// Light is stored as one nibble per block
+	LightLength = SectionVolume / 2
+)
+
+// Section is a 16x16x16 cube of a chunk column. Blocks are indexed by
+// (y << 8) | (z << 4) | x, as done by the client.
+type Section struct {
+	Blocks [SectionVolume]uint16
+	Biomes [BiomeVolume]uint16
+	// SkyLight and BlockLight are nil when the light isn't known
+	SkyLight   []byte
+	BlockLight []byte
+}
+
+// BlockCount returns the number of blocks that aren't air in the section.
+func (self *Section) BlockCount() int {
+	count := 0
+	for _, state := range self.Blocks {
+		if !IsAir(state) {
+			count += 1
+		}
+	}
+
+	return count
+}
+
+type BlockEntity struct {
+	X, Y, Z int
+	Type    int
+	Data    nbt.Tag
+}
+
+// Chunk is a column of sections from MinY up to the build limit.
+type Chunk struct {
+	X, Z          int32
+	MinY          int
+	Sections      []*Section
+	BlockEntities []BlockEntity
+
+	// dirty is set when the chunk changed since it was last saved
+	dirty atomic.Bool
+	// lit is set once the light of the chunk is known
+	lit atomic.Bool
+	// extra keeps the tags of a loaded chunk the server doesn't handle, so
+	// they are written back untouched
+	extra map[string]nbt.Tag
+}
+
+func NewChunk(x int32, z int32, minY int, height int) *Chunk {
+	sections := make([]*Section, height/SectionSize)
+	for i := range sections {
+		sections[i] = &Section{}
+	}
+
+	return &Chunk{
+		X:             x,
+		Z:             z,
+		MinY:          minY,
+		Sections:      sections,
+		BlockEntities: []BlockEntity{},
+	}
+}
+
+func (self *Chunk) Height() int {
+	return len(self.Sections) * SectionSize
+}
+
+func blockIndex(x int, y int, z int) int {
+	return (y << 8) | (z << 4) | x
+}
+
+func biomeIndex(x int, y int, z int) int {
+	return ((y >> 2) << 4) | ((z >> 2) << 2) | (x >> 2)
+}
+
+// section returns the section holding the block at y and y relative to it,
+// or nil when y is out of the chunk.
+func (self *Chunk) section(y int) (*Section, int) {
+	y -= self.MinY
+	if y < 0 || y >= self.Height() {
+		return nil, 0
+	}
+
+	return self.Sections[y/SectionSize], y % SectionSize
+}
+
+// Block returns the block state at x, y, z, the coordinates being relative
+// to the chunk horizontally and absolute vertically.
+func (self *Chunk) Block(x int, y int, z int) uint16 {
+	s, y := self.section(y)
+	if s == nil {
+		return 0
+	}
+
+	return s.Blocks[blockIndex(x&15, y, z&15)]
+}
+
+func (self *Chunk) SetBlock(x int, y int, z int, state uint16) {
+	s, y := self.section(y)
+	if s == nil {
+		return
+	}
+
+	s.Blocks[blockIndex(x&15, y, z&15)] = state
+	self.dirty.Store(true)
+}
+
+func (self *Chunk) Biome(x int, y int, z int) uint16 {
+	s, y := self.section(y)
+	if s == nil {
+		return 0
+	}
+
+	return s.Biomes[biomeIndex(x&15, y, z&15)]
+}
+
+func (self *Chunk) SetBiome(x int, y int, z int, biome uint16) {
+	s, y := self.section(y)
+	if s == nil {
+		return
+	}
+
+	s.Biomes[biomeIndex(x&15, y, z&15)] = biome
+	self.dirty.Store(true)
+}
+
+// MarkDirty flags the chunk to be saved, for changes made directly to its
+// sections or block entities.
+func (self *Chunk) MarkDirty() {
+	self.dirty.Store(true)
+}
+
+func (self *Chunk) Dirty() bool {
+	return self.dirty.Load()
+}
+
+// clone returns a copy of the chunk which later changes to it don't alter.
+// The tags of its block entities are shared, being replaced but never
+// modified.
+func (self *Chunk) clone() *Chunk {
+	sections := make([]*Section, len(self.Sections))
+	for i, s := range self.Sections {
+		copied := *s
+		copied.SkyLight = slices.Clone(s.SkyLight)
+		copied.BlockLight = slices.Clone(s.BlockLight)
+		sections[i] = &copied
+	}
+
+	return &Chunk{
+		X:             self.X,
+		Z:             self.Z,
+		MinY:          self.MinY,
+		Sections:      sections,
+		BlockEntities: slices.Clone(self.BlockEntities),
+		extra:         self.extra,
+	}
+}
+
+// HeightmapKind tells which blocks a heightmap stops at.
+type HeightmapKind int
+
+const (
+	// WorldSurface stops at any block that isn't air
+	WorldSurface HeightmapKind = iota
+	// MotionBlocking stops at the blocks players collide with and fluids
+	MotionBlocking
+	// MotionBlockingNoLeaves is MotionBlocking without the leaves
+	MotionBlockingNoLeaves
+)
+
+// Blocks always holding water, without a waterlogged property
+var waterBlocks = map[string]bool{
+	"minecraft:water":         true,
+	"minecraft:lava":          true,
+	"minecraft:bubble_column": true,
+	"minecraft:kelp":          true,
+	"minecraft:kelp_plant":    true,
+	"minecraft:seagrass":      true,
+	"minecraft:tall_seagrass": true,
+}
+
+var (
+	// motionBlocking tells for every state whether it stops the
+	// MotionBlocking heightmaps, and leaves whether it is leaves
+	motionBlocking []bool
+	leaves         []bool
+	heightmapOnce  sync.Once
+)
+
+func heightmapStates() {
+	motionBlocking = make([]bool, len(states))
+	leaves = make([]bool, len(states))
+
+	for id, s := range states {
+		fluid := waterBlocks[s.Name] || s.Properties["waterlogged"] == "true"
+		motionBlocking[id] = fluid || Solid(uint16(id))
+		leaves[id] = strings.HasSuffix(s.Name, "_leaves")
+	}
+}
+
+// stops reports whether a heightmap of kind stops at state.
+func stops(kind HeightmapKind, state uint16) bool {
+	if kind == WorldSurface || int(state) >= len(motionBlocking) {
+		return !IsAir(state)
+	}
+
+	if kind == MotionBlockingNoLeaves && leaves[state] {
+		return false
+	}
+
+	return motionBlocking[state]
+}
+
+// Heightmap returns, for every column indexed by (z << 4) | x, the height
+// above MinY of the first block the heightmap of kind stops at, counting
+// from the top.
+func (self *Chunk) Heightmap(kind HeightmapKind) []int {
+	heightmapOnce.Do(heightmapStates)
+
+	heights := make([]int, SectionSize*SectionSize)
+
+	for i := range heights {
+		x, z := i&15, i>>4
+
+		for y := self.Height() - 1; y >= 0; y-- {
+			if stops(kind, self.Block(x, self.MinY+y, z)) {
+				heights[i] = y + 1
+				break
+			}
+		}
+	}
+
+	return heights
+}
+
+// Pack packs values of bits bits in longs, without an entry spanning two
+// longs, the way both the protocol and the Anvil format store them.
+func Pack(values []uint16, bits int) []int64 {
+	perLong := 64 / bits
+	longs := make([]int64, (len(values)+perLong-1)/perLong)
+
+	for i, v := range values {
+		longs[i/perLong] |= int64(v) << ((i % perLong) * bits)
+	}
+
+	return longs
+}
+
+// Unpack is the reverse of Pack, it fills values from longs.
+func Unpack(longs []int64, bits int, values []uint16) {
+	perLong := 64 / bits
+	mask := uint64(1)<<bits - 1
+
+	for i := range values {
+		if i/perLong >= len(longs) {
+			return
+		}
+
+		values[i] = uint16((uint64(longs[i/perLong]) >> ((i % perLong) * bits)) & mask)
+	}
+}
+
+// BitsFor returns the number of bits needed to index n values.
+func BitsFor(n int) int {
+	if n <= 1 {
+		return 0
+	}
+
+	return bits.Len(uint(n - 1))
+}
@@ -0,0 +1,165 @@
+package world
+
+import (
+	"bytes"
+	"errors"
+	"os"
+
+	"github.com/beito123/nbt"
+)
+
+// A compact world is a single file holding a whole small map: a magic, a
+// version and the gzipped NBT of the level, the chunks and the entities,
+// stored like in the region files.
+var compactMagic = []byte("MCWC")
+
+const compactVersion = 1
+
+// Compact is a read-only store for compact world files, loaded in memory
+// at once. Changes made to the world are lost when the server stops.
+type Compact struct {
+	minY     int
+	height   int
+	level    Level
+	chunks   map[[2]int32]nbt.Tag
+	entities map[[2]int32][]nbt.Tag
+}
+
+func OpenCompact(path string, minY int, height int) (*Compact, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.HasPrefix(data, compactMagic) || len(data) <= len(compactMagic) {
+		return nil, errors.New("Not a compact world")
+	}
+
+	if version := data[len(compactMagic)]; version != compactVersion {
+		return nil, errors.New("Unsupported compact world version")
+	}
+
+	root, err := readNBT(data[len(compactMagic)+1:])
+	if err != nil {
+		return nil, err
+	}
+
+	store := &Compact{
+		minY:     minY,
+		height:   height,
+		level:    levelFromNBT(compoundOf(root, "Level")),
+		chunks:   make(map[[2]int32]nbt.Tag),
+		entities: make(map[[2]int32][]nbt.Tag),
+	}
+
+	for _, chunk := range listOf(root, "Chunks") {
+		key := [2]int32{int32(intOf(chunk, "xPos")), int32(intOf(chunk, "zPos"))}
+		store.chunks[key] = chunk
+	}
+
+	for _, entry := range listOf(root, "Entities") {
+		position, ok := child(entry, "Position").(*nbt.IntArray)
+		if !ok || len(position.Value) != 2 {
+			continue
+		}
+
+		key := [2]int32{position.Value[0], position.Value[1]}
+		store.entities[key] = listOf(entry, "Entities")
+	}
+
+	return store, nil
+}
+
+func (self *Compact) LoadLevel() (Level, error) {
+	return self.level, nil
+}
+
+func (self *Compact) SaveLevel(level Level) error {
+	return ErrReadOnly
+}
+
+func (self *Compact) LoadChunk(x int32, z int32) (*Chunk, error) {
+	tag, ok := self.chunks[[2]int32{x, z}]
+	if !ok {
+		return nil, nil
+	}
+
+	return decodeChunk(tag, x, z, self.minY, self.height)
+}
+
+func (self *Compact) SaveChunk(chunk *Chunk) error {
+	return ErrReadOnly
+}
+
+func (self *Compact) LoadEntities(x int32, z int32) ([]nbt.Tag, error) {
+	return self.entities[[2]int32{x, z}], nil
+}
+
+// LoadPlayer returns nil, compact worlds leaving the players out.
+func (self *Compact) LoadPlayer(id string) (*PlayerData, error) {
+	return nil, nil
+}
+
+func (self *Compact) SavePlayer(id string, player PlayerData) error {
+	return ErrReadOnly
+}
+
+func (self *Compact) Close() error {
+	return nil
+}
+
+// WriteCompact writes the chunks at positions of a store, with their
+// entities and the level, to a compact world file.
+func WriteCompact(path string, from WorldStore, positions [][2]int32) error {
+	level, err := from.LoadLevel()
+	if err != nil {
+		return err
+	}
+
+	data := make(map[string]nbt.Tag)
+	level.encode(data)
+
+	chunks := make([]nbt.Tag, 0, len(positions))
+	entities := make([]nbt.Tag, 0)
+
+	for _, pos := range positions {
+		chunk, err := from.LoadChunk(pos[0], pos[1])
+		if err != nil {
+			return err
+		}
+
+		if chunk == nil {
+			continue
+		}
+
+		tag, err := encodeChunk(chunk)
+		if err != nil {
+			return err
+		}
+
+		chunks = append(chunks, tag)
+
+		list, err := from.LoadEntities(pos[0], pos[1])
+		if err != nil {
+			return err
+		}
+
+		if len(list) > 0 {
+			entities = append(entities, encodeEntities(pos[0], pos[1], list))
+		}
+	}
+
+	root := nbt.NewCompoundTag("", map[string]nbt.Tag{
+		"Level":    nbt.NewCompoundTag("Level", data),
+		"Chunks":   nbt.NewListTag("Chunks", chunks, nbt.IDTagCompound),
+		"Entities": nbt.NewListTag("Entities", entities, nbt.IDTagCompound),
+	})
+
+	compressed, err := writeGzipNBT(root)
+	if err != nil {
+		return err
+	}
+
+	header := append(append([]byte{}, compactMagic...), compactVersion)
+	return writeFileAtomic(path, append(header, compressed...))
+}
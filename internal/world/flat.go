@@ -0,0 +1,140 @@
+package world
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Generator makes the chunks a world doesn't have yet.
+type Generator interface {
+	Generate(x int32, z int32) *Chunk
+	// SpawnHeight returns the height a player can spawn at over x, z
+	SpawnHeight(x int, z int) int
+}
+
+// DefaultFlatPreset is the Classic Flat preset of the game.
+const DefaultFlatPreset = "minecraft:bedrock,2*minecraft:dirt,minecraft:grass_block;minecraft:plains"
+
+type flatLayer struct {
+	state uint16
+	count int
+}
+
+// Flat generates superflat worlds, every chunk being the same stack of
+// layers over a single biome.
+type Flat struct {
+	minY     int
+	height   int
+	template *Chunk
+	top      int
+}
+
+// NewFlat parses a superflat preset, layers from the bottom up separated by
+// commas, each optionally repeated with a count, then the biome:
+//
+//	minecraft:bedrock,2*minecraft:dirt,minecraft:grass_block;minecraft:plains
+func NewFlat(preset string, minY int, height int) (*Flat, error) {
+	parts := strings.Split(preset, ";")
+
+	layers := make([]flatLayer, 0)
+	for _, entry := range strings.Split(parts[0], ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		layer, err := parseFlatLayer(entry)
+		if err != nil {
+			return nil, err
+		}
+
+		layers = append(layers, layer)
+	}
+
+	biome := mustBiome("minecraft:plains")
+	if len(parts) > 1 && strings.TrimSpace(parts[1]) != "" {
+		id, ok := BiomeID(namespaced(strings.TrimSpace(parts[1])))
+		if !ok {
+			return nil, fmt.Errorf("Unknown biome %s", parts[1])
+		}
+
+		biome = id
+	}
+
+	template := NewChunk(0, 0, minY, height)
+	for _, s := range template.Sections {
+		for i := range s.Biomes {
+			s.Biomes[i] = biome
+		}
+	}
+
+	y := minY
+	for _, layer := range layers {
+		for range layer.count {
+			if y >= minY+height {
+				break
+			}
+
+			for x := range SectionSize {
+				for z := range SectionSize {
+					template.SetBlock(x, y, z, layer.state)
+				}
+			}
+
+			y += 1
+		}
+	}
+
+	return &Flat{
+		minY:     minY,
+		height:   height,
+		template: template,
+		top:      y,
+	}, nil
+}
+
+func namespaced(name string) string {
+	if !strings.Contains(name, ":") {
+		return "minecraft:" + name
+	}
+
+	return name
+}
+
+func parseFlatLayer(entry string) (flatLayer, error) {
+	count := 1
+	name := entry
+
+	if i := strings.IndexAny(entry, "*x"); i > 0 {
+		if n, err := strconv.Atoi(entry[:i]); err == nil {
+			count = n
+			name = entry[i+1:]
+		}
+	}
+
+	if count < 1 {
+		return flatLayer{}, fmt.Errorf("Bad layer count in %s", entry)
+	}
+
+	state, ok := DefaultState(namespaced(name))
+	if !ok {
+		return flatLayer{}, fmt.Errorf("Unknown block %s", name)
+	}
+
+	return flatLayer{state: state, count: count}, nil
+}
+
+func (self *Flat) Generate(x int32, z int32) *Chunk {
+	chunk := NewChunk(x, z, self.minY, self.height)
+
+	for i, s := range self.template.Sections {
+		*chunk.Sections[i] = *s
+	}
+
+	return chunk
+}
+
+func (self *Flat) SpawnHeight(x int, z int) int {
+	return self.top
+}
@@ -0,0 +1,128 @@
+package world
+
+// How blocks break is generated from minecraft-data into hardness_gen.go,
+// the reports of the data generator not describing it.
+
+// Tool is the kind of tool mining a block faster.
+type Tool byte
+
+const (
+	AnyTool Tool = iota
+	Pickaxe
+	Axe
+	Shovel
+	Hoe
+)
+
+// Tiers of the tools, the blocks needing a tool requiring at least one
+const (
+	WoodTier = iota
+	StoneTier
+	IronTier
+	DiamondTier
+	NetheriteTier
+)
+
+// Hardness tells how long a block takes to break. Blocks with
+// RequiresTool only drop when mined with the right tool of Tier or above,
+// and take longer to break without it.
+type Hardness struct {
+	// Value is -1 for the blocks which can't be broken
+	Value        float32
+	Tool         Tool
+	Tier         int
+	RequiresTool bool
+}
+
+// Unbreakable reports whether the block can't be broken in survival.
+func (self Hardness) Unbreakable() bool {
+	return self.Value < 0
+}
+
+// unbreakable are the blocks players can't break in survival besides the
+// ones of hardness -1 in the table: air and fluids, which they can't aim at
+var unbreakable = []string{
+	"minecraft:air", "minecraft:cave_air", "minecraft:void_air",
+	"minecraft:water", "minecraft:lava", "minecraft:bubble_column",
+}
+
+func init() {
+	for _, name := range unbreakable {
+		hardness[name] = Hardness{Value: -1}
+	}
+}
+
+// BlockHardness returns how a block breaks. The blocks missing from the
+// table, which come from worlds of other versions, can't be broken.
+func BlockHardness(name string) Hardness {
+	if h, ok := hardness[name]; ok {
+		return h
+	}
+
+	return Hardness{Value: -1}
+}
+
+// replaceable blocks give way to the blocks placed in them. They are the
+// #minecraft:replaceable block tag, which minecraft-data doesn't describe.
+var replaceable = map[string]bool{
+	"minecraft:air":             true,
+	"minecraft:water":           true,
+	"minecraft:lava":            true,
+	"minecraft:short_grass":     true,
+	"minecraft:fern":            true,
+	"minecraft:dead_bush":       true,
+	"minecraft:bush":            true,
+	"minecraft:short_dry_grass": true,
+	"minecraft:tall_dry_grass":  true,
+	"minecraft:seagrass":        true,
+	"minecraft:tall_seagrass":   true,
+	"minecraft:fire":            true,
+	"minecraft:soul_fire":       true,
+	"minecraft:snow":            true,
+	"minecraft:vine":            true,
+	"minecraft:glow_lichen":     true,
+	"minecraft:resin_clump":     true,
+	"minecraft:light":           true,
+	"minecraft:tall_grass":      true,
+	"minecraft:large_fern":      true,
+	"minecraft:structure_void":  true,
+	"minecraft:void_air":        true,
+	"minecraft:cave_air":        true,
+	"minecraft:bubble_column":   true,
+	"minecraft:warped_roots":    true,
+	"minecraft:nether_sprouts":  true,
+	"minecraft:crimson_roots":   true,
+	"minecraft:hanging_roots":   true,
+	"minecraft:leaf_litter":     true,
+}
+
+// Replaceable reports whether placing a block in state replaces it.
+func Replaceable(state uint16) bool {
+	s, ok := State(state)
+	if !ok {
+		return false
+	}
+
+	// Only the thinnest layer of snow is replaced
+	if s.Name == "minecraft:snow" {
+		return s.Properties["layers"] == "1"
+	}
+
+	return replaceable[s.Name]
+}
+
+// Solid reports whether players collide with a block, which then can't be
+// placed where they stand.
+func Solid(state uint16) bool {
+	s, ok := State(state)
+	if !ok {
+		return true
+	}
+
+	// Layers of snow collide from the second one
+	if s.Name == "minecraft:snow" {
+		return s.Properties["layers"] != "1"
+	}
+
+	return !passable[s.Name]
+}
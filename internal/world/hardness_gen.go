@@ -0,0 +1,1357 @@
+// Code generated by gendata from minecraft-data. DO NOT EDIT.
+
+package world
+
+var hardness = map[string]Hardness{
+	"minecraft:air":                                {Value: 0},
+	"minecraft:stone":                              {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:granite":                            {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:polished_granite":                   {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:diorite":                            {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:polished_diorite":                   {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:andesite":                           {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:polished_andesite":                  {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:grass_block":                        {Value: 0.6, Tool: Shovel},
+	"minecraft:dirt":                               {Value: 0.5, Tool: Shovel},
+	"minecraft:coarse_dirt":                        {Value: 0.5, Tool: Shovel},
+	"minecraft:podzol":                             {Value: 0.5, Tool: Shovel},
+	"minecraft:cobblestone":                        {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:oak_planks":                         {Value: 2, Tool: Axe},
+	"minecraft:spruce_planks":                      {Value: 2, Tool: Axe},
+	"minecraft:birch_planks":                       {Value: 2, Tool: Axe},
+	"minecraft:jungle_planks":                      {Value: 2, Tool: Axe},
+	"minecraft:acacia_planks":                      {Value: 2, Tool: Axe},
+	"minecraft:cherry_planks":                      {Value: 2, Tool: Axe},
+	"minecraft:dark_oak_planks":                    {Value: 2, Tool: Axe},
+	"minecraft:pale_oak_wood":                      {Value: 2, Tool: Axe},
+	"minecraft:pale_oak_planks":                    {Value: 2, Tool: Axe},
+	"minecraft:mangrove_planks":                    {Value: 2, Tool: Axe},
+	"minecraft:bamboo_planks":                      {Value: 2, Tool: Axe},
+	"minecraft:bamboo_mosaic":                      {Value: 2, Tool: Axe},
+	"minecraft:oak_sapling":                        {Value: 0},
+	"minecraft:spruce_sapling":                     {Value: 0},
+	"minecraft:birch_sapling":                      {Value: 0},
+	"minecraft:jungle_sapling":                     {Value: 0},
+	"minecraft:acacia_sapling":                     {Value: 0},
+	"minecraft:cherry_sapling":                     {Value: 0},
+	"minecraft:dark_oak_sapling":                   {Value: 0},
+	"minecraft:pale_oak_sapling":                   {Value: 0},
+	"minecraft:mangrove_propagule":                 {Value: 0},
+	"minecraft:bedrock":                            {Value: -1},
+	"minecraft:water":                              {Value: 100},
+	"minecraft:lava":                               {Value: 100},
+	"minecraft:sand":                               {Value: 0.5, Tool: Shovel},
+	"minecraft:suspicious_sand":                    {Value: 0.25, Tool: Shovel},
+	"minecraft:red_sand":                           {Value: 0.5, Tool: Shovel},
+	"minecraft:gravel":                             {Value: 0.6, Tool: Shovel},
+	"minecraft:suspicious_gravel":                  {Value: 0.25, Tool: Shovel},
+	"minecraft:gold_ore":                           {Value: 3, Tool: Pickaxe, Tier: IronTier, RequiresTool: true},
+	"minecraft:deepslate_gold_ore":                 {Value: 4.5, Tool: Pickaxe, Tier: IronTier, RequiresTool: true},
+	"minecraft:iron_ore":                           {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:deepslate_iron_ore":                 {Value: 4.5, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:coal_ore":                           {Value: 3, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:deepslate_coal_ore":                 {Value: 4.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:nether_gold_ore":                    {Value: 3, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:oak_log":                            {Value: 2, Tool: Axe},
+	"minecraft:spruce_log":                         {Value: 2, Tool: Axe},
+	"minecraft:birch_log":                          {Value: 2, Tool: Axe},
+	"minecraft:jungle_log":                         {Value: 2, Tool: Axe},
+	"minecraft:acacia_log":                         {Value: 2, Tool: Axe},
+	"minecraft:cherry_log":                         {Value: 2, Tool: Axe},
+	"minecraft:dark_oak_log":                       {Value: 2, Tool: Axe},
+	"minecraft:pale_oak_log":                       {Value: 2, Tool: Axe},
+	"minecraft:mangrove_log":                       {Value: 2, Tool: Axe},
+	"minecraft:mangrove_roots":                     {Value: 0.7, Tool: Axe},
+	"minecraft:muddy_mangrove_roots":               {Value: 0.7, Tool: Shovel},
+	"minecraft:bamboo_block":                       {Value: 2, Tool: Axe},
+	"minecraft:stripped_spruce_log":                {Value: 2, Tool: Axe},
+	"minecraft:stripped_birch_log":                 {Value: 2, Tool: Axe},
+	"minecraft:stripped_jungle_log":                {Value: 2, Tool: Axe},
+	"minecraft:stripped_acacia_log":                {Value: 2, Tool: Axe},
+	"minecraft:stripped_cherry_log":                {Value: 2, Tool: Axe},
+	"minecraft:stripped_dark_oak_log":              {Value: 2, Tool: Axe},
+	"minecraft:stripped_pale_oak_log":              {Value: 2, Tool: Axe},
+	"minecraft:stripped_oak_log":                   {Value: 2, Tool: Axe},
+	"minecraft:stripped_mangrove_log":              {Value: 2, Tool: Axe},
+	"minecraft:stripped_bamboo_block":              {Value: 2, Tool: Axe},
+	"minecraft:oak_wood":                           {Value: 2, Tool: Axe},
+	"minecraft:spruce_wood":                        {Value: 2, Tool: Axe},
+	"minecraft:birch_wood":                         {Value: 2, Tool: Axe},
+	"minecraft:jungle_wood":                        {Value: 2, Tool: Axe},
+	"minecraft:acacia_wood":                        {Value: 2, Tool: Axe},
+	"minecraft:cherry_wood":                        {Value: 2, Tool: Axe},
+	"minecraft:dark_oak_wood":                      {Value: 2, Tool: Axe},
+	"minecraft:mangrove_wood":                      {Value: 2, Tool: Axe},
+	"minecraft:stripped_oak_wood":                  {Value: 2, Tool: Axe},
+	"minecraft:stripped_spruce_wood":               {Value: 2, Tool: Axe},
+	"minecraft:stripped_birch_wood":                {Value: 2, Tool: Axe},
+	"minecraft:stripped_jungle_wood":               {Value: 2, Tool: Axe},
+	"minecraft:stripped_acacia_wood":               {Value: 2, Tool: Axe},
+	"minecraft:stripped_cherry_wood":               {Value: 2, Tool: Axe},
+	"minecraft:stripped_dark_oak_wood":             {Value: 2, Tool: Axe},
+	"minecraft:stripped_pale_oak_wood":             {Value: 2, Tool: Axe},
+	"minecraft:stripped_mangrove_wood":             {Value: 2, Tool: Axe},
+	"minecraft:oak_leaves":                         {Value: 0.2, Tool: Hoe},
+	"minecraft:spruce_leaves":                      {Value: 0.2, Tool: Hoe},
+	"minecraft:birch_leaves":                       {Value: 0.2, Tool: Hoe},
+	"minecraft:jungle_leaves":                      {Value: 0.2, Tool: Hoe},
+	"minecraft:acacia_leaves":                      {Value: 0.2, Tool: Hoe},
+	"minecraft:cherry_leaves":                      {Value: 0.2, Tool: Hoe},
+	"minecraft:dark_oak_leaves":                    {Value: 0.2, Tool: Hoe},
+	"minecraft:pale_oak_leaves":                    {Value: 0.2, Tool: Hoe},
+	"minecraft:mangrove_leaves":                    {Value: 0.2, Tool: Hoe},
+	"minecraft:azalea_leaves":                      {Value: 0.2, Tool: Hoe},
+	"minecraft:flowering_azalea_leaves":            {Value: 0.2, Tool: Hoe},
+	"minecraft:sponge":                             {Value: 0.6, Tool: Hoe},
+	"minecraft:wet_sponge":                         {Value: 0.6, Tool: Hoe},
+	"minecraft:glass":                              {Value: 0.3},
+	"minecraft:lapis_ore":                          {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:deepslate_lapis_ore":                {Value: 4.5, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:lapis_block":                        {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:dispenser":                          {Value: 3.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:sandstone":                          {Value: 0.8, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:chiseled_sandstone":                 {Value: 0.8, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:cut_sandstone":                      {Value: 0.8, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:note_block":                         {Value: 0.8, Tool: Axe},
+	"minecraft:white_bed":                          {Value: 0.2},
+	"minecraft:orange_bed":                         {Value: 0.2},
+	"minecraft:magenta_bed":                        {Value: 0.2},
+	"minecraft:light_blue_bed":                     {Value: 0.2},
+	"minecraft:yellow_bed":                         {Value: 0.2},
+	"minecraft:lime_bed":                           {Value: 0.2},
+	"minecraft:pink_bed":                           {Value: 0.2},
+	"minecraft:gray_bed":                           {Value: 0.2},
+	"minecraft:light_gray_bed":                     {Value: 0.2},
+	"minecraft:cyan_bed":                           {Value: 0.2},
+	"minecraft:purple_bed":                         {Value: 0.2},
+	"minecraft:blue_bed":                           {Value: 0.2},
+	"minecraft:brown_bed":                          {Value: 0.2},
+	"minecraft:green_bed":                          {Value: 0.2},
+	"minecraft:red_bed":                            {Value: 0.2},
+	"minecraft:black_bed":                          {Value: 0.2},
+	"minecraft:powered_rail":                       {Value: 0.7, Tool: Pickaxe},
+	"minecraft:detector_rail":                      {Value: 0.7, Tool: Pickaxe},
+	"minecraft:sticky_piston":                      {Value: 1.5, Tool: Pickaxe},
+	"minecraft:cobweb":                             {Value: 4, RequiresTool: true},
+	"minecraft:short_grass":                        {Value: 0},
+	"minecraft:fern":                               {Value: 0},
+	"minecraft:dead_bush":                          {Value: 0},
+	"minecraft:bush":                               {Value: 0},
+	"minecraft:short_dry_grass":                    {Value: 0},
+	"minecraft:tall_dry_grass":                     {Value: 0},
+	"minecraft:seagrass":                           {Value: 0},
+	"minecraft:tall_seagrass":                      {Value: 0},
+	"minecraft:piston":                             {Value: 1.5, Tool: Pickaxe},
+	"minecraft:piston_head":                        {Value: 1.5, Tool: Pickaxe},
+	"minecraft:white_wool":                         {Value: 0.8},
+	"minecraft:orange_wool":                        {Value: 0.8},
+	"minecraft:magenta_wool":                       {Value: 0.8},
+	"minecraft:light_blue_wool":                    {Value: 0.8},
+	"minecraft:yellow_wool":                        {Value: 0.8},
+	"minecraft:lime_wool":                          {Value: 0.8},
+	"minecraft:pink_wool":                          {Value: 0.8},
+	"minecraft:gray_wool":                          {Value: 0.8},
+	"minecraft:light_gray_wool":                    {Value: 0.8},
+	"minecraft:cyan_wool":                          {Value: 0.8},
+	"minecraft:purple_wool":                        {Value: 0.8},
+	"minecraft:blue_wool":                          {Value: 0.8},
+	"minecraft:brown_wool":                         {Value: 0.8},
+	"minecraft:green_wool":                         {Value: 0.8},
+	"minecraft:red_wool":                           {Value: 0.8},
+	"minecraft:black_wool":                         {Value: 0.8},
+	"minecraft:moving_piston":                      {Value: -1},
+	"minecraft:dandelion":                          {Value: 0},
+	"minecraft:torchflower":                        {Value: 0},
+	"minecraft:poppy":                              {Value: 0},
+	"minecraft:blue_orchid":                        {Value: 0},
+	"minecraft:allium":                             {Value: 0},
+	"minecraft:azure_bluet":                        {Value: 0},
+	"minecraft:red_tulip":                          {Value: 0},
+	"minecraft:orange_tulip":                       {Value: 0},
+	"minecraft:white_tulip":                        {Value: 0},
+	"minecraft:pink_tulip":                         {Value: 0},
+	"minecraft:oxeye_daisy":                        {Value: 0},
+	"minecraft:cornflower":                         {Value: 0},
+	"minecraft:wither_rose":                        {Value: 0},
+	"minecraft:lily_of_the_valley":                 {Value: 0},
+	"minecraft:brown_mushroom":                     {Value: 0},
+	"minecraft:red_mushroom":                       {Value: 0},
+	"minecraft:gold_block":                         {Value: 3, Tool: Pickaxe, Tier: IronTier, RequiresTool: true},
+	"minecraft:iron_block":                         {Value: 5, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:bricks":                             {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:tnt":                                {Value: 0},
+	"minecraft:bookshelf":                          {Value: 1.5, Tool: Axe},
+	"minecraft:chiseled_bookshelf":                 {Value: 1.5, Tool: Axe},
+	"minecraft:mossy_cobblestone":                  {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:obsidian":                           {Value: 50, Tool: Pickaxe, Tier: DiamondTier, RequiresTool: true},
+	"minecraft:torch":                              {Value: 0},
+	"minecraft:wall_torch":                         {Value: 0},
+	"minecraft:fire":                               {Value: 0},
+	"minecraft:soul_fire":                          {Value: 0},
+	"minecraft:spawner":                            {Value: 5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:creaking_heart":                     {Value: 10, Tool: Axe},
+	"minecraft:oak_stairs":                         {Value: 2, Tool: Axe},
+	"minecraft:chest":                              {Value: 2.5, Tool: Axe},
+	"minecraft:redstone_wire":                      {Value: 0},
+	"minecraft:diamond_ore":                        {Value: 3, Tool: Pickaxe, Tier: IronTier, RequiresTool: true},
+	"minecraft:deepslate_diamond_ore":              {Value: 4.5, Tool: Pickaxe, Tier: IronTier, RequiresTool: true},
+	"minecraft:diamond_block":                      {Value: 5, Tool: Pickaxe, Tier: IronTier, RequiresTool: true},
+	"minecraft:crafting_table":                     {Value: 2.5, Tool: Axe},
+	"minecraft:wheat":                              {Value: 0},
+	"minecraft:farmland":                           {Value: 0.6, Tool: Shovel},
+	"minecraft:furnace":                            {Value: 3.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:oak_sign":                           {Value: 1, Tool: Axe},
+	"minecraft:spruce_sign":                        {Value: 1, Tool: Axe},
+	"minecraft:birch_sign":                         {Value: 1, Tool: Axe},
+	"minecraft:acacia_sign":                        {Value: 1, Tool: Axe},
+	"minecraft:cherry_sign":                        {Value: 1, Tool: Axe},
+	"minecraft:jungle_sign":                        {Value: 1, Tool: Axe},
+	"minecraft:dark_oak_sign":                      {Value: 1, Tool: Axe},
+	"minecraft:pale_oak_sign":                      {Value: 1, Tool: Axe},
+	"minecraft:mangrove_sign":                      {Value: 1, Tool: Axe},
+	"minecraft:bamboo_sign":                        {Value: 1, Tool: Axe},
+	"minecraft:oak_door":                           {Value: 3, Tool: Axe},
+	"minecraft:ladder":                             {Value: 0.4, Tool: Axe},
+	"minecraft:rail":                               {Value: 0.7, Tool: Pickaxe},
+	"minecraft:cobblestone_stairs":                 {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:oak_wall_sign":                      {Value: 1, Tool: Axe},
+	"minecraft:spruce_wall_sign":                   {Value: 1, Tool: Axe},
+	"minecraft:birch_wall_sign":                    {Value: 1, Tool: Axe},
+	"minecraft:acacia_wall_sign":                   {Value: 1, Tool: Axe},
+	"minecraft:cherry_wall_sign":                   {Value: 1, Tool: Axe},
+	"minecraft:jungle_wall_sign":                   {Value: 1, Tool: Axe},
+	"minecraft:dark_oak_wall_sign":                 {Value: 1, Tool: Axe},
+	"minecraft:pale_oak_wall_sign":                 {Value: 1, Tool: Axe},
+	"minecraft:mangrove_wall_sign":                 {Value: 1, Tool: Axe},
+	"minecraft:bamboo_wall_sign":                   {Value: 1, Tool: Axe},
+	"minecraft:oak_hanging_sign":                   {Value: 1, Tool: Axe},
+	"minecraft:spruce_hanging_sign":                {Value: 1, Tool: Axe},
+	"minecraft:birch_hanging_sign":                 {Value: 1, Tool: Axe},
+	"minecraft:acacia_hanging_sign":                {Value: 1, Tool: Axe},
+	"minecraft:cherry_hanging_sign":                {Value: 1, Tool: Axe},
+	"minecraft:jungle_hanging_sign":                {Value: 1, Tool: Axe},
+	"minecraft:dark_oak_hanging_sign":              {Value: 1, Tool: Axe},
+	"minecraft:pale_oak_hanging_sign":              {Value: 1, Tool: Axe},
+	"minecraft:crimson_hanging_sign":               {Value: 1, Tool: Axe},
+	"minecraft:warped_hanging_sign":                {Value: 1, Tool: Axe},
+	"minecraft:mangrove_hanging_sign":              {Value: 1, Tool: Axe},
+	"minecraft:bamboo_hanging_sign":                {Value: 1, Tool: Axe},
+	"minecraft:oak_wall_hanging_sign":              {Value: 1, Tool: Axe},
+	"minecraft:spruce_wall_hanging_sign":           {Value: 1, Tool: Axe},
+	"minecraft:birch_wall_hanging_sign":            {Value: 1, Tool: Axe},
+	"minecraft:acacia_wall_hanging_sign":           {Value: 1, Tool: Axe},
+	"minecraft:cherry_wall_hanging_sign":           {Value: 1, Tool: Axe},
+	"minecraft:jungle_wall_hanging_sign":           {Value: 1, Tool: Axe},
+	"minecraft:dark_oak_wall_hanging_sign":         {Value: 1, Tool: Axe},
+	"minecraft:pale_oak_wall_hanging_sign":         {Value: 1, Tool: Axe},
+	"minecraft:mangrove_wall_hanging_sign":         {Value: 1, Tool: Axe},
+	"minecraft:crimson_wall_hanging_sign":          {Value: 1, Tool: Axe},
+	"minecraft:warped_wall_hanging_sign":           {Value: 1, Tool: Axe},
+	"minecraft:bamboo_wall_hanging_sign":           {Value: 1, Tool: Axe},
+	"minecraft:lever":                              {Value: 0.5},
+	"minecraft:stone_pressure_plate":               {Value: 0.5, Tool: Pickaxe},
+	"minecraft:iron_door":                          {Value: 5, Tool: Pickaxe},
+	"minecraft:oak_pressure_plate":                 {Value: 0.5, Tool: Axe},
+	"minecraft:spruce_pressure_plate":              {Value: 0.5, Tool: Axe},
+	"minecraft:birch_pressure_plate":               {Value: 0.5, Tool: Axe},
+	"minecraft:jungle_pressure_plate":              {Value: 0.5, Tool: Axe},
+	"minecraft:acacia_pressure_plate":              {Value: 0.5, Tool: Axe},
+	"minecraft:cherry_pressure_plate":              {Value: 0.5, Tool: Axe},
+	"minecraft:dark_oak_pressure_plate":            {Value: 0.5, Tool: Axe},
+	"minecraft:pale_oak_pressure_plate":            {Value: 0.5, Tool: Axe},
+	"minecraft:mangrove_pressure_plate":            {Value: 0.5, Tool: Axe},
+	"minecraft:bamboo_pressure_plate":              {Value: 0.5, Tool: Axe},
+	"minecraft:redstone_ore":                       {Value: 3, Tool: Pickaxe, Tier: IronTier, RequiresTool: true},
+	"minecraft:deepslate_redstone_ore":             {Value: 4.5, Tool: Pickaxe, Tier: IronTier, RequiresTool: true},
+	"minecraft:redstone_torch":                     {Value: 0},
+	"minecraft:redstone_wall_torch":                {Value: 0},
+	"minecraft:stone_button":                       {Value: 0.5, Tool: Pickaxe},
+	"minecraft:snow":                               {Value: 0.1, Tool: Shovel, RequiresTool: true},
+	"minecraft:ice":                                {Value: 0.5, Tool: Pickaxe},
+	"minecraft:snow_block":                         {Value: 0.2, Tool: Shovel, RequiresTool: true},
+	"minecraft:cactus":                             {Value: 0.4},
+	"minecraft:cactus_flower":                      {Value: 0},
+	"minecraft:clay":                               {Value: 0.6, Tool: Shovel},
+	"minecraft:sugar_cane":                         {Value: 0},
+	"minecraft:jukebox":                            {Value: 2, Tool: Axe},
+	"minecraft:oak_fence":                          {Value: 2, Tool: Axe},
+	"minecraft:netherrack":                         {Value: 0.4, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:soul_sand":                          {Value: 0.5, Tool: Shovel},
+	"minecraft:soul_soil":                          {Value: 0.5, Tool: Shovel},
+	"minecraft:basalt":                             {Value: 1.25, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:polished_basalt":                    {Value: 1.25, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:soul_torch":                         {Value: 0},
+	"minecraft:soul_wall_torch":                    {Value: 0},
+	"minecraft:glowstone":                          {Value: 0.3},
+	"minecraft:nether_portal":                      {Value: -1},
+	"minecraft:carved_pumpkin":                     {Value: 1, Tool: Axe},
+	"minecraft:jack_o_lantern":                     {Value: 1, Tool: Axe},
+	"minecraft:cake":                               {Value: 0.5},
+	"minecraft:repeater":                           {Value: 0},
+	"minecraft:white_stained_glass":                {Value: 0.3},
+	"minecraft:orange_stained_glass":               {Value: 0.3},
+	"minecraft:magenta_stained_glass":              {Value: 0.3},
+	"minecraft:light_blue_stained_glass":           {Value: 0.3},
+	"minecraft:yellow_stained_glass":               {Value: 0.3},
+	"minecraft:lime_stained_glass":                 {Value: 0.3},
+	"minecraft:pink_stained_glass":                 {Value: 0.3},
+	"minecraft:gray_stained_glass":                 {Value: 0.3},
+	"minecraft:light_gray_stained_glass":           {Value: 0.3},
+	"minecraft:cyan_stained_glass":                 {Value: 0.3},
+	"minecraft:purple_stained_glass":               {Value: 0.3},
+	"minecraft:blue_stained_glass":                 {Value: 0.3},
+	"minecraft:brown_stained_glass":                {Value: 0.3},
+	"minecraft:green_stained_glass":                {Value: 0.3},
+	"minecraft:red_stained_glass":                  {Value: 0.3},
+	"minecraft:black_stained_glass":                {Value: 0.3},
+	"minecraft:oak_trapdoor":                       {Value: 3, Tool: Axe},
+	"minecraft:spruce_trapdoor":                    {Value: 3, Tool: Axe},
+	"minecraft:birch_trapdoor":                     {Value: 3, Tool: Axe},
+	"minecraft:jungle_trapdoor":                    {Value: 3, Tool: Axe},
+	"minecraft:acacia_trapdoor":                    {Value: 3, Tool: Axe},
+	"minecraft:cherry_trapdoor":                    {Value: 3, Tool: Axe},
+	"minecraft:dark_oak_trapdoor":                  {Value: 3, Tool: Axe},
+	"minecraft:pale_oak_trapdoor":                  {Value: 3, Tool: Axe},
+	"minecraft:mangrove_trapdoor":                  {Value: 3, Tool: Axe},
+	"minecraft:bamboo_trapdoor":                    {Value: 3, Tool: Axe},
+	"minecraft:stone_bricks":                       {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:mossy_stone_bricks":                 {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:cracked_stone_bricks":               {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:chiseled_stone_bricks":              {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:packed_mud":                         {Value: 1, Tool: Pickaxe},
+	"minecraft:mud_bricks":                         {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:infested_stone":                     {Value: 0.75, Tool: Pickaxe},
+	"minecraft:infested_cobblestone":               {Value: 1, Tool: Pickaxe},
+	"minecraft:infested_stone_bricks":              {Value: 0.75, Tool: Pickaxe},
+	"minecraft:infested_mossy_stone_bricks":        {Value: 0.75, Tool: Pickaxe},
+	"minecraft:infested_cracked_stone_bricks":      {Value: 0.75, Tool: Pickaxe},
+	"minecraft:infested_chiseled_stone_bricks":     {Value: 0.75, Tool: Pickaxe},
+	"minecraft:brown_mushroom_block":               {Value: 0.2, Tool: Axe},
+	"minecraft:red_mushroom_block":                 {Value: 0.2, Tool: Axe},
+	"minecraft:mushroom_stem":                      {Value: 0.2, Tool: Axe},
+	"minecraft:iron_bars":                          {Value: 5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:chain":                              {Value: 5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:glass_pane":                         {Value: 0.3},
+	"minecraft:pumpkin":                            {Value: 1, Tool: Axe},
+	"minecraft:melon":                              {Value: 1, Tool: Axe},
+	"minecraft:attached_pumpkin_stem":              {Value: 0},
+	"minecraft:attached_melon_stem":                {Value: 0},
+	"minecraft:pumpkin_stem":                       {Value: 0},
+	"minecraft:melon_stem":                         {Value: 0},
+	"minecraft:vine":                               {Value: 0.2, Tool: Axe},
+	"minecraft:glow_lichen":                        {Value: 0.2, Tool: Axe},
+	"minecraft:resin_clump":                        {Value: 0},
+	"minecraft:oak_fence_gate":                     {Value: 2, Tool: Axe},
+	"minecraft:brick_stairs":                       {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:stone_brick_stairs":                 {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:mud_brick_stairs":                   {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:mycelium":                           {Value: 0.6, Tool: Shovel},
+	"minecraft:lily_pad":                           {Value: 0},
+	"minecraft:resin_block":                        {Value: 0},
+	"minecraft:resin_bricks":                       {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:resin_brick_stairs":                 {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:resin_brick_slab":                   {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:resin_brick_wall":                   {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:chiseled_resin_bricks":              {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:nether_bricks":                      {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:nether_brick_fence":                 {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:nether_brick_stairs":                {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:nether_wart":                        {Value: 0},
+	"minecraft:enchanting_table":                   {Value: 5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:brewing_stand":                      {Value: 0.5, Tool: Pickaxe},
+	"minecraft:cauldron":                           {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:water_cauldron":                     {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:lava_cauldron":                      {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:powder_snow_cauldron":               {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:end_portal":                         {Value: -1},
+	"minecraft:end_portal_frame":                   {Value: -1},
+	"minecraft:end_stone":                          {Value: 3, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:dragon_egg":                         {Value: 3},
+	"minecraft:redstone_lamp":                      {Value: 0.3},
+	"minecraft:cocoa":                              {Value: 0.2, Tool: Axe},
+	"minecraft:sandstone_stairs":                   {Value: 0.8, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:emerald_ore":                        {Value: 3, Tool: Pickaxe, Tier: IronTier, RequiresTool: true},
+	"minecraft:deepslate_emerald_ore":              {Value: 4.5, Tool: Pickaxe, Tier: IronTier, RequiresTool: true},
+	"minecraft:ender_chest":                        {Value: 22.5, Tool: Pickaxe},
+	"minecraft:tripwire_hook":                      {Value: 0},
+	"minecraft:tripwire":                           {Value: 0},
+	"minecraft:emerald_block":                      {Value: 5, Tool: Pickaxe, Tier: IronTier, RequiresTool: true},
+	"minecraft:spruce_stairs":                      {Value: 2, Tool: Axe},
+	"minecraft:birch_stairs":                       {Value: 2, Tool: Axe},
+	"minecraft:jungle_stairs":                      {Value: 2, Tool: Axe},
+	"minecraft:command_block":                      {Value: -1},
+	"minecraft:beacon":                             {Value: 3},
+	"minecraft:cobblestone_wall":                   {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:mossy_cobblestone_wall":             {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:flower_pot":                         {Value: 0},
+	"minecraft:potted_torchflower":                 {Value: 0},
+	"minecraft:potted_oak_sapling":                 {Value: 0},
+	"minecraft:potted_spruce_sapling":              {Value: 0},
+	"minecraft:potted_birch_sapling":               {Value: 0},
+	"minecraft:potted_jungle_sapling":              {Value: 0},
+	"minecraft:potted_acacia_sapling":              {Value: 0},
+	"minecraft:potted_cherry_sapling":              {Value: 0},
+	"minecraft:potted_dark_oak_sapling":            {Value: 0},
+	"minecraft:potted_pale_oak_sapling":            {Value: 0},
+	"minecraft:potted_mangrove_propagule":          {Value: 0},
+	"minecraft:potted_fern":                        {Value: 0},
+	"minecraft:potted_dandelion":                   {Value: 0},
+	"minecraft:potted_poppy":                       {Value: 0},
+	"minecraft:potted_blue_orchid":                 {Value: 0},
+	"minecraft:potted_allium":                      {Value: 0},
+	"minecraft:potted_azure_bluet":                 {Value: 0},
+	"minecraft:potted_red_tulip":                   {Value: 0},
+	"minecraft:potted_orange_tulip":                {Value: 0},
+	"minecraft:potted_white_tulip":                 {Value: 0},
+	"minecraft:potted_pink_tulip":                  {Value: 0},
+	"minecraft:potted_oxeye_daisy":                 {Value: 0},
+	"minecraft:potted_cornflower":                  {Value: 0},
+	"minecraft:potted_lily_of_the_valley":          {Value: 0},
+	"minecraft:potted_wither_rose":                 {Value: 0},
+	"minecraft:potted_red_mushroom":                {Value: 0},
+	"minecraft:potted_brown_mushroom":              {Value: 0},
+	"minecraft:potted_dead_bush":                   {Value: 0},
+	"minecraft:potted_cactus":                      {Value: 0},
+	"minecraft:carrots":                            {Value: 0},
+	"minecraft:potatoes":                           {Value: 0},
+	"minecraft:oak_button":                         {Value: 0.5, Tool: Axe},
+	"minecraft:spruce_button":                      {Value: 0.5, Tool: Axe},
+	"minecraft:birch_button":                       {Value: 0.5, Tool: Axe},
+	"minecraft:jungle_button":                      {Value: 0.5, Tool: Axe},
+	"minecraft:acacia_button":                      {Value: 0.5, Tool: Axe},
+	"minecraft:cherry_button":                      {Value: 0.5, Tool: Axe},
+	"minecraft:dark_oak_button":                    {Value: 0.5, Tool: Axe},
+	"minecraft:pale_oak_button":                    {Value: 0.5, Tool: Axe},
+	"minecraft:mangrove_button":                    {Value: 0.5, Tool: Axe},
+	"minecraft:bamboo_button":                      {Value: 0.5, Tool: Axe},
+	"minecraft:skeleton_skull":                     {Value: 1},
+	"minecraft:skeleton_wall_skull":                {Value: 1},
+	"minecraft:wither_skeleton_skull":              {Value: 1},
+	"minecraft:wither_skeleton_wall_skull":         {Value: 1},
+	"minecraft:zombie_head":                        {Value: 1},
+	"minecraft:zombie_wall_head":                   {Value: 1},
+	"minecraft:player_head":                        {Value: 1},
+	"minecraft:player_wall_head":                   {Value: 1},
+	"minecraft:creeper_head":                       {Value: 1},
+	"minecraft:creeper_wall_head":                  {Value: 1},
+	"minecraft:dragon_head":                        {Value: 1},
+	"minecraft:dragon_wall_head":                   {Value: 1},
+	"minecraft:piglin_head":                        {Value: 1},
+	"minecraft:piglin_wall_head":                   {Value: 1},
+	"minecraft:anvil":                              {Value: 5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:chipped_anvil":                      {Value: 5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:damaged_anvil":                      {Value: 5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:trapped_chest":                      {Value: 2.5, Tool: Axe},
+	"minecraft:light_weighted_pressure_plate":      {Value: 0.5, Tool: Pickaxe},
+	"minecraft:heavy_weighted_pressure_plate":      {Value: 0.5, Tool: Pickaxe},
+	"minecraft:comparator":                         {Value: 0},
+	"minecraft:daylight_detector":                  {Value: 0.2, Tool: Axe},
+	"minecraft:redstone_block":                     {Value: 5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:nether_quartz_ore":                  {Value: 3, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:hopper":                             {Value: 3, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:quartz_block":                       {Value: 0.8, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:chiseled_quartz_block":              {Value: 0.8, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:quartz_pillar":                      {Value: 0.8, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:quartz_stairs":                      {Value: 0.8, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:activator_rail":                     {Value: 0.7, Tool: Pickaxe},
+	"minecraft:dropper":                            {Value: 3.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:white_terracotta":                   {Value: 1.25, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:orange_terracotta":                  {Value: 1.25, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:magenta_terracotta":                 {Value: 1.25, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:light_blue_terracotta":              {Value: 1.25, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:yellow_terracotta":                  {Value: 1.25, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:lime_terracotta":                    {Value: 1.25, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:pink_terracotta":                    {Value: 1.25, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:gray_terracotta":                    {Value: 1.25, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:light_gray_terracotta":              {Value: 1.25, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:cyan_terracotta":                    {Value: 1.25, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:purple_terracotta":                  {Value: 1.25, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:blue_terracotta":                    {Value: 1.25, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:brown_terracotta":                   {Value: 1.25, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:green_terracotta":                   {Value: 1.25, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:red_terracotta":                     {Value: 1.25, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:black_terracotta":                   {Value: 1.25, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:white_stained_glass_pane":           {Value: 0.3},
+	"minecraft:orange_stained_glass_pane":          {Value: 0.3},
+	"minecraft:magenta_stained_glass_pane":         {Value: 0.3},
+	"minecraft:light_blue_stained_glass_pane":      {Value: 0.3},
+	"minecraft:yellow_stained_glass_pane":          {Value: 0.3},
+	"minecraft:lime_stained_glass_pane":            {Value: 0.3},
+	"minecraft:pink_stained_glass_pane":            {Value: 0.3},
+	"minecraft:gray_stained_glass_pane":            {Value: 0.3},
+	"minecraft:light_gray_stained_glass_pane":      {Value: 0.3},
+	"minecraft:cyan_stained_glass_pane":            {Value: 0.3},
+	"minecraft:purple_stained_glass_pane":          {Value: 0.3},
+	"minecraft:blue_stained_glass_pane":            {Value: 0.3},
+	"minecraft:brown_stained_glass_pane":           {Value: 0.3},
+	"minecraft:green_stained_glass_pane":           {Value: 0.3},
+	"minecraft:red_stained_glass_pane":             {Value: 0.3},
+	"minecraft:black_stained_glass_pane":           {Value: 0.3},
+	"minecraft:acacia_stairs":                      {Value: 2, Tool: Axe},
+	"minecraft:cherry_stairs":                      {Value: 2, Tool: Axe},
+	"minecraft:dark_oak_stairs":                    {Value: 2, Tool: Axe},
+	"minecraft:pale_oak_stairs":                    {Value: 2, Tool: Axe},
+	"minecraft:mangrove_stairs":                    {Value: 2, Tool: Axe},
+	"minecraft:bamboo_stairs":                      {Value: 2, Tool: Axe},
+	"minecraft:bamboo_mosaic_stairs":               {Value: 2, Tool: Axe},
+	"minecraft:slime_block":                        {Value: 0},
+	"minecraft:barrier":                            {Value: -1},
+	"minecraft:light":                              {Value: -1},
+	"minecraft:iron_trapdoor":                      {Value: 5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:prismarine":                         {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:prismarine_bricks":                  {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:dark_prismarine":                    {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:prismarine_stairs":                  {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:prismarine_brick_stairs":            {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:dark_prismarine_stairs":             {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:prismarine_slab":                    {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:prismarine_brick_slab":              {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:dark_prismarine_slab":               {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:sea_lantern":                        {Value: 0.3},
+	"minecraft:hay_block":                          {Value: 0.5, Tool: Hoe},
+	"minecraft:white_carpet":                       {Value: 0.1},
+	"minecraft:orange_carpet":                      {Value: 0.1},
+	"minecraft:magenta_carpet":                     {Value: 0.1},
+	"minecraft:light_blue_carpet":                  {Value: 0.1},
+	"minecraft:yellow_carpet":                      {Value: 0.1},
+	"minecraft:lime_carpet":                        {Value: 0.1},
+	"minecraft:pink_carpet":                        {Value: 0.1},
+	"minecraft:gray_carpet":                        {Value: 0.1},
+	"minecraft:light_gray_carpet":                  {Value: 0.1},
+	"minecraft:cyan_carpet":                        {Value: 0.1},
+	"minecraft:purple_carpet":                      {Value: 0.1},
+	"minecraft:blue_carpet":                        {Value: 0.1},
+	"minecraft:brown_carpet":                       {Value: 0.1},
+	"minecraft:green_carpet":                       {Value: 0.1},
+	"minecraft:red_carpet":                         {Value: 0.1},
+	"minecraft:black_carpet":                       {Value: 0.1},
+	"minecraft:terracotta":                         {Value: 1.25, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:coal_block":                         {Value: 5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:packed_ice":                         {Value: 0.5, Tool: Pickaxe},
+	"minecraft:sunflower":                          {Value: 0},
+	"minecraft:lilac":                              {Value: 0},
+	"minecraft:rose_bush":                          {Value: 0},
+	"minecraft:peony":                              {Value: 0},
+	"minecraft:tall_grass":                         {Value: 0},
+	"minecraft:large_fern":                         {Value: 0},
+	"minecraft:white_banner":                       {Value: 1, Tool: Axe},
+	"minecraft:orange_banner":                      {Value: 1, Tool: Axe},
+	"minecraft:magenta_banner":                     {Value: 1, Tool: Axe},
+	"minecraft:light_blue_banner":                  {Value: 1, Tool: Axe},
+	"minecraft:yellow_banner":                      {Value: 1, Tool: Axe},
+	"minecraft:lime_banner":                        {Value: 1, Tool: Axe},
+	"minecraft:pink_banner":                        {Value: 1, Tool: Axe},
+	"minecraft:gray_banner":                        {Value: 1, Tool: Axe},
+	"minecraft:light_gray_banner":                  {Value: 1, Tool: Axe},
+	"minecraft:cyan_banner":                        {Value: 1, Tool: Axe},
+	"minecraft:purple_banner":                      {Value: 1, Tool: Axe},
+	"minecraft:blue_banner":                        {Value: 1, Tool: Axe},
+	"minecraft:brown_banner":                       {Value: 1, Tool: Axe},
+	"minecraft:green_banner":                       {Value: 1, Tool: Axe},
+	"minecraft:red_banner":                         {Value: 1, Tool: Axe},
+	"minecraft:black_banner":                       {Value: 1, Tool: Axe},
+	"minecraft:white_wall_banner":                  {Value: 1, Tool: Axe},
+	"minecraft:orange_wall_banner":                 {Value: 1, Tool: Axe},
+	"minecraft:magenta_wall_banner":                {Value: 1, Tool: Axe},
+	"minecraft:light_blue_wall_banner":             {Value: 1, Tool: Axe},
+	"minecraft:yellow_wall_banner":                 {Value: 1, Tool: Axe},
+	"minecraft:lime_wall_banner":                   {Value: 1, Tool: Axe},
+	"minecraft:pink_wall_banner":                   {Value: 1, Tool: Axe},
+	"minecraft:gray_wall_banner":                   {Value: 1, Tool: Axe},
+	"minecraft:light_gray_wall_banner":             {Value: 1, Tool: Axe},
+	"minecraft:cyan_wall_banner":                   {Value: 1, Tool: Axe},
+	"minecraft:purple_wall_banner":                 {Value: 1, Tool: Axe},
+	"minecraft:blue_wall_banner":                   {Value: 1, Tool: Axe},
+	"minecraft:brown_wall_banner":                  {Value: 1, Tool: Axe},
+	"minecraft:green_wall_banner":                  {Value: 1, Tool: Axe},
+	"minecraft:red_wall_banner":                    {Value: 1, Tool: Axe},
+	"minecraft:black_wall_banner":                  {Value: 1, Tool: Axe},
+	"minecraft:red_sandstone":                      {Value: 0.8, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:chiseled_red_sandstone":             {Value: 0.8, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:cut_red_sandstone":                  {Value: 0.8, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:red_sandstone_stairs":               {Value: 0.8, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:oak_slab":                           {Value: 2, Tool: Axe},
+	"minecraft:spruce_slab":                        {Value: 2, Tool: Axe},
+	"minecraft:birch_slab":                         {Value: 2, Tool: Axe},
+	"minecraft:jungle_slab":                        {Value: 2, Tool: Axe},
+	"minecraft:acacia_slab":                        {Value: 2, Tool: Axe},
+	"minecraft:cherry_slab":                        {Value: 2, Tool: Axe},
+	"minecraft:dark_oak_slab":                      {Value: 2, Tool: Axe},
+	"minecraft:pale_oak_slab":                      {Value: 2, Tool: Axe},
+	"minecraft:mangrove_slab":                      {Value: 2, Tool: Axe},
+	"minecraft:bamboo_slab":                        {Value: 2, Tool: Axe},
+	"minecraft:bamboo_mosaic_slab":                 {Value: 2, Tool: Axe},
+	"minecraft:stone_slab":                         {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:smooth_stone_slab":                  {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:sandstone_slab":                     {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:cut_sandstone_slab":                 {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:petrified_oak_slab":                 {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:cobblestone_slab":                   {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:brick_slab":                         {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:stone_brick_slab":                   {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:mud_brick_slab":                     {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:nether_brick_slab":                  {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:quartz_slab":                        {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:red_sandstone_slab":                 {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:cut_red_sandstone_slab":             {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:purpur_slab":                        {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:smooth_stone":                       {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:smooth_sandstone":                   {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:smooth_quartz":                      {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:smooth_red_sandstone":               {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:spruce_fence_gate":                  {Value: 2, Tool: Axe},
+	"minecraft:birch_fence_gate":                   {Value: 2, Tool: Axe},
+	"minecraft:jungle_fence_gate":                  {Value: 2, Tool: Axe},
+	"minecraft:acacia_fence_gate":                  {Value: 2, Tool: Axe},
+	"minecraft:cherry_fence_gate":                  {Value: 2, Tool: Axe},
+	"minecraft:dark_oak_fence_gate":                {Value: 2, Tool: Axe},
+	"minecraft:pale_oak_fence_gate":                {Value: 2, Tool: Axe},
+	"minecraft:mangrove_fence_gate":                {Value: 2, Tool: Axe},
+	"minecraft:bamboo_fence_gate":                  {Value: 2, Tool: Axe},
+	"minecraft:spruce_fence":                       {Value: 2, Tool: Axe},
+	"minecraft:birch_fence":                        {Value: 2, Tool: Axe},
+	"minecraft:jungle_fence":                       {Value: 2, Tool: Axe},
+	"minecraft:acacia_fence":                       {Value: 2, Tool: Axe},
+	"minecraft:cherry_fence":                       {Value: 2, Tool: Axe},
+	"minecraft:dark_oak_fence":                     {Value: 2, Tool: Axe},
+	"minecraft:pale_oak_fence":                     {Value: 2, Tool: Axe},
+	"minecraft:mangrove_fence":                     {Value: 2, Tool: Axe},
+	"minecraft:bamboo_fence":                       {Value: 2, Tool: Axe},
+	"minecraft:spruce_door":                        {Value: 3, Tool: Axe},
+	"minecraft:birch_door":                         {Value: 3, Tool: Axe},
+	"minecraft:jungle_door":                        {Value: 3, Tool: Axe},
+	"minecraft:acacia_door":                        {Value: 3, Tool: Axe},
+	"minecraft:cherry_door":                        {Value: 3, Tool: Axe},
+	"minecraft:dark_oak_door":                      {Value: 3, Tool: Axe},
+	"minecraft:pale_oak_door":                      {Value: 3, Tool: Axe},
+	"minecraft:mangrove_door":                      {Value: 3, Tool: Axe},
+	"minecraft:bamboo_door":                        {Value: 3, Tool: Axe},
+	"minecraft:end_rod":                            {Value: 0},
+	"minecraft:chorus_plant":                       {Value: 0.4, Tool: Axe},
+	"minecraft:chorus_flower":                      {Value: 0.4, Tool: Axe},
+	"minecraft:purpur_block":                       {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:purpur_pillar":                      {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:purpur_stairs":                      {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:end_stone_bricks":                   {Value: 3, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:torchflower_crop":                   {Value: 0},
+	"minecraft:pitcher_crop":                       {Value: 0},
+	"minecraft:pitcher_plant":                      {Value: 0},
+	"minecraft:beetroots":                          {Value: 0},
+	"minecraft:dirt_path":                          {Value: 0.65, Tool: Shovel},
+	"minecraft:end_gateway":                        {Value: -1},
+	"minecraft:repeating_command_block":            {Value: -1},
+	"minecraft:chain_command_block":                {Value: -1},
+	"minecraft:frosted_ice":                        {Value: 0.5},
+	"minecraft:magma_block":                        {Value: 0.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:nether_wart_block":                  {Value: 1, Tool: Hoe},
+	"minecraft:red_nether_bricks":                  {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:bone_block":                         {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:structure_void":                     {Value: 0},
+	"minecraft:observer":                           {Value: 3, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:shulker_box":                        {Value: 2, Tool: Pickaxe},
+	"minecraft:white_shulker_box":                  {Value: 2, Tool: Pickaxe},
+	"minecraft:orange_shulker_box":                 {Value: 2, Tool: Pickaxe},
+	"minecraft:magenta_shulker_box":                {Value: 2, Tool: Pickaxe},
+	"minecraft:light_blue_shulker_box":             {Value: 2, Tool: Pickaxe},
+	"minecraft:yellow_shulker_box":                 {Value: 2, Tool: Pickaxe},
+	"minecraft:lime_shulker_box":                   {Value: 2, Tool: Pickaxe},
+	"minecraft:pink_shulker_box":                   {Value: 2, Tool: Pickaxe},
+	"minecraft:gray_shulker_box":                   {Value: 2, Tool: Pickaxe},
+	"minecraft:light_gray_shulker_box":             {Value: 2, Tool: Pickaxe},
+	"minecraft:cyan_shulker_box":                   {Value: 2, Tool: Pickaxe},
+	"minecraft:purple_shulker_box":                 {Value: 2, Tool: Pickaxe},
+	"minecraft:blue_shulker_box":                   {Value: 2, Tool: Pickaxe},
+	"minecraft:brown_shulker_box":                  {Value: 2, Tool: Pickaxe},
+	"minecraft:green_shulker_box":                  {Value: 2, Tool: Pickaxe},
+	"minecraft:red_shulker_box":                    {Value: 2, Tool: Pickaxe},
+	"minecraft:black_shulker_box":                  {Value: 2, Tool: Pickaxe},
+	"minecraft:white_glazed_terracotta":            {Value: 1.4, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:orange_glazed_terracotta":           {Value: 1.4, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:magenta_glazed_terracotta":          {Value: 1.4, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:light_blue_glazed_terracotta":       {Value: 1.4, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:yellow_glazed_terracotta":           {Value: 1.4, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:lime_glazed_terracotta":             {Value: 1.4, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:pink_glazed_terracotta":             {Value: 1.4, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:gray_glazed_terracotta":             {Value: 1.4, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:light_gray_glazed_terracotta":       {Value: 1.4, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:cyan_glazed_terracotta":             {Value: 1.4, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:purple_glazed_terracotta":           {Value: 1.4, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:blue_glazed_terracotta":             {Value: 1.4, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:brown_glazed_terracotta":            {Value: 1.4, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:green_glazed_terracotta":            {Value: 1.4, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:red_glazed_terracotta":              {Value: 1.4, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:black_glazed_terracotta":            {Value: 1.4, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:white_concrete":                     {Value: 1.8, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:orange_concrete":                    {Value: 1.8, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:magenta_concrete":                   {Value: 1.8, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:light_blue_concrete":                {Value: 1.8, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:yellow_concrete":                    {Value: 1.8, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:lime_concrete":                      {Value: 1.8, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:pink_concrete":                      {Value: 1.8, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:gray_concrete":                      {Value: 1.8, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:light_gray_concrete":                {Value: 1.8, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:cyan_concrete":                      {Value: 1.8, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:purple_concrete":                    {Value: 1.8, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:blue_concrete":                      {Value: 1.8, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:brown_concrete":                     {Value: 1.8, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:green_concrete":                     {Value: 1.8, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:red_concrete":                       {Value: 1.8, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:black_concrete":                     {Value: 1.8, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:white_concrete_powder":              {Value: 0.5, Tool: Shovel},
+	"minecraft:orange_concrete_powder":             {Value: 0.5, Tool: Shovel},
+	"minecraft:magenta_concrete_powder":            {Value: 0.5, Tool: Shovel},
+	"minecraft:light_blue_concrete_powder":         {Value: 0.5, Tool: Shovel},
+	"minecraft:yellow_concrete_powder":             {Value: 0.5, Tool: Shovel},
+	"minecraft:lime_concrete_powder":               {Value: 0.5, Tool: Shovel},
+	"minecraft:pink_concrete_powder":               {Value: 0.5, Tool: Shovel},
+	"minecraft:gray_concrete_powder":               {Value: 0.5, Tool: Shovel},
+	"minecraft:light_gray_concrete_powder":         {Value: 0.5, Tool: Shovel},
+	"minecraft:cyan_concrete_powder":               {Value: 0.5, Tool: Shovel},
+	"minecraft:purple_concrete_powder":             {Value: 0.5, Tool: Shovel},
+	"minecraft:blue_concrete_powder":               {Value: 0.5, Tool: Shovel},
+	"minecraft:brown_concrete_powder":              {Value: 0.5, Tool: Shovel},
+	"minecraft:green_concrete_powder":              {Value: 0.5, Tool: Shovel},
+	"minecraft:red_concrete_powder":                {Value: 0.5, Tool: Shovel},
+	"minecraft:black_concrete_powder":              {Value: 0.5, Tool: Shovel},
+	"minecraft:kelp":                               {Value: 0},
+	"minecraft:kelp_plant":                         {Value: 0},
+	"minecraft:dried_kelp_block":                   {Value: 0.5, Tool: Hoe},
+	"minecraft:turtle_egg":                         {Value: 0.5},
+	"minecraft:sniffer_egg":                        {Value: 0.5},
+	"minecraft:dried_ghast":                        {Value: 0},
+	"minecraft:dead_tube_coral_block":              {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:dead_brain_coral_block":             {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:dead_bubble_coral_block":            {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:dead_fire_coral_block":              {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:dead_horn_coral_block":              {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:tube_coral_block":                   {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:brain_coral_block":                  {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:bubble_coral_block":                 {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:fire_coral_block":                   {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:horn_coral_block":                   {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:dead_tube_coral":                    {Value: 0, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:dead_brain_coral":                   {Value: 0, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:dead_bubble_coral":                  {Value: 0, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:dead_fire_coral":                    {Value: 0, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:dead_horn_coral":                    {Value: 0, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:tube_coral":                         {Value: 0},
+	"minecraft:brain_coral":                        {Value: 0},
+	"minecraft:bubble_coral":                       {Value: 0},
+	"minecraft:fire_coral":                         {Value: 0},
+	"minecraft:horn_coral":                         {Value: 0},
+	"minecraft:dead_tube_coral_fan":                {Value: 0, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:dead_brain_coral_fan":               {Value: 0, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:dead_bubble_coral_fan":              {Value: 0, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:dead_fire_coral_fan":                {Value: 0, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:dead_horn_coral_fan":                {Value: 0, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:tube_coral_fan":                     {Value: 0},
+	"minecraft:brain_coral_fan":                    {Value: 0},
+	"minecraft:bubble_coral_fan":                   {Value: 0},
+	"minecraft:fire_coral_fan":                     {Value: 0},
+	"minecraft:horn_coral_fan":                     {Value: 0},
+	"minecraft:dead_tube_coral_wall_fan":           {Value: 0, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:dead_brain_coral_wall_fan":          {Value: 0, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:dead_bubble_coral_wall_fan":         {Value: 0, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:dead_fire_coral_wall_fan":           {Value: 0, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:dead_horn_coral_wall_fan":           {Value: 0, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:tube_coral_wall_fan":                {Value: 0},
+	"minecraft:brain_coral_wall_fan":               {Value: 0},
+	"minecraft:bubble_coral_wall_fan":              {Value: 0},
+	"minecraft:fire_coral_wall_fan":                {Value: 0},
+	"minecraft:horn_coral_wall_fan":                {Value: 0},
+	"minecraft:sea_pickle":                         {Value: 0},
+	"minecraft:blue_ice":                           {Value: 2.8, Tool: Pickaxe},
+	"minecraft:conduit":                            {Value: 3, Tool: Pickaxe},
+	"minecraft:bamboo_sapling":                     {Value: 1},
+	"minecraft:bamboo":                             {Value: 1},
+	"minecraft:potted_bamboo":                      {Value: 0},
+	"minecraft:void_air":                           {Value: 0},
+	"minecraft:cave_air":                           {Value: 0},
+	"minecraft:bubble_column":                      {Value: 0},
+	"minecraft:polished_granite_stairs":            {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:smooth_red_sandstone_stairs":        {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:mossy_stone_brick_stairs":           {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:polished_diorite_stairs":            {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:mossy_cobblestone_stairs":           {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:end_stone_brick_stairs":             {Value: 3, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:stone_stairs":                       {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:smooth_sandstone_stairs":            {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:smooth_quartz_stairs":               {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:granite_stairs":                     {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:andesite_stairs":                    {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:red_nether_brick_stairs":            {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:polished_andesite_stairs":           {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:diorite_stairs":                     {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:polished_granite_slab":              {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:smooth_red_sandstone_slab":          {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:mossy_stone_brick_slab":             {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:polished_diorite_slab":              {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:mossy_cobblestone_slab":             {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:end_stone_brick_slab":               {Value: 3, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:smooth_sandstone_slab":              {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:smooth_quartz_slab":                 {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:granite_slab":                       {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:andesite_slab":                      {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:red_nether_brick_slab":              {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:polished_andesite_slab":             {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:diorite_slab":                       {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:brick_wall":                         {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:prismarine_wall":                    {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:red_sandstone_wall":                 {Value: 0.8, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:mossy_stone_brick_wall":             {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:granite_wall":                       {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:stone_brick_wall":                   {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:mud_brick_wall":                     {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:nether_brick_wall":                  {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:andesite_wall":                      {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:red_nether_brick_wall":              {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:sandstone_wall":                     {Value: 0.8, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:end_stone_brick_wall":               {Value: 3, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:diorite_wall":                       {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:scaffolding":                        {Value: 0},
+	"minecraft:loom":                               {Value: 2.5, Tool: Axe},
+	"minecraft:barrel":                             {Value: 2.5, Tool: Axe},
+	"minecraft:smoker":                             {Value: 3.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:blast_furnace":                      {Value: 3.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:cartography_table":                  {Value: 2.5, Tool: Axe},
+	"minecraft:fletching_table":                    {Value: 2.5, Tool: Axe},
+	"minecraft:grindstone":                         {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:lectern":                            {Value: 2.5, Tool: Axe},
+	"minecraft:smithing_table":                     {Value: 2.5, Tool: Axe},
+	"minecraft:stonecutter":                        {Value: 3.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:bell":                               {Value: 5, Tool: Pickaxe},
+	"minecraft:lantern":                            {Value: 3.5, Tool: Pickaxe},
+	"minecraft:soul_lantern":                       {Value: 3.5, Tool: Pickaxe},
+	"minecraft:campfire":                           {Value: 2, Tool: Axe},
+	"minecraft:soul_campfire":                      {Value: 2, Tool: Axe},
+	"minecraft:sweet_berry_bush":                   {Value: 0},
+	"minecraft:warped_stem":                        {Value: 2, Tool: Axe},
+	"minecraft:stripped_warped_stem":               {Value: 2, Tool: Axe},
+	"minecraft:warped_hyphae":                      {Value: 2, Tool: Axe},
+	"minecraft:stripped_warped_hyphae":             {Value: 2, Tool: Axe},
+	"minecraft:warped_nylium":                      {Value: 0.4, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:warped_fungus":                      {Value: 0},
+	"minecraft:warped_wart_block":                  {Value: 1, Tool: Hoe},
+	"minecraft:warped_roots":                       {Value: 0},
+	"minecraft:nether_sprouts":                     {Value: 0},
+	"minecraft:crimson_stem":                       {Value: 2, Tool: Axe},
+	"minecraft:stripped_crimson_stem":              {Value: 2, Tool: Axe},
+	"minecraft:crimson_hyphae":                     {Value: 2, Tool: Axe},
+	"minecraft:stripped_crimson_hyphae":            {Value: 2, Tool: Axe},
+	"minecraft:crimson_nylium":                     {Value: 0.4, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:crimson_fungus":                     {Value: 0},
+	"minecraft:shroomlight":                        {Value: 1, Tool: Hoe},
+	"minecraft:weeping_vines":                      {Value: 0},
+	"minecraft:weeping_vines_plant":                {Value: 0},
+	"minecraft:twisting_vines":                     {Value: 0},
+	"minecraft:twisting_vines_plant":               {Value: 0},
+	"minecraft:crimson_roots":                      {Value: 0},
+	"minecraft:crimson_planks":                     {Value: 2, Tool: Axe},
+	"minecraft:warped_planks":                      {Value: 2, Tool: Axe},
+	"minecraft:crimson_slab":                       {Value: 2, Tool: Axe},
+	"minecraft:warped_slab":                        {Value: 2, Tool: Axe},
+	"minecraft:crimson_pressure_plate":             {Value: 0.5, Tool: Axe},
+	"minecraft:warped_pressure_plate":              {Value: 0.5, Tool: Axe},
+	"minecraft:crimson_fence":                      {Value: 2, Tool: Axe},
+	"minecraft:warped_fence":                       {Value: 2, Tool: Axe},
+	"minecraft:crimson_trapdoor":                   {Value: 3, Tool: Axe},
+	"minecraft:warped_trapdoor":                    {Value: 3, Tool: Axe},
+	"minecraft:crimson_fence_gate":                 {Value: 2, Tool: Axe},
+	"minecraft:warped_fence_gate":                  {Value: 2, Tool: Axe},
+	"minecraft:crimson_stairs":                     {Value: 2, Tool: Axe},
+	"minecraft:warped_stairs":                      {Value: 2, Tool: Axe},
+	"minecraft:crimson_button":                     {Value: 0.5, Tool: Axe},
+	"minecraft:warped_button":                      {Value: 0.5, Tool: Axe},
+	"minecraft:crimson_door":                       {Value: 3, Tool: Axe},
+	"minecraft:warped_door":                        {Value: 3, Tool: Axe},
+	"minecraft:crimson_sign":                       {Value: 1, Tool: Axe},
+	"minecraft:warped_sign":                        {Value: 1, Tool: Axe},
+	"minecraft:crimson_wall_sign":                  {Value: 1, Tool: Axe},
+	"minecraft:warped_wall_sign":                   {Value: 1, Tool: Axe},
+	"minecraft:structure_block":                    {Value: -1},
+	"minecraft:jigsaw":                             {Value: -1},
+	"minecraft:test_block":                         {Value: -1},
+	"minecraft:test_instance_block":                {Value: -1},
+	"minecraft:composter":                          {Value: 0.6, Tool: Axe},
+	"minecraft:target":                             {Value: 0.5, Tool: Hoe},
+	"minecraft:bee_nest":                           {Value: 0.3, Tool: Axe},
+	"minecraft:beehive":                            {Value: 0.6, Tool: Axe},
+	"minecraft:honey_block":                        {Value: 0},
+	"minecraft:honeycomb_block":                    {Value: 0.6},
+	"minecraft:netherite_block":                    {Value: 50, Tool: Pickaxe, Tier: DiamondTier, RequiresTool: true},
+	"minecraft:ancient_debris":                     {Value: 30, Tool: Pickaxe, Tier: DiamondTier, RequiresTool: true},
+	"minecraft:crying_obsidian":                    {Value: 50, Tool: Pickaxe, Tier: DiamondTier, RequiresTool: true},
+	"minecraft:respawn_anchor":                     {Value: 50, Tool: Pickaxe, Tier: DiamondTier, RequiresTool: true},
+	"minecraft:potted_crimson_fungus":              {Value: 0},
+	"minecraft:potted_warped_fungus":               {Value: 0},
+	"minecraft:potted_crimson_roots":               {Value: 0},
+	"minecraft:potted_warped_roots":                {Value: 0},
+	"minecraft:lodestone":                          {Value: 3.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:blackstone":                         {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:blackstone_stairs":                  {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:blackstone_wall":                    {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:blackstone_slab":                    {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:polished_blackstone":                {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:polished_blackstone_bricks":         {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:cracked_polished_blackstone_bricks": {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:chiseled_polished_blackstone":       {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:polished_blackstone_brick_slab":     {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:polished_blackstone_brick_stairs":   {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:polished_blackstone_brick_wall":     {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:gilded_blackstone":                  {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:polished_blackstone_stairs":         {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:polished_blackstone_slab":           {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:polished_blackstone_pressure_plate": {Value: 0.5, Tool: Pickaxe},
+	"minecraft:polished_blackstone_button":         {Value: 0.5, Tool: Pickaxe},
+	"minecraft:polished_blackstone_wall":           {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:chiseled_nether_bricks":             {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:cracked_nether_bricks":              {Value: 2, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:quartz_bricks":                      {Value: 0.8, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:candle":                             {Value: 0.1},
+	"minecraft:white_candle":                       {Value: 0.1},
+	"minecraft:orange_candle":                      {Value: 0.1},
+	"minecraft:magenta_candle":                     {Value: 0.1},
+	"minecraft:light_blue_candle":                  {Value: 0.1},
+	"minecraft:yellow_candle":                      {Value: 0.1},
+	"minecraft:lime_candle":                        {Value: 0.1},
+	"minecraft:pink_candle":                        {Value: 0.1},
+	"minecraft:gray_candle":                        {Value: 0.1},
+	"minecraft:light_gray_candle":                  {Value: 0.1},
+	"minecraft:cyan_candle":                        {Value: 0.1},
+	"minecraft:purple_candle":                      {Value: 0.1},
+	"minecraft:blue_candle":                        {Value: 0.1},
+	"minecraft:brown_candle":                       {Value: 0.1},
+	"minecraft:green_candle":                       {Value: 0.1},
+	"minecraft:red_candle":                         {Value: 0.1},
+	"minecraft:black_candle":                       {Value: 0.1},
+	"minecraft:candle_cake":                        {Value: 0.5},
+	"minecraft:white_candle_cake":                  {Value: 0.5},
+	"minecraft:orange_candle_cake":                 {Value: 0.5},
+	"minecraft:magenta_candle_cake":                {Value: 0.5},
+	"minecraft:light_blue_candle_cake":             {Value: 0.5},
+	"minecraft:yellow_candle_cake":                 {Value: 0.5},
+	"minecraft:lime_candle_cake":                   {Value: 0.5},
+	"minecraft:pink_candle_cake":                   {Value: 0.5},
+	"minecraft:gray_candle_cake":                   {Value: 0.5},
+	"minecraft:light_gray_candle_cake":             {Value: 0.5},
+	"minecraft:cyan_candle_cake":                   {Value: 0.5},
+	"minecraft:purple_candle_cake":                 {Value: 0.5},
+	"minecraft:blue_candle_cake":                   {Value: 0.5},
+	"minecraft:brown_candle_cake":                  {Value: 0.5},
+	"minecraft:green_candle_cake":                  {Value: 0.5},
+	"minecraft:red_candle_cake":                    {Value: 0.5},
+	"minecraft:black_candle_cake":                  {Value: 0.5},
+	"minecraft:amethyst_block":                     {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:budding_amethyst":                   {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:amethyst_cluster":                   {Value: 1.5, Tool: Pickaxe},
+	"minecraft:large_amethyst_bud":                 {Value: 1.5, Tool: Pickaxe},
+	"minecraft:medium_amethyst_bud":                {Value: 1.5, Tool: Pickaxe},
+	"minecraft:small_amethyst_bud":                 {Value: 1.5, Tool: Pickaxe},
+	"minecraft:tuff":                               {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:tuff_slab":                          {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:tuff_stairs":                        {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:tuff_wall":                          {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:polished_tuff":                      {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:polished_tuff_slab":                 {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:polished_tuff_stairs":               {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:polished_tuff_wall":                 {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:chiseled_tuff":                      {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:tuff_bricks":                        {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:tuff_brick_slab":                    {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:tuff_brick_stairs":                  {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:tuff_brick_wall":                    {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:chiseled_tuff_bricks":               {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:calcite":                            {Value: 0.75, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:tinted_glass":                       {Value: 0.3},
+	"minecraft:powder_snow":                        {Value: 0.25},
+	"minecraft:sculk_sensor":                       {Value: 1.5, Tool: Hoe},
+	"minecraft:calibrated_sculk_sensor":            {Value: 1.5, Tool: Hoe},
+	"minecraft:sculk":                              {Value: 0.2, Tool: Hoe},
+	"minecraft:sculk_vein":                         {Value: 0.2, Tool: Hoe},
+	"minecraft:sculk_catalyst":                     {Value: 3, Tool: Hoe},
+	"minecraft:sculk_shrieker":                     {Value: 3, Tool: Hoe},
+	"minecraft:copper_block":                       {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:exposed_copper":                     {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:weathered_copper":                   {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:oxidized_copper":                    {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:copper_ore":                         {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:deepslate_copper_ore":               {Value: 4.5, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:oxidized_cut_copper":                {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:weathered_cut_copper":               {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:exposed_cut_copper":                 {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:cut_copper":                         {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:oxidized_chiseled_copper":           {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:weathered_chiseled_copper":          {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:exposed_chiseled_copper":            {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:chiseled_copper":                    {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:waxed_oxidized_chiseled_copper":     {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:waxed_weathered_chiseled_copper":    {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:waxed_exposed_chiseled_copper":      {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:waxed_chiseled_copper":              {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:oxidized_cut_copper_stairs":         {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:weathered_cut_copper_stairs":        {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:exposed_cut_copper_stairs":          {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:cut_copper_stairs":                  {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:oxidized_cut_copper_slab":           {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:weathered_cut_copper_slab":          {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:exposed_cut_copper_slab":            {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:cut_copper_slab":                    {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:waxed_copper_block":                 {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:waxed_weathered_copper":             {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:waxed_exposed_copper":               {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:waxed_oxidized_copper":              {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:waxed_oxidized_cut_copper":          {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:waxed_weathered_cut_copper":         {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:waxed_exposed_cut_copper":           {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:waxed_cut_copper":                   {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:waxed_oxidized_cut_copper_stairs":   {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:waxed_weathered_cut_copper_stairs":  {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:waxed_exposed_cut_copper_stairs":    {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:waxed_cut_copper_stairs":            {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:waxed_oxidized_cut_copper_slab":     {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:waxed_weathered_cut_copper_slab":    {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:waxed_exposed_cut_copper_slab":      {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:waxed_cut_copper_slab":              {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:copper_door":                        {Value: 3, Tool: Pickaxe},
+	"minecraft:exposed_copper_door":                {Value: 3, Tool: Pickaxe},
+	"minecraft:oxidized_copper_door":               {Value: 3, Tool: Pickaxe},
+	"minecraft:weathered_copper_door":              {Value: 3, Tool: Pickaxe},
+	"minecraft:waxed_copper_door":                  {Value: 3, Tool: Pickaxe},
+	"minecraft:waxed_exposed_copper_door":          {Value: 3, Tool: Pickaxe},
+	"minecraft:waxed_oxidized_copper_door":         {Value: 3, Tool: Pickaxe},
+	"minecraft:waxed_weathered_copper_door":        {Value: 3, Tool: Pickaxe},
+	"minecraft:copper_trapdoor":                    {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:exposed_copper_trapdoor":            {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:oxidized_copper_trapdoor":           {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:weathered_copper_trapdoor":          {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:waxed_copper_trapdoor":              {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:waxed_exposed_copper_trapdoor":      {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:waxed_oxidized_copper_trapdoor":     {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:waxed_weathered_copper_trapdoor":    {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:copper_grate":                       {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:exposed_copper_grate":               {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:weathered_copper_grate":             {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:oxidized_copper_grate":              {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:waxed_copper_grate":                 {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:waxed_exposed_copper_grate":         {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:waxed_weathered_copper_grate":       {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:waxed_oxidized_copper_grate":        {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:copper_bulb":                        {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:exposed_copper_bulb":                {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:weathered_copper_bulb":              {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:oxidized_copper_bulb":               {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:waxed_copper_bulb":                  {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:waxed_exposed_copper_bulb":          {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:waxed_weathered_copper_bulb":        {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:waxed_oxidized_copper_bulb":         {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:lightning_rod":                      {Value: 3, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:pointed_dripstone":                  {Value: 1.5, Tool: Pickaxe},
+	"minecraft:dripstone_block":                    {Value: 1.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:cave_vines":                         {Value: 0},
+	"minecraft:cave_vines_plant":                   {Value: 0},
+	"minecraft:spore_blossom":                      {Value: 0},
+	"minecraft:azalea":                             {Value: 0},
+	"minecraft:flowering_azalea":                   {Value: 0},
+	"minecraft:moss_carpet":                        {Value: 0.1, Tool: Hoe},
+	"minecraft:pink_petals":                        {Value: 0},
+	"minecraft:wildflowers":                        {Value: 0},
+	"minecraft:leaf_litter":                        {Value: 0},
+	"minecraft:moss_block":                         {Value: 0.1, Tool: Hoe},
+	"minecraft:big_dripleaf":                       {Value: 0.1, Tool: Axe},
+	"minecraft:big_dripleaf_stem":                  {Value: 0.1, Tool: Axe},
+	"minecraft:small_dripleaf":                     {Value: 0},
+	"minecraft:hanging_roots":                      {Value: 0},
+	"minecraft:rooted_dirt":                        {Value: 0.5, Tool: Shovel},
+	"minecraft:mud":                                {Value: 0.5, Tool: Shovel},
+	"minecraft:deepslate":                          {Value: 3, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:cobbled_deepslate":                  {Value: 3.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:cobbled_deepslate_stairs":           {Value: 3.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:cobbled_deepslate_slab":             {Value: 3.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:cobbled_deepslate_wall":             {Value: 3.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:polished_deepslate":                 {Value: 3.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:polished_deepslate_stairs":          {Value: 3.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:polished_deepslate_slab":            {Value: 3.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:polished_deepslate_wall":            {Value: 3.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:deepslate_tiles":                    {Value: 3.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:deepslate_tile_stairs":              {Value: 3.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:deepslate_tile_slab":                {Value: 3.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:deepslate_tile_wall":                {Value: 3.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:deepslate_bricks":                   {Value: 3.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:deepslate_brick_stairs":             {Value: 3.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:deepslate_brick_slab":               {Value: 3.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:deepslate_brick_wall":               {Value: 3.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:chiseled_deepslate":                 {Value: 3.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:cracked_deepslate_bricks":           {Value: 3.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:cracked_deepslate_tiles":            {Value: 3.5, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:infested_deepslate":                 {Value: 1.5, Tool: Pickaxe},
+	"minecraft:smooth_basalt":                      {Value: 1.25, Tool: Pickaxe, RequiresTool: true},
+	"minecraft:raw_iron_block":                     {Value: 5, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:raw_copper_block":                   {Value: 5, Tool: Pickaxe, Tier: StoneTier, RequiresTool: true},
+	"minecraft:raw_gold_block":                     {Value: 5, Tool: Pickaxe, Tier: IronTier, RequiresTool: true},
+	"minecraft:potted_azalea_bush":                 {Value: 0},
+	"minecraft:potted_flowering_azalea_bush":       {Value: 0},
+	"minecraft:ochre_froglight":                    {Value: 0.3},
+	"minecraft:verdant_froglight":                  {Value: 0.3},
+	"minecraft:pearlescent_froglight":              {Value: 0.3},
+	"minecraft:frogspawn":                          {Value: 0},
+	"minecraft:reinforced_deepslate":               {Value: 55},
+	"minecraft:decorated_pot":                      {Value: 0},
+	"minecraft:crafter":                            {Value: 1.5},
+	"minecraft:trial_spawner":                      {Value: 50},
+	"minecraft:vault":                              {Value: 50},
+	"minecraft:heavy_core":                         {Value: 10, Tool: Pickaxe},
+	"minecraft:pale_moss_block":                    {Value: 0.1, Tool: Hoe},
+	"minecraft:pale_moss_carpet":                   {Value: 0.1, Tool: Hoe},
+	"minecraft:pale_hanging_moss":                  {Value: 0},
+	"minecraft:open_eyeblossom":                    {Value: 0},
+	"minecraft:closed_eyeblossom":                  {Value: 0},
+	"minecraft:potted_open_eyeblossom":             {Value: 0},
+	"minecraft:potted_closed_eyeblossom":           {Value: 0},
+	"minecraft:firefly_bush":                       {Value: 0},
+}
+
+// passable blocks have no collision, players walking through them
+var passable = map[string]bool{
+	"minecraft:air":                                true,
+	"minecraft:oak_sapling":                        true,
+	"minecraft:spruce_sapling":                     true,
+	"minecraft:birch_sapling":                      true,
+	"minecraft:jungle_sapling":                     true,
+	"minecraft:acacia_sapling":                     true,
+	"minecraft:cherry_sapling":                     true,
+	"minecraft:dark_oak_sapling":                   true,
+	"minecraft:pale_oak_sapling":                   true,
+	"minecraft:mangrove_propagule":                 true,
+	"minecraft:water":                              true,
+	"minecraft:lava":                               true,
+	"minecraft:powered_rail":                       true,
+	"minecraft:detector_rail":                      true,
+	"minecraft:cobweb":                             true,
+	"minecraft:short_grass":                        true,
+	"minecraft:fern":                               true,
+	"minecraft:dead_bush":                          true,
+	"minecraft:bush":                               true,
+	"minecraft:short_dry_grass":                    true,
+	"minecraft:tall_dry_grass":                     true,
+	"minecraft:seagrass":                           true,
+	"minecraft:tall_seagrass":                      true,
+	"minecraft:moving_piston":                      true,
+	"minecraft:dandelion":                          true,
+	"minecraft:torchflower":                        true,
+	"minecraft:poppy":                              true,
+	"minecraft:blue_orchid":                        true,
+	"minecraft:allium":                             true,
+	"minecraft:azure_bluet":                        true,
+	"minecraft:red_tulip":                          true,
+	"minecraft:orange_tulip":                       true,
+	"minecraft:white_tulip":                        true,
+	"minecraft:pink_tulip":                         true,
+	"minecraft:oxeye_daisy":                        true,
+	"minecraft:cornflower":                         true,
+	"minecraft:wither_rose":                        true,
+	"minecraft:lily_of_the_valley":                 true,
+	"minecraft:brown_mushroom":                     true,
+	"minecraft:red_mushroom":                       true,
+	"minecraft:torch":                              true,
+	"minecraft:wall_torch":                         true,
+	"minecraft:fire":                               true,
+	"minecraft:soul_fire":                          true,
+	"minecraft:redstone_wire":                      true,
+	"minecraft:wheat":                              true,
+	"minecraft:oak_sign":                           true,
+	"minecraft:spruce_sign":                        true,
+	"minecraft:birch_sign":                         true,
+	"minecraft:acacia_sign":                        true,
+	"minecraft:cherry_sign":                        true,
+	"minecraft:jungle_sign":                        true,
+	"minecraft:dark_oak_sign":                      true,
+	"minecraft:pale_oak_sign":                      true,
+	"minecraft:mangrove_sign":                      true,
+	"minecraft:bamboo_sign":                        true,
+	"minecraft:rail":                               true,
+	"minecraft:oak_wall_sign":                      true,
+	"minecraft:spruce_wall_sign":                   true,
+	"minecraft:birch_wall_sign":                    true,
+	"minecraft:acacia_wall_sign":                   true,
+	"minecraft:cherry_wall_sign":                   true,
+	"minecraft:jungle_wall_sign":                   true,
+	"minecraft:dark_oak_wall_sign":                 true,
+	"minecraft:pale_oak_wall_sign":                 true,
+	"minecraft:mangrove_wall_sign":                 true,
+	"minecraft:bamboo_wall_sign":                   true,
+	"minecraft:oak_hanging_sign":                   true,
+	"minecraft:spruce_hanging_sign":                true,
+	"minecraft:birch_hanging_sign":                 true,
+	"minecraft:acacia_hanging_sign":                true,
+	"minecraft:cherry_hanging_sign":                true,
+	"minecraft:jungle_hanging_sign":                true,
+	"minecraft:dark_oak_hanging_sign":              true,
+	"minecraft:pale_oak_hanging_sign":              true,
+	"minecraft:crimson_hanging_sign":               true,
+	"minecraft:warped_hanging_sign":                true,
+	"minecraft:mangrove_hanging_sign":              true,
+	"minecraft:bamboo_hanging_sign":                true,
+	"minecraft:lever":                              true,
+	"minecraft:stone_pressure_plate":               true,
+	"minecraft:oak_pressure_plate":                 true,
+	"minecraft:spruce_pressure_plate":              true,
+	"minecraft:birch_pressure_plate":               true,
+	"minecraft:jungle_pressure_plate":              true,
+	"minecraft:acacia_pressure_plate":              true,
+	"minecraft:cherry_pressure_plate":              true,
+	"minecraft:dark_oak_pressure_plate":            true,
+	"minecraft:pale_oak_pressure_plate":            true,
+	"minecraft:mangrove_pressure_plate":            true,
+	"minecraft:bamboo_pressure_plate":              true,
+	"minecraft:redstone_torch":                     true,
+	"minecraft:redstone_wall_torch":                true,
+	"minecraft:stone_button":                       true,
+	"minecraft:snow":                               true,
+	"minecraft:cactus_flower":                      true,
+	"minecraft:sugar_cane":                         true,
+	"minecraft:soul_torch":                         true,
+	"minecraft:soul_wall_torch":                    true,
+	"minecraft:nether_portal":                      true,
+	"minecraft:attached_pumpkin_stem":              true,
+	"minecraft:attached_melon_stem":                true,
+	"minecraft:pumpkin_stem":                       true,
+	"minecraft:melon_stem":                         true,
+	"minecraft:vine":                               true,
+	"minecraft:glow_lichen":                        true,
+	"minecraft:resin_clump":                        true,
+	"minecraft:nether_wart":                        true,
+	"minecraft:end_portal":                         true,
+	"minecraft:tripwire_hook":                      true,
+	"minecraft:tripwire":                           true,
+	"minecraft:carrots":                            true,
+	"minecraft:potatoes":                           true,
+	"minecraft:oak_button":                         true,
+	"minecraft:spruce_button":                      true,
+	"minecraft:birch_button":                       true,
+	"minecraft:jungle_button":                      true,
+	"minecraft:acacia_button":                      true,
+	"minecraft:cherry_button":                      true,
+	"minecraft:dark_oak_button":                    true,
+	"minecraft:pale_oak_button":                    true,
+	"minecraft:mangrove_button":                    true,
+	"minecraft:bamboo_button":                      true,
+	"minecraft:light_weighted_pressure_plate":      true,
+	"minecraft:heavy_weighted_pressure_plate":      true,
+	"minecraft:activator_rail":                     true,
+	"minecraft:light":                              true,
+	"minecraft:sunflower":                          true,
+	"minecraft:lilac":                              true,
+	"minecraft:rose_bush":                          true,
+	"minecraft:peony":                              true,
+	"minecraft:tall_grass":                         true,
+	"minecraft:large_fern":                         true,
+	"minecraft:white_banner":                       true,
+	"minecraft:orange_banner":                      true,
+	"minecraft:magenta_banner":                     true,
+	"minecraft:light_blue_banner":                  true,
+	"minecraft:yellow_banner":                      true,
+	"minecraft:lime_banner":                        true,
+	"minecraft:pink_banner":                        true,
+	"minecraft:gray_banner":                        true,
+	"minecraft:light_gray_banner":                  true,
+	"minecraft:cyan_banner":                        true,
+	"minecraft:purple_banner":                      true,
+	"minecraft:blue_banner":                        true,
+	"minecraft:brown_banner":                       true,
+	"minecraft:green_banner":                       true,
+	"minecraft:red_banner":                         true,
+	"minecraft:black_banner":                       true,
+	"minecraft:white_wall_banner":                  true,
+	"minecraft:orange_wall_banner":                 true,
+	"minecraft:magenta_wall_banner":                true,
+	"minecraft:light_blue_wall_banner":             true,
+	"minecraft:yellow_wall_banner":                 true,
+	"minecraft:lime_wall_banner":                   true,
+	"minecraft:pink_wall_banner":                   true,
+	"minecraft:gray_wall_banner":                   true,
+	"minecraft:light_gray_wall_banner":             true,
+	"minecraft:cyan_wall_banner":                   true,
+	"minecraft:purple_wall_banner":                 true,
+	"minecraft:blue_wall_banner":                   true,
+	"minecraft:brown_wall_banner":                  true,
+	"minecraft:green_wall_banner":                  true,
+	"minecraft:red_wall_banner":                    true,
+	"minecraft:black_wall_banner":                  true,
+	"minecraft:torchflower_crop":                   true,
+	"minecraft:pitcher_plant":                      true,
+	"minecraft:beetroots":                          true,
+	"minecraft:end_gateway":                        true,
+	"minecraft:structure_void":                     true,
+	"minecraft:kelp":                               true,
+	"minecraft:kelp_plant":                         true,
+	"minecraft:dead_tube_coral":                    true,
+	"minecraft:dead_brain_coral":                   true,
+	"minecraft:dead_bubble_coral":                  true,
+	"minecraft:dead_fire_coral":                    true,
+	"minecraft:dead_horn_coral":                    true,
+	"minecraft:tube_coral":                         true,
+	"minecraft:brain_coral":                        true,
+	"minecraft:bubble_coral":                       true,
+	"minecraft:fire_coral":                         true,
+	"minecraft:horn_coral":                         true,
+	"minecraft:dead_tube_coral_fan":                true,
+	"minecraft:dead_brain_coral_fan":               true,
+	"minecraft:dead_bubble_coral_fan":              true,
+	"minecraft:dead_fire_coral_fan":                true,
+	"minecraft:dead_horn_coral_fan":                true,
+	"minecraft:tube_coral_fan":                     true,
+	"minecraft:brain_coral_fan":                    true,
+	"minecraft:bubble_coral_fan":                   true,
+	"minecraft:fire_coral_fan":                     true,
+	"minecraft:horn_coral_fan":                     true,
+	"minecraft:dead_tube_coral_wall_fan":           true,
+	"minecraft:dead_brain_coral_wall_fan":          true,
+	"minecraft:dead_bubble_coral_wall_fan":         true,
+	"minecraft:dead_fire_coral_wall_fan":           true,
+	"minecraft:dead_horn_coral_wall_fan":           true,
+	"minecraft:tube_coral_wall_fan":                true,
+	"minecraft:brain_coral_wall_fan":               true,
+	"minecraft:bubble_coral_wall_fan":              true,
+	"minecraft:fire_coral_wall_fan":                true,
+	"minecraft:horn_coral_wall_fan":                true,
+	"minecraft:bamboo_sapling":                     true,
+	"minecraft:void_air":                           true,
+	"minecraft:cave_air":                           true,
+	"minecraft:bubble_column":                      true,
+	"minecraft:sweet_berry_bush":                   true,
+	"minecraft:warped_fungus":                      true,
+	"minecraft:warped_roots":                       true,
+	"minecraft:nether_sprouts":                     true,
+	"minecraft:crimson_fungus":                     true,
+	"minecraft:weeping_vines":                      true,
+	"minecraft:weeping_vines_plant":                true,
+	"minecraft:twisting_vines":                     true,
+	"minecraft:twisting_vines_plant":               true,
+	"minecraft:crimson_roots":                      true,
+	"minecraft:crimson_pressure_plate":             true,
+	"minecraft:warped_pressure_plate":              true,
+	"minecraft:crimson_button":                     true,
+	"minecraft:warped_button":                      true,
+	"minecraft:crimson_sign":                       true,
+	"minecraft:warped_sign":                        true,
+	"minecraft:crimson_wall_sign":                  true,
+	"minecraft:warped_wall_sign":                   true,
+	"minecraft:polished_blackstone_pressure_plate": true,
+	"minecraft:polished_blackstone_button":         true,
+	"minecraft:powder_snow":                        true,
+	"minecraft:sculk_vein":                         true,
+	"minecraft:cave_vines":                         true,
+	"minecraft:cave_vines_plant":                   true,
+	"minecraft:spore_blossom":                      true,
+	"minecraft:pink_petals":                        true,
+	"minecraft:wildflowers":                        true,
+	"minecraft:leaf_litter":                        true,
+	"minecraft:big_dripleaf_stem":                  true,
+	"minecraft:small_dripleaf":                     true,
+	"minecraft:hanging_roots":                      true,
+	"minecraft:frogspawn":                          true,
+	"minecraft:pale_hanging_moss":                  true,
+	"minecraft:open_eyeblossom":                    true,
+	"minecraft:closed_eyeblossom":                  true,
+	"minecraft:firefly_bush":                       true,
+}
@@ -0,0 +1,1422 @@
+// Code generated by gendata from minecraft-data. DO NOT EDIT.
+
+package world
+
+var stackSizes = map[string]int{
+	"minecraft:air":                                    64,
+	"minecraft:stone":                                  64,
+	"minecraft:granite":                                64,
+	"minecraft:polished_granite":                       64,
+	"minecraft:diorite":                                64,
+	"minecraft:polished_diorite":                       64,
+	"minecraft:andesite":                               64,
+	"minecraft:polished_andesite":                      64,
+	"minecraft:deepslate":                              64,
+	"minecraft:cobbled_deepslate":                      64,
+	"minecraft:polished_deepslate":                     64,
+	"minecraft:calcite":                                64,
+	"minecraft:tuff":                                   64,
+	"minecraft:tuff_slab":                              64,
+	"minecraft:tuff_stairs":                            64,
+	"minecraft:tuff_wall":                              64,
+	"minecraft:chiseled_tuff":                          64,
+	"minecraft:polished_tuff":                          64,
+	"minecraft:polished_tuff_slab":                     64,
+	"minecraft:polished_tuff_stairs":                   64,
+	"minecraft:polished_tuff_wall":                     64,
+	"minecraft:tuff_bricks":                            64,
+	"minecraft:tuff_brick_slab":                        64,
+	"minecraft:tuff_brick_stairs":                      64,
+	"minecraft:tuff_brick_wall":                        64,
+	"minecraft:chiseled_tuff_bricks":                   64,
+	"minecraft:dripstone_block":                        64,
+	"minecraft:grass_block":                            64,
+	"minecraft:dirt":                                   64,
+	"minecraft:coarse_dirt":                            64,
+	"minecraft:podzol":                                 64,
+	"minecraft:rooted_dirt":                            64,
+	"minecraft:mud":                                    64,
+	"minecraft:crimson_nylium":                         64,
+	"minecraft:warped_nylium":                          64,
+	"minecraft:cobblestone":                            64,
+	"minecraft:oak_planks":                             64,
+	"minecraft:spruce_planks":                          64,
+	"minecraft:birch_planks":                           64,
+	"minecraft:jungle_planks":                          64,
+	"minecraft:acacia_planks":                          64,
+	"minecraft:cherry_planks":                          64,
+	"minecraft:dark_oak_planks":                        64,
+	"minecraft:pale_oak_planks":                        64,
+	"minecraft:mangrove_planks":                        64,
+	"minecraft:bamboo_planks":                          64,
+	"minecraft:crimson_planks":                         64,
+	"minecraft:warped_planks":                          64,
+	"minecraft:bamboo_mosaic":                          64,
+	"minecraft:oak_sapling":                            64,
+	"minecraft:spruce_sapling":                         64,
+	"minecraft:birch_sapling":                          64,
+	"minecraft:jungle_sapling":                         64,
+	"minecraft:acacia_sapling":                         64,
+	"minecraft:cherry_sapling":                         64,
+	"minecraft:dark_oak_sapling":                       64,
+	"minecraft:pale_oak_sapling":                       64,
+	"minecraft:mangrove_propagule":                     64,
+	"minecraft:bedrock":                                64,
+	"minecraft:sand":                                   64,
+	"minecraft:suspicious_sand":                        64,
+	"minecraft:suspicious_gravel":                      64,
+	"minecraft:red_sand":                               64,
+	"minecraft:gravel":                                 64,
+	"minecraft:coal_ore":                               64,
+	"minecraft:deepslate_coal_ore":                     64,
+	"minecraft:iron_ore":                               64,
+	"minecraft:deepslate_iron_ore":                     64,
+	"minecraft:copper_ore":                             64,
+	"minecraft:deepslate_copper_ore":                   64,
+	"minecraft:gold_ore":                               64,
+	"minecraft:deepslate_gold_ore":                     64,
+	"minecraft:redstone_ore":                           64,
+	"minecraft:deepslate_redstone_ore":                 64,
+	"minecraft:emerald_ore":                            64,
+	"minecraft:deepslate_emerald_ore":                  64,
+	"minecraft:lapis_ore":                              64,
+	"minecraft:deepslate_lapis_ore":                    64,
+	"minecraft:diamond_ore":                            64,
+	"minecraft:deepslate_diamond_ore":                  64,
+	"minecraft:nether_gold_ore":                        64,
+	"minecraft:nether_quartz_ore":                      64,
+	"minecraft:ancient_debris":                         64,
+	"minecraft:coal_block":                             64,
+	"minecraft:raw_iron_block":                         64,
+	"minecraft:raw_copper_block":                       64,
+	"minecraft:raw_gold_block":                         64,
+	"minecraft:heavy_core":                             64,
+	"minecraft:amethyst_block":                         64,
+	"minecraft:budding_amethyst":                       64,
+	"minecraft:iron_block":                             64,
+	"minecraft:copper_block":                           64,
+	"minecraft:gold_block":                             64,
+	"minecraft:diamond_block":                          64,
+	"minecraft:netherite_block":                        64,
+	"minecraft:exposed_copper":                         64,
+	"minecraft:weathered_copper":                       64,
+	"minecraft:oxidized_copper":                        64,
+	"minecraft:chiseled_copper":                        64,
+	"minecraft:exposed_chiseled_copper":                64,
+	"minecraft:weathered_chiseled_copper":              64,
+	"minecraft:oxidized_chiseled_copper":               64,
+	"minecraft:cut_copper":                             64,
+	"minecraft:exposed_cut_copper":                     64,
+	"minecraft:weathered_cut_copper":                   64,
+	"minecraft:oxidized_cut_copper":                    64,
+	"minecraft:cut_copper_stairs":                      64,
+	"minecraft:exposed_cut_copper_stairs":              64,
+	"minecraft:weathered_cut_copper_stairs":            64,
+	"minecraft:oxidized_cut_copper_stairs":             64,
+	"minecraft:cut_copper_slab":                        64,
+	"minecraft:exposed_cut_copper_slab":                64,
+	"minecraft:weathered_cut_copper_slab":              64,
+	"minecraft:oxidized_cut_copper_slab":               64,
+	"minecraft:waxed_copper_block":                     64,
+	"minecraft:waxed_exposed_copper":                   64,
+	"minecraft:waxed_weathered_copper":                 64,
+	"minecraft:waxed_oxidized_copper":                  64,
+	"minecraft:waxed_chiseled_copper":                  64,
+	"minecraft:waxed_exposed_chiseled_copper":          64,
+	"minecraft:waxed_weathered_chiseled_copper":        64,
+	"minecraft:waxed_oxidized_chiseled_copper":         64,
+	"minecraft:waxed_cut_copper":                       64,
+	"minecraft:waxed_exposed_cut_copper":               64,
+	"minecraft:waxed_weathered_cut_copper":             64,
+	"minecraft:waxed_oxidized_cut_copper":              64,
+	"minecraft:waxed_cut_copper_stairs":                64,
+	"minecraft:waxed_exposed_cut_copper_stairs":        64,
+	"minecraft:waxed_weathered_cut_copper_stairs":      64,
+	"minecraft:waxed_oxidized_cut_copper_stairs":       64,
+	"minecraft:waxed_cut_copper_slab":                  64,
+	"minecraft:waxed_exposed_cut_copper_slab":          64,
+	"minecraft:waxed_weathered_cut_copper_slab":        64,
+	"minecraft:waxed_oxidized_cut_copper_slab":         64,
+	"minecraft:oak_log":                                64,
+	"minecraft:spruce_log":                             64,
+	"minecraft:birch_log":                              64,
+	"minecraft:jungle_log":                             64,
+	"minecraft:acacia_log":                             64,
+	"minecraft:cherry_log":                             64,
+	"minecraft:pale_oak_log":                           64,
+	"minecraft:dark_oak_log":                           64,
+	"minecraft:mangrove_log":                           64,
+	"minecraft:mangrove_roots":                         64,
+	"minecraft:muddy_mangrove_roots":                   64,
+	"minecraft:crimson_stem":                           64,
+	"minecraft:warped_stem":                            64,
+	"minecraft:bamboo_block":                           64,
+	"minecraft:stripped_oak_log":                       64,
+	"minecraft:stripped_spruce_log":                    64,
+	"minecraft:stripped_birch_log":                     64,
+	"minecraft:stripped_jungle_log":                    64,
+	"minecraft:stripped_acacia_log":                    64,
+	"minecraft:stripped_cherry_log":                    64,
+	"minecraft:stripped_dark_oak_log":                  64,
+	"minecraft:stripped_pale_oak_log":                  64,
+	"minecraft:stripped_mangrove_log":                  64,
+	"minecraft:stripped_crimson_stem":                  64,
+	"minecraft:stripped_warped_stem":                   64,
+	"minecraft:stripped_oak_wood":                      64,
+	"minecraft:stripped_spruce_wood":                   64,
+	"minecraft:stripped_birch_wood":                    64,
+	"minecraft:stripped_jungle_wood":                   64,
+	"minecraft:stripped_acacia_wood":                   64,
+	"minecraft:stripped_cherry_wood":                   64,
+	"minecraft:stripped_dark_oak_wood":                 64,
+	"minecraft:stripped_pale_oak_wood":                 64,
+	"minecraft:stripped_mangrove_wood":                 64,
+	"minecraft:stripped_crimson_hyphae":                64,
+	"minecraft:stripped_warped_hyphae":                 64,
+	"minecraft:stripped_bamboo_block":                  64,
+	"minecraft:oak_wood":                               64,
+	"minecraft:spruce_wood":                            64,
+	"minecraft:birch_wood":                             64,
+	"minecraft:jungle_wood":                            64,
+	"minecraft:acacia_wood":                            64,
+	"minecraft:cherry_wood":                            64,
+	"minecraft:pale_oak_wood":                          64,
+	"minecraft:dark_oak_wood":                          64,
+	"minecraft:mangrove_wood":                          64,
+	"minecraft:crimson_hyphae":                         64,
+	"minecraft:warped_hyphae":                          64,
+	"minecraft:oak_leaves":                             64,
+	"minecraft:spruce_leaves":                          64,
+	"minecraft:birch_leaves":                           64,
+	"minecraft:jungle_leaves":                          64,
+	"minecraft:acacia_leaves":                          64,
+	"minecraft:cherry_leaves":                          64,
+	"minecraft:dark_oak_leaves":                        64,
+	"minecraft:pale_oak_leaves":                        64,
+	"minecraft:mangrove_leaves":                        64,
+	"minecraft:azalea_leaves":                          64,
+	"minecraft:flowering_azalea_leaves":                64,
+	"minecraft:sponge":                                 64,
+	"minecraft:wet_sponge":                             64,
+	"minecraft:glass":                                  64,
+	"minecraft:tinted_glass":                           64,
+	"minecraft:lapis_block":                            64,
+	"minecraft:sandstone":                              64,
+	"minecraft:chiseled_sandstone":                     64,
+	"minecraft:cut_sandstone":                          64,
+	"minecraft:cobweb":                                 64,
+	"minecraft:short_grass":                            64,
+	"minecraft:fern":                                   64,
+	"minecraft:bush":                                   64,
+	"minecraft:azalea":                                 64,
+	"minecraft:flowering_azalea":                       64,
+	"minecraft:dead_bush":                              64,
+	"minecraft:firefly_bush":                           64,
+	"minecraft:short_dry_grass":                        64,
+	"minecraft:tall_dry_grass":                         64,
+	"minecraft:seagrass":                               64,
+	"minecraft:sea_pickle":                             64,
+	"minecraft:white_wool":                             64,
+	"minecraft:orange_wool":                            64,
+	"minecraft:magenta_wool":                           64,
+	"minecraft:light_blue_wool":                        64,
+	"minecraft:yellow_wool":                            64,
+	"minecraft:lime_wool":                              64,
+	"minecraft:pink_wool":                              64,
+	"minecraft:gray_wool":                              64,
+	"minecraft:light_gray_wool":                        64,
+	"minecraft:cyan_wool":                              64,
+	"minecraft:purple_wool":                            64,
+	"minecraft:blue_wool":                              64,
+	"minecraft:brown_wool":                             64,
+	"minecraft:green_wool":                             64,
+	"minecraft:red_wool":                               64,
+	"minecraft:black_wool":                             64,
+	"minecraft:dandelion":                              64,
+	"minecraft:open_eyeblossom":                        64,
+	"minecraft:closed_eyeblossom":                      64,
+	"minecraft:poppy":                                  64,
+	"minecraft:blue_orchid":                            64,
+	"minecraft:allium":                                 64,
+	"minecraft:azure_bluet":                            64,
+	"minecraft:red_tulip":                              64,
+	"minecraft:orange_tulip":                           64,
+	"minecraft:white_tulip":                            64,
+	"minecraft:pink_tulip":                             64,
+	"minecraft:oxeye_daisy":                            64,
+	"minecraft:cornflower":                             64,
+	"minecraft:lily_of_the_valley":                     64,
+	"minecraft:wither_rose":                            64,
+	"minecraft:torchflower":                            64,
+	"minecraft:pitcher_plant":                          64,
+	"minecraft:spore_blossom":                          64,
+	"minecraft:brown_mushroom":                         64,
+	"minecraft:red_mushroom":                           64,
+	"minecraft:crimson_fungus":                         64,
+	"minecraft:warped_fungus":                          64,
+	"minecraft:crimson_roots":                          64,
+	"minecraft:warped_roots":                           64,
+	"minecraft:nether_sprouts":                         64,
+	"minecraft:weeping_vines":                          64,
+	"minecraft:twisting_vines":                         64,
+	"minecraft:sugar_cane":                             64,
+	"minecraft:kelp":                                   64,
+	"minecraft:pink_petals":                            64,
+	"minecraft:wildflowers":                            64,
+	"minecraft:leaf_litter":                            64,
+	"minecraft:moss_carpet":                            64,
+	"minecraft:moss_block":                             64,
+	"minecraft:pale_moss_carpet":                       64,
+	"minecraft:pale_hanging_moss":                      64,
+	"minecraft:pale_moss_block":                        64,
+	"minecraft:hanging_roots":                          64,
+	"minecraft:big_dripleaf":                           64,
+	"minecraft:small_dripleaf":                         64,
+	"minecraft:bamboo":                                 64,
+	"minecraft:oak_slab":                               64,
+	"minecraft:spruce_slab":                            64,
+	"minecraft:birch_slab":                             64,
+	"minecraft:jungle_slab":                            64,
+	"minecraft:acacia_slab":                            64,
+	"minecraft:cherry_slab":                            64,
+	"minecraft:dark_oak_slab":                          64,
+	"minecraft:pale_oak_slab":                          64,
+	"minecraft:mangrove_slab":                          64,
+	"minecraft:bamboo_slab":                            64,
+	"minecraft:bamboo_mosaic_slab":                     64,
+	"minecraft:crimson_slab":                           64,
+	"minecraft:warped_slab":                            64,
+	"minecraft:stone_slab":                             64,
+	"minecraft:smooth_stone_slab":                      64,
+	"minecraft:sandstone_slab":                         64,
+	"minecraft:cut_sandstone_slab":                     64,
+	"minecraft:petrified_oak_slab":                     64,
+	"minecraft:cobblestone_slab":                       64,
+	"minecraft:brick_slab":                             64,
+	"minecraft:stone_brick_slab":                       64,
+	"minecraft:mud_brick_slab":                         64,
+	"minecraft:nether_brick_slab":                      64,
+	"minecraft:quartz_slab":                            64,
+	"minecraft:red_sandstone_slab":                     64,
+	"minecraft:cut_red_sandstone_slab":                 64,
+	"minecraft:purpur_slab":                            64,
+	"minecraft:prismarine_slab":                        64,
+	"minecraft:prismarine_brick_slab":                  64,
+	"minecraft:dark_prismarine_slab":                   64,
+	"minecraft:smooth_quartz":                          64,
+	"minecraft:smooth_red_sandstone":                   64,
+	"minecraft:smooth_sandstone":                       64,
+	"minecraft:smooth_stone":                           64,
+	"minecraft:bricks":                                 64,
+	"minecraft:bookshelf":                              64,
+	"minecraft:chiseled_bookshelf":                     64,
+	"minecraft:decorated_pot":                          64,
+	"minecraft:mossy_cobblestone":                      64,
+	"minecraft:obsidian":                               64,
+	"minecraft:torch":                                  64,
+	"minecraft:end_rod":                                64,
+	"minecraft:chorus_plant":                           64,
+	"minecraft:chorus_flower":                          64,
+	"minecraft:purpur_block":                           64,
+	"minecraft:purpur_pillar":                          64,
+	"minecraft:purpur_stairs":                          64,
+	"minecraft:spawner":                                64,
+	"minecraft:creaking_heart":                         64,
+	"minecraft:chest":                                  64,
+	"minecraft:crafting_table":                         64,
+	"minecraft:farmland":                               64,
+	"minecraft:furnace":                                64,
+	"minecraft:ladder":                                 64,
+	"minecraft:cobblestone_stairs":                     64,
+	"minecraft:snow":                                   64,
+	"minecraft:ice":                                    64,
+	"minecraft:snow_block":                             64,
+	"minecraft:cactus":                                 64,
+	"minecraft:cactus_flower":                          64,
+	"minecraft:clay":                                   64,
+	"minecraft:jukebox":                                64,
+	"minecraft:oak_fence":                              64,
+	"minecraft:spruce_fence":                           64,
+	"minecraft:birch_fence":                            64,
+	"minecraft:jungle_fence":                           64,
+	"minecraft:acacia_fence":                           64,
+	"minecraft:cherry_fence":                           64,
+	"minecraft:dark_oak_fence":                         64,
+	"minecraft:pale_oak_fence":                         64,
+	"minecraft:mangrove_fence":                         64,
+	"minecraft:bamboo_fence":                           64,
+	"minecraft:crimson_fence":                          64,
+	"minecraft:warped_fence":                           64,
+	"minecraft:pumpkin":                                64,
+	"minecraft:carved_pumpkin":                         64,
+	"minecraft:jack_o_lantern":                         64,
+	"minecraft:netherrack":                             64,
+	"minecraft:soul_sand":                              64,
+	"minecraft:soul_soil":                              64,
+	"minecraft:basalt":                                 64,
+	"minecraft:polished_basalt":                        64,
+	"minecraft:smooth_basalt":                          64,
+	"minecraft:soul_torch":                             64,
+	"minecraft:glowstone":                              64,
+	"minecraft:infested_stone":                         64,
+	"minecraft:infested_cobblestone":                   64,
+	"minecraft:infested_stone_bricks":                  64,
+	"minecraft:infested_mossy_stone_bricks":            64,
+	"minecraft:infested_cracked_stone_bricks":          64,
+	"minecraft:infested_chiseled_stone_bricks":         64,
+	"minecraft:infested_deepslate":                     64,
+	"minecraft:stone_bricks":                           64,
+	"minecraft:mossy_stone_bricks":                     64,
+	"minecraft:cracked_stone_bricks":                   64,
+	"minecraft:chiseled_stone_bricks":                  64,
+	"minecraft:packed_mud":                             64,
+	"minecraft:mud_bricks":                             64,
+	"minecraft:deepslate_bricks":                       64,
+	"minecraft:cracked_deepslate_bricks":               64,
+	"minecraft:deepslate_tiles":                        64,
+	"minecraft:cracked_deepslate_tiles":                64,
+	"minecraft:chiseled_deepslate":                     64,
+	"minecraft:reinforced_deepslate":                   64,
+	"minecraft:brown_mushroom_block":                   64,
+	"minecraft:red_mushroom_block":                     64,
+	"minecraft:mushroom_stem":                          64,
+	"minecraft:iron_bars":                              64,
+	"minecraft:chain":                                  64,
+	"minecraft:glass_pane":                             64,
+	"minecraft:melon":                                  64,
+	"minecraft:vine":                                   64,
+	"minecraft:glow_lichen":                            64,
+	"minecraft:resin_clump":                            64,
+	"minecraft:resin_block":                            64,
+	"minecraft:resin_bricks":                           64,
+	"minecraft:resin_brick_stairs":                     64,
+	"minecraft:resin_brick_slab":                       64,
+	"minecraft:resin_brick_wall":                       64,
+	"minecraft:chiseled_resin_bricks":                  64,
+	"minecraft:brick_stairs":                           64,
+	"minecraft:stone_brick_stairs":                     64,
+	"minecraft:mud_brick_stairs":                       64,
+	"minecraft:mycelium":                               64,
+	"minecraft:lily_pad":                               64,
+	"minecraft:nether_bricks":                          64,
+	"minecraft:cracked_nether_bricks":                  64,
+	"minecraft:chiseled_nether_bricks":                 64,
+	"minecraft:nether_brick_fence":                     64,
+	"minecraft:nether_brick_stairs":                    64,
+	"minecraft:sculk":                                  64,
+	"minecraft:sculk_vein":                             64,
+	"minecraft:sculk_catalyst":                         64,
+	"minecraft:sculk_shrieker":                         64,
+	"minecraft:enchanting_table":                       64,
+	"minecraft:end_portal_frame":                       64,
+	"minecraft:end_stone":                              64,
+	"minecraft:end_stone_bricks":                       64,
+	"minecraft:dragon_egg":                             64,
+	"minecraft:sandstone_stairs":                       64,
+	"minecraft:ender_chest":                            64,
+	"minecraft:emerald_block":                          64,
+	"minecraft:oak_stairs":                             64,
+	"minecraft:spruce_stairs":                          64,
+	"minecraft:birch_stairs":                           64,
+	"minecraft:jungle_stairs":                          64,
+	"minecraft:acacia_stairs":                          64,
+	"minecraft:cherry_stairs":                          64,
+	"minecraft:dark_oak_stairs":                        64,
+	"minecraft:pale_oak_stairs":                        64,
+	"minecraft:mangrove_stairs":                        64,
+	"minecraft:bamboo_stairs":                          64,
+	"minecraft:bamboo_mosaic_stairs":                   64,
+	"minecraft:crimson_stairs":                         64,
+	"minecraft:warped_stairs":                          64,
+	"minecraft:command_block":                          64,
+	"minecraft:beacon":                                 64,
+	"minecraft:cobblestone_wall":                       64,
+	"minecraft:mossy_cobblestone_wall":                 64,
+	"minecraft:brick_wall":                             64,
+	"minecraft:prismarine_wall":                        64,
+	"minecraft:red_sandstone_wall":                     64,
+	"minecraft:mossy_stone_brick_wall":                 64,
+	"minecraft:granite_wall":                           64,
+	"minecraft:stone_brick_wall":                       64,
+	"minecraft:mud_brick_wall":                         64,
+	"minecraft:nether_brick_wall":                      64,
+	"minecraft:andesite_wall":                          64,
+	"minecraft:red_nether_brick_wall":                  64,
+	"minecraft:sandstone_wall":                         64,
+	"minecraft:end_stone_brick_wall":                   64,
+	"minecraft:diorite_wall":                           64,
+	"minecraft:blackstone_wall":                        64,
+	"minecraft:polished_blackstone_wall":               64,
+	"minecraft:polished_blackstone_brick_wall":         64,
+	"minecraft:cobbled_deepslate_wall":                 64,
+	"minecraft:polished_deepslate_wall":                64,
+	"minecraft:deepslate_brick_wall":                   64,
+	"minecraft:deepslate_tile_wall":                    64,
+	"minecraft:anvil":                                  64,
+	"minecraft:chipped_anvil":                          64,
+	"minecraft:damaged_anvil":                          64,
+	"minecraft:chiseled_quartz_block":                  64,
+	"minecraft:quartz_block":                           64,
+	"minecraft:quartz_bricks":                          64,
+	"minecraft:quartz_pillar":                          64,
+	"minecraft:quartz_stairs":                          64,
+	"minecraft:white_terracotta":                       64,
+	"minecraft:orange_terracotta":                      64,
+	"minecraft:magenta_terracotta":                     64,
+	"minecraft:light_blue_terracotta":                  64,
+	"minecraft:yellow_terracotta":                      64,
+	"minecraft:lime_terracotta":                        64,
+	"minecraft:pink_terracotta":                        64,
+	"minecraft:gray_terracotta":                        64,
+	"minecraft:light_gray_terracotta":                  64,
+	"minecraft:cyan_terracotta":                        64,
+	"minecraft:purple_terracotta":                      64,
+	"minecraft:blue_terracotta":                        64,
+	"minecraft:brown_terracotta":                       64,
+	"minecraft:green_terracotta":                       64,
+	"minecraft:red_terracotta":                         64,
+	"minecraft:black_terracotta":                       64,
+	"minecraft:barrier":                                64,
+	"minecraft:light":                                  64,
+	"minecraft:hay_block":                              64,
+	"minecraft:white_carpet":                           64,
+	"minecraft:orange_carpet":                          64,
+	"minecraft:magenta_carpet":                         64,
+	"minecraft:light_blue_carpet":                      64,
+	"minecraft:yellow_carpet":                          64,
+	"minecraft:lime_carpet":                            64,
+	"minecraft:pink_carpet":                            64,
+	"minecraft:gray_carpet":                            64,
+	"minecraft:light_gray_carpet":                      64,
+	"minecraft:cyan_carpet":                            64,
+	"minecraft:purple_carpet":                          64,
+	"minecraft:blue_carpet":                            64,
+	"minecraft:brown_carpet":                           64,
+	"minecraft:green_carpet":                           64,
+	"minecraft:red_carpet":                             64,
+	"minecraft:black_carpet":                           64,
+	"minecraft:terracotta":                             64,
+	"minecraft:packed_ice":                             64,
+	"minecraft:dirt_path":                              64,
+	"minecraft:sunflower":                              64,
+	"minecraft:lilac":                                  64,
+	"minecraft:rose_bush":                              64,
+	"minecraft:peony":                                  64,
+	"minecraft:tall_grass":                             64,
+	"minecraft:large_fern":                             64,
+	"minecraft:white_stained_glass":                    64,
+	"minecraft:orange_stained_glass":                   64,
+	"minecraft:magenta_stained_glass":                  64,
+	"minecraft:light_blue_stained_glass":               64,
+	"minecraft:yellow_stained_glass":                   64,
+	"minecraft:lime_stained_glass":                     64,
+	"minecraft:pink_stained_glass":                     64,
+	"minecraft:gray_stained_glass":                     64,
+	"minecraft:light_gray_stained_glass":               64,
+	"minecraft:cyan_stained_glass":                     64,
+	"minecraft:purple_stained_glass":                   64,
+	"minecraft:blue_stained_glass":                     64,
+	"minecraft:brown_stained_glass":                    64,
+	"minecraft:green_stained_glass":                    64,
+	"minecraft:red_stained_glass":                      64,
+	"minecraft:black_stained_glass":                    64,
+	"minecraft:white_stained_glass_pane":               64,
+	"minecraft:orange_stained_glass_pane":              64,
+	"minecraft:magenta_stained_glass_pane":             64,
+	"minecraft:light_blue_stained_glass_pane":          64,
+	"minecraft:yellow_stained_glass_pane":              64,
+	"minecraft:lime_stained_glass_pane":                64,
+	"minecraft:pink_stained_glass_pane":                64,
+	"minecraft:gray_stained_glass_pane":                64,
+	"minecraft:light_gray_stained_glass_pane":          64,
+	"minecraft:cyan_stained_glass_pane":                64,
+	"minecraft:purple_stained_glass_pane":              64,
+	"minecraft:blue_stained_glass_pane":                64,
+	"minecraft:brown_stained_glass_pane":               64,
+	"minecraft:green_stained_glass_pane":               64,
+	"minecraft:red_stained_glass_pane":                 64,
+	"minecraft:black_stained_glass_pane":               64,
+	"minecraft:prismarine":                             64,
+	"minecraft:prismarine_bricks":                      64,
+	"minecraft:dark_prismarine":                        64,
+	"minecraft:prismarine_stairs":                      64,
+	"minecraft:prismarine_brick_stairs":                64,
+	"minecraft:dark_prismarine_stairs":                 64,
+	"minecraft:sea_lantern":                            64,
+	"minecraft:red_sandstone":                          64,
+	"minecraft:chiseled_red_sandstone":                 64,
+	"minecraft:cut_red_sandstone":                      64,
+	"minecraft:red_sandstone_stairs":                   64,
+	"minecraft:repeating_command_block":                64,
+	"minecraft:chain_command_block":                    64,
+	"minecraft:magma_block":                            64,
+	"minecraft:nether_wart_block":                      64,
+	"minecraft:warped_wart_block":                      64,
+	"minecraft:red_nether_bricks":                      64,
+	"minecraft:bone_block":                             64,
+	"minecraft:structure_void":                         64,
+	"minecraft:shulker_box":                            1,
+	"minecraft:white_shulker_box":                      1,
+	"minecraft:orange_shulker_box":                     1,
+	"minecraft:magenta_shulker_box":                    1,
+	"minecraft:light_blue_shulker_box":                 1,
+	"minecraft:yellow_shulker_box":                     1,
+	"minecraft:lime_shulker_box":                       1,
+	"minecraft:pink_shulker_box":                       1,
+	"minecraft:gray_shulker_box":                       1,
+	"minecraft:light_gray_shulker_box":                 1,
+	"minecraft:cyan_shulker_box":                       1,
+	"minecraft:purple_shulker_box":                     1,
+	"minecraft:blue_shulker_box":                       1,
+	"minecraft:brown_shulker_box":                      1,
+	"minecraft:green_shulker_box":                      1,
+	"minecraft:red_shulker_box":                        1,
+	"minecraft:black_shulker_box":                      1,
+	"minecraft:white_glazed_terracotta":                64,
+	"minecraft:orange_glazed_terracotta":               64,
+	"minecraft:magenta_glazed_terracotta":              64,
+	"minecraft:light_blue_glazed_terracotta":           64,
+	"minecraft:yellow_glazed_terracotta":               64,
+	"minecraft:lime_glazed_terracotta":                 64,
+	"minecraft:pink_glazed_terracotta":                 64,
+	"minecraft:gray_glazed_terracotta":                 64,
+	"minecraft:light_gray_glazed_terracotta":           64,
+	"minecraft:cyan_glazed_terracotta":                 64,
+	"minecraft:purple_glazed_terracotta":               64,
+	"minecraft:blue_glazed_terracotta":                 64,
+	"minecraft:brown_glazed_terracotta":                64,
+	"minecraft:green_glazed_terracotta":                64,
+	"minecraft:red_glazed_terracotta":                  64,
+	"minecraft:black_glazed_terracotta":                64,
+	"minecraft:white_concrete":                         64,
+	"minecraft:orange_concrete":                        64,
+	"minecraft:magenta_concrete":                       64,
+	"minecraft:light_blue_concrete":                    64,
+	"minecraft:yellow_concrete":                        64,
+	"minecraft:lime_concrete":                          64,
+	"minecraft:pink_concrete":                          64,
+	"minecraft:gray_concrete":                          64,
+	"minecraft:light_gray_concrete":                    64,
+	"minecraft:cyan_concrete":                          64,
+	"minecraft:purple_concrete":                        64,
+	"minecraft:blue_concrete":                          64,
+	"minecraft:brown_concrete":                         64,
+	"minecraft:green_concrete":                         64,
+	"minecraft:red_concrete":                           64,
+	"minecraft:black_concrete":                         64,
+	"minecraft:white_concrete_powder":                  64,
+	"minecraft:orange_concrete_powder":                 64,
+	"minecraft:magenta_concrete_powder":                64,
+	"minecraft:light_blue_concrete_powder":             64,
+	"minecraft:yellow_concrete_powder":                 64,
+	"minecraft:lime_concrete_powder":                   64,
+	"minecraft:pink_concrete_powder":                   64,
+	"minecraft:gray_concrete_powder":                   64,
+	"minecraft:light_gray_concrete_powder":             64,
+	"minecraft:cyan_concrete_powder":                   64,
+	"minecraft:purple_concrete_powder":                 64,
+	"minecraft:blue_concrete_powder":                   64,
+	"minecraft:brown_concrete_powder":                  64,
+	"minecraft:green_concrete_powder":                  64,
+	"minecraft:red_concrete_powder":                    64,
+	"minecraft:black_concrete_powder":                  64,
+	"minecraft:turtle_egg":                             64,
+	"minecraft:sniffer_egg":                            64,
+	"minecraft:dried_ghast":                            64,
+	"minecraft:dead_tube_coral_block":                  64,
+	"minecraft:dead_brain_coral_block":                 64,
+	"minecraft:dead_bubble_coral_block":                64,
+	"minecraft:dead_fire_coral_block":                  64,
+	"minecraft:dead_horn_coral_block":                  64,
+	"minecraft:tube_coral_block":                       64,
+	"minecraft:brain_coral_block":                      64,
+	"minecraft:bubble_coral_block":                     64,
+	"minecraft:fire_coral_block":                       64,
+	"minecraft:horn_coral_block":                       64,
+	"minecraft:tube_coral":                             64,
+	"minecraft:brain_coral":                            64,
+	"minecraft:bubble_coral":                           64,
+	"minecraft:fire_coral":                             64,
+	"minecraft:horn_coral":                             64,
+	"minecraft:dead_brain_coral":                       64,
+	"minecraft:dead_bubble_coral":                      64,
+	"minecraft:dead_fire_coral":                        64,
+	"minecraft:dead_horn_coral":                        64,
+	"minecraft:dead_tube_coral":                        64,
+	"minecraft:tube_coral_fan":                         64,
+	"minecraft:brain_coral_fan":                        64,
+	"minecraft:bubble_coral_fan":                       64,
+	"minecraft:fire_coral_fan":                         64,
+	"minecraft:horn_coral_fan":                         64,
+	"minecraft:dead_tube_coral_fan":                    64,
+	"minecraft:dead_brain_coral_fan":                   64,
+	"minecraft:dead_bubble_coral_fan":                  64,
+	"minecraft:dead_fire_coral_fan":                    64,
+	"minecraft:dead_horn_coral_fan":                    64,
+	"minecraft:blue_ice":                               64,
+	"minecraft:conduit":                                64,
+	"minecraft:polished_granite_stairs":                64,
+	"minecraft:smooth_red_sandstone_stairs":            64,
+	"minecraft:mossy_stone_brick_stairs":               64,
+	"minecraft:polished_diorite_stairs":                64,
+	"minecraft:mossy_cobblestone_stairs":               64,
+	"minecraft:end_stone_brick_stairs":                 64,
+	"minecraft:stone_stairs":                           64,
+	"minecraft:smooth_sandstone_stairs":                64,
+	"minecraft:smooth_quartz_stairs":                   64,
+	"minecraft:granite_stairs":                         64,
+	"minecraft:andesite_stairs":                        64,
+	"minecraft:red_nether_brick_stairs":                64,
+	"minecraft:polished_andesite_stairs":               64,
+	"minecraft:diorite_stairs":                         64,
+	"minecraft:cobbled_deepslate_stairs":               64,
+	"minecraft:polished_deepslate_stairs":              64,
+	"minecraft:deepslate_brick_stairs":                 64,
+	"minecraft:deepslate_tile_stairs":                  64,
+	"minecraft:polished_granite_slab":                  64,
+	"minecraft:smooth_red_sandstone_slab":              64,
+	"minecraft:mossy_stone_brick_slab":                 64,
+	"minecraft:polished_diorite_slab":                  64,
+	"minecraft:mossy_cobblestone_slab":                 64,
+	"minecraft:end_stone_brick_slab":                   64,
+	"minecraft:smooth_sandstone_slab":                  64,
+	"minecraft:smooth_quartz_slab":                     64,
+	"minecraft:granite_slab":                           64,
+	"minecraft:andesite_slab":                          64,
+	"minecraft:red_nether_brick_slab":                  64,
+	"minecraft:polished_andesite_slab":                 64,
+	"minecraft:diorite_slab":                           64,
+	"minecraft:cobbled_deepslate_slab":                 64,
+	"minecraft:polished_deepslate_slab":                64,
+	"minecraft:deepslate_brick_slab":                   64,
+	"minecraft:deepslate_tile_slab":                    64,
+	"minecraft:scaffolding":                            64,
+	"minecraft:redstone":                               64,
+	"minecraft:redstone_torch":                         64,
+	"minecraft:redstone_block":                         64,
+	"minecraft:repeater":                               64,
+	"minecraft:comparator":                             64,
+	"minecraft:piston":                                 64,
+	"minecraft:sticky_piston":                          64,
+	"minecraft:slime_block":                            64,
+	"minecraft:honey_block":                            64,
+	"minecraft:observer":                               64,
+	"minecraft:hopper":                                 64,
+	"minecraft:dispenser":                              64,
+	"minecraft:dropper":                                64,
+	"minecraft:lectern":                                64,
+	"minecraft:target":                                 64,
+	"minecraft:lever":                                  64,
+	"minecraft:lightning_rod":                          64,
+	"minecraft:daylight_detector":                      64,
+	"minecraft:sculk_sensor":                           64,
+	"minecraft:calibrated_sculk_sensor":                64,
+	"minecraft:tripwire_hook":                          64,
+	"minecraft:trapped_chest":                          64,
+	"minecraft:tnt":                                    64,
+	"minecraft:redstone_lamp":                          64,
+	"minecraft:note_block":                             64,
+	"minecraft:stone_button":                           64,
+	"minecraft:polished_blackstone_button":             64,
+	"minecraft:oak_button":                             64,
+	"minecraft:spruce_button":                          64,
+	"minecraft:birch_button":                           64,
+	"minecraft:jungle_button":                          64,
+	"minecraft:acacia_button":                          64,
+	"minecraft:cherry_button":                          64,
+	"minecraft:dark_oak_button":                        64,
+	"minecraft:pale_oak_button":                        64,
+	"minecraft:mangrove_button":                        64,
+	"minecraft:bamboo_button":                          64,
+	"minecraft:crimson_button":                         64,
+	"minecraft:warped_button":                          64,
+	"minecraft:stone_pressure_plate":                   64,
+	"minecraft:polished_blackstone_pressure_plate":     64,
+	"minecraft:light_weighted_pressure_plate":          64,
+	"minecraft:heavy_weighted_pressure_plate":          64,
+	"minecraft:oak_pressure_plate":                     64,
+	"minecraft:spruce_pressure_plate":                  64,
+	"minecraft:birch_pressure_plate":                   64,
+	"minecraft:jungle_pressure_plate":                  64,
+	"minecraft:acacia_pressure_plate":                  64,
+	"minecraft:cherry_pressure_plate":                  64,
+	"minecraft:dark_oak_pressure_plate":                64,
+	"minecraft:pale_oak_pressure_plate":                64,
+	"minecraft:mangrove_pressure_plate":                64,
+	"minecraft:bamboo_pressure_plate":                  64,
+	"minecraft:crimson_pressure_plate":                 64,
+	"minecraft:warped_pressure_plate":                  64,
+	"minecraft:iron_door":                              64,
+	"minecraft:oak_door":                               64,
+	"minecraft:spruce_door":                            64,
+	"minecraft:birch_door":                             64,
+	"minecraft:jungle_door":                            64,
+	"minecraft:acacia_door":                            64,
+	"minecraft:cherry_door":                            64,
+	"minecraft:dark_oak_door":                          64,
+	"minecraft:pale_oak_door":                          64,
+	"minecraft:mangrove_door":                          64,
+	"minecraft:bamboo_door":                            64,
+	"minecraft:crimson_door":                           64,
+	"minecraft:warped_door":                            64,
+	"minecraft:copper_door":                            64,
+	"minecraft:exposed_copper_door":                    64,
+	"minecraft:weathered_copper_door":                  64,
+	"minecraft:oxidized_copper_door":                   64,
+	"minecraft:waxed_copper_door":                      64,
+	"minecraft:waxed_exposed_copper_door":              64,
+	"minecraft:waxed_weathered_copper_door":            64,
+	"minecraft:waxed_oxidized_copper_door":             64,
+	"minecraft:iron_trapdoor":                          64,
+	"minecraft:oak_trapdoor":                           64,
+	"minecraft:spruce_trapdoor":                        64,
+	"minecraft:birch_trapdoor":                         64,
+	"minecraft:jungle_trapdoor":                        64,
+	"minecraft:acacia_trapdoor":                        64,
+	"minecraft:cherry_trapdoor":                        64,
+	"minecraft:dark_oak_trapdoor":                      64,
+	"minecraft:pale_oak_trapdoor":                      64,
+	"minecraft:mangrove_trapdoor":                      64,
+	"minecraft:bamboo_trapdoor":                        64,
+	"minecraft:crimson_trapdoor":                       64,
+	"minecraft:warped_trapdoor":                        64,
+	"minecraft:copper_trapdoor":                        64,
+	"minecraft:exposed_copper_trapdoor":                64,
+	"minecraft:weathered_copper_trapdoor":              64,
+	"minecraft:oxidized_copper_trapdoor":               64,
+	"minecraft:waxed_copper_trapdoor":                  64,
+	"minecraft:waxed_exposed_copper_trapdoor":          64,
+	"minecraft:waxed_weathered_copper_trapdoor":        64,
+	"minecraft:waxed_oxidized_copper_trapdoor":         64,
+	"minecraft:oak_fence_gate":                         64,
+	"minecraft:spruce_fence_gate":                      64,
+	"minecraft:birch_fence_gate":                       64,
+	"minecraft:jungle_fence_gate":                      64,
+	"minecraft:acacia_fence_gate":                      64,
+	"minecraft:cherry_fence_gate":                      64,
+	"minecraft:dark_oak_fence_gate":                    64,
+	"minecraft:pale_oak_fence_gate":                    64,
+	"minecraft:mangrove_fence_gate":                    64,
+	"minecraft:bamboo_fence_gate":                      64,
+	"minecraft:crimson_fence_gate":                     64,
+	"minecraft:warped_fence_gate":                      64,
+	"minecraft:powered_rail":                           64,
+	"minecraft:detector_rail":                          64,
+	"minecraft:rail":                                   64,
+	"minecraft:activator_rail":                         64,
+	"minecraft:saddle":                                 1,
+	"minecraft:white_harness":                          1,
+	"minecraft:orange_harness":                         1,
+	"minecraft:magenta_harness":                        1,
+	"minecraft:light_blue_harness":                     1,
+	"minecraft:yellow_harness":                         1,
+	"minecraft:lime_harness":                           1,
+	"minecraft:pink_harness":                           1,
+	"minecraft:gray_harness":                           1,
+	"minecraft:light_gray_harness":                     1,
+	"minecraft:cyan_harness":                           1,
+	"minecraft:purple_harness":                         1,
+	"minecraft:blue_harness":                           1,
+	"minecraft:brown_harness":                          1,
+	"minecraft:green_harness":                          1,
+	"minecraft:red_harness":                            1,
+	"minecraft:black_harness":                          1,
+	"minecraft:minecart":                               1,
+	"minecraft:chest_minecart":                         1,
+	"minecraft:furnace_minecart":                       1,
+	"minecraft:tnt_minecart":                           1,
+	"minecraft:hopper_minecart":                        1,
+	"minecraft:carrot_on_a_stick":                      1,
+	"minecraft:warped_fungus_on_a_stick":               1,
+	"minecraft:phantom_membrane":                       64,
+	"minecraft:elytra":                                 1,
+	"minecraft:oak_boat":                               1,
+	"minecraft:oak_chest_boat":                         1,
+	"minecraft:spruce_boat":                            1,
+	"minecraft:spruce_chest_boat":                      1,
+	"minecraft:birch_boat":                             1,
+	"minecraft:birch_chest_boat":                       1,
+	"minecraft:jungle_boat":                            1,
+	"minecraft:jungle_chest_boat":                      1,
+	"minecraft:acacia_boat":                            1,
+	"minecraft:acacia_chest_boat":                      1,
+	"minecraft:cherry_boat":                            1,
+	"minecraft:cherry_chest_boat":                      1,
+	"minecraft:dark_oak_boat":                          1,
+	"minecraft:dark_oak_chest_boat":                    1,
+	"minecraft:pale_oak_boat":                          1,
+	"minecraft:pale_oak_chest_boat":                    1,
+	"minecraft:mangrove_boat":                          1,
+	"minecraft:mangrove_chest_boat":                    1,
+	"minecraft:bamboo_raft":                            1,
+	"minecraft:bamboo_chest_raft":                      1,
+	"minecraft:structure_block":                        64,
+	"minecraft:jigsaw":                                 64,
+	"minecraft:test_block":                             64,
+	"minecraft:test_instance_block":                    64,
+	"minecraft:turtle_helmet":                          1,
+	"minecraft:turtle_scute":                           64,
+	"minecraft:armadillo_scute":                        64,
+	"minecraft:wolf_armor":                             1,
+	"minecraft:flint_and_steel":                        1,
+	"minecraft:bowl":                                   64,
+	"minecraft:apple":                                  64,
+	"minecraft:bow":                                    1,
+	"minecraft:arrow":                                  64,
+	"minecraft:coal":                                   64,
+	"minecraft:charcoal":                               64,
+	"minecraft:diamond":                                64,
+	"minecraft:emerald":                                64,
+	"minecraft:lapis_lazuli":                           64,
+	"minecraft:quartz":                                 64,
+	"minecraft:amethyst_shard":                         64,
+	"minecraft:raw_iron":                               64,
+	"minecraft:iron_ingot":                             64,
+	"minecraft:raw_copper":                             64,
+	"minecraft:copper_ingot":                           64,
+	"minecraft:raw_gold":                               64,
+	"minecraft:gold_ingot":                             64,
+	"minecraft:netherite_ingot":                        64,
+	"minecraft:netherite_scrap":                        64,
+	"minecraft:wooden_sword":                           1,
+	"minecraft:wooden_shovel":                          1,
+	"minecraft:wooden_pickaxe":                         1,
+	"minecraft:wooden_axe":                             1,
+	"minecraft:wooden_hoe":                             1,
+	"minecraft:stone_sword":                            1,
+	"minecraft:stone_shovel":                           1,
+	"minecraft:stone_pickaxe":                          1,
+	"minecraft:stone_axe":                              1,
+	"minecraft:stone_hoe":                              1,
+	"minecraft:golden_sword":                           1,
+	"minecraft:golden_shovel":                          1,
+	"minecraft:golden_pickaxe":                         1,
+	"minecraft:golden_axe":                             1,
+	"minecraft:golden_hoe":                             1,
+	"minecraft:iron_sword":                             1,
+	"minecraft:iron_shovel":                            1,
+	"minecraft:iron_pickaxe":                           1,
+	"minecraft:iron_axe":                               1,
+	"minecraft:iron_hoe":                               1,
+	"minecraft:diamond_sword":                          1,
+	"minecraft:diamond_shovel":                         1,
+	"minecraft:diamond_pickaxe":                        1,
+	"minecraft:diamond_axe":                            1,
+	"minecraft:diamond_hoe":                            1,
+	"minecraft:netherite_sword":                        1,
+	"minecraft:netherite_shovel":                       1,
+	"minecraft:netherite_pickaxe":                      1,
+	"minecraft:netherite_axe":                          1,
+	"minecraft:netherite_hoe":                          1,
+	"minecraft:stick":                                  64,
+	"minecraft:mushroom_stew":                          1,
+	"minecraft:string":                                 64,
+	"minecraft:feather":                                64,
+	"minecraft:gunpowder":                              64,
+	"minecraft:wheat_seeds":                            64,
+	"minecraft:wheat":                                  64,
+	"minecraft:bread":                                  64,
+	"minecraft:leather_helmet":                         1,
+	"minecraft:leather_chestplate":                     1,
+	"minecraft:leather_leggings":                       1,
+	"minecraft:leather_boots":                          1,
+	"minecraft:chainmail_helmet":                       1,
+	"minecraft:chainmail_chestplate":                   1,
+	"minecraft:chainmail_leggings":                     1,
+	"minecraft:chainmail_boots":                        1,
+	"minecraft:iron_helmet":                            1,
+	"minecraft:iron_chestplate":                        1,
+	"minecraft:iron_leggings":                          1,
+	"minecraft:iron_boots":                             1,
+	"minecraft:diamond_helmet":                         1,
+	"minecraft:diamond_chestplate":                     1,
+	"minecraft:diamond_leggings":                       1,
+	"minecraft:diamond_boots":                          1,
+	"minecraft:golden_helmet":                          1,
+	"minecraft:golden_chestplate":                      1,
+	"minecraft:golden_leggings":                        1,
+	"minecraft:golden_boots":                           1,
+	"minecraft:netherite_helmet":                       1,
+	"minecraft:netherite_chestplate":                   1,
+	"minecraft:netherite_leggings":                     1,
+	"minecraft:netherite_boots":                        1,
+	"minecraft:flint":                                  64,
+	"minecraft:porkchop":                               64,
+	"minecraft:cooked_porkchop":                        64,
+	"minecraft:painting":                               64,
+	"minecraft:golden_apple":                           64,
+	"minecraft:enchanted_golden_apple":                 64,
+	"minecraft:oak_sign":                               16,
+	"minecraft:spruce_sign":                            16,
+	"minecraft:birch_sign":                             16,
+	"minecraft:jungle_sign":                            16,
+	"minecraft:acacia_sign":                            16,
+	"minecraft:cherry_sign":                            16,
+	"minecraft:dark_oak_sign":                          16,
+	"minecraft:pale_oak_sign":                          16,
+	"minecraft:mangrove_sign":                          16,
+	"minecraft:bamboo_sign":                            16,
+	"minecraft:crimson_sign":                           16,
+	"minecraft:warped_sign":                            16,
+	"minecraft:oak_hanging_sign":                       16,
+	"minecraft:spruce_hanging_sign":                    16,
+	"minecraft:birch_hanging_sign":                     16,
+	"minecraft:jungle_hanging_sign":                    16,
+	"minecraft:acacia_hanging_sign":                    16,
+	"minecraft:cherry_hanging_sign":                    16,
+	"minecraft:dark_oak_hanging_sign":                  16,
+	"minecraft:pale_oak_hanging_sign":                  16,
+	"minecraft:mangrove_hanging_sign":                  16,
+	"minecraft:bamboo_hanging_sign":                    16,
+	"minecraft:crimson_hanging_sign":                   16,
+	"minecraft:warped_hanging_sign":                    16,
+	"minecraft:bucket":                                 16,
+	"minecraft:water_bucket":                           1,
+	"minecraft:lava_bucket":                            1,
+	"minecraft:powder_snow_bucket":                     1,
+	"minecraft:snowball":                               16,
+	"minecraft:leather":                                64,
+	"minecraft:milk_bucket":                            1,
+	"minecraft:pufferfish_bucket":                      1,
+	"minecraft:salmon_bucket":                          1,
+	"minecraft:cod_bucket":                             1,
+	"minecraft:tropical_fish_bucket":                   1,
+	"minecraft:axolotl_bucket":                         1,
+	"minecraft:tadpole_bucket":                         1,
+	"minecraft:brick":                                  64,
+	"minecraft:clay_ball":                              64,
+	"minecraft:dried_kelp_block":                       64,
+	"minecraft:paper":                                  64,
+	"minecraft:book":                                   64,
+	"minecraft:slime_ball":                             64,
+	"minecraft:egg":                                    16,
+	"minecraft:blue_egg":                               16,
+	"minecraft:brown_egg":                              16,
+	"minecraft:compass":                                64,
+	"minecraft:recovery_compass":                       64,
+	"minecraft:bundle":                                 1,
+	"minecraft:white_bundle":                           1,
+	"minecraft:orange_bundle":                          1,
+	"minecraft:magenta_bundle":                         1,
+	"minecraft:light_blue_bundle":                      1,
+	"minecraft:yellow_bundle":                          1,
+	"minecraft:lime_bundle":                            1,
+	"minecraft:pink_bundle":                            1,
+	"minecraft:gray_bundle":                            1,
+	"minecraft:light_gray_bundle":                      1,
+	"minecraft:cyan_bundle":                            1,
+	"minecraft:purple_bundle":                          1,
+	"minecraft:blue_bundle":                            1,
+	"minecraft:brown_bundle":                           1,
+	"minecraft:green_bundle":                           1,
+	"minecraft:red_bundle":                             1,
+	"minecraft:black_bundle":                           1,
+	"minecraft:fishing_rod":                            1,
+	"minecraft:clock":                                  64,
+	"minecraft:spyglass":                               1,
+	"minecraft:glowstone_dust":                         64,
+	"minecraft:cod":                                    64,
+	"minecraft:salmon":                                 64,
+	"minecraft:tropical_fish":                          64,
+	"minecraft:pufferfish":                             64,
+	"minecraft:cooked_cod":                             64,
+	"minecraft:cooked_salmon":                          64,
+	"minecraft:ink_sac":                                64,
+	"minecraft:glow_ink_sac":                           64,
+	"minecraft:cocoa_beans":                            64,
+	"minecraft:white_dye":                              64,
+	"minecraft:orange_dye":                             64,
+	"minecraft:magenta_dye":                            64,
+	"minecraft:light_blue_dye":                         64,
+	"minecraft:yellow_dye":                             64,
+	"minecraft:lime_dye":                               64,
+	"minecraft:pink_dye":                               64,
+	"minecraft:gray_dye":                               64,
+	"minecraft:light_gray_dye":                         64,
+	"minecraft:cyan_dye":                               64,
+	"minecraft:purple_dye":                             64,
+	"minecraft:blue_dye":                               64,
+	"minecraft:brown_dye":                              64,
+	"minecraft:green_dye":                              64,
+	"minecraft:red_dye":                                64,
+	"minecraft:black_dye":                              64,
+	"minecraft:bone_meal":                              64,
+	"minecraft:bone":                                   64,
+	"minecraft:sugar":                                  64,
+	"minecraft:cake":                                   1,
+	"minecraft:white_bed":                              1,
+	"minecraft:orange_bed":                             1,
+	"minecraft:magenta_bed":                            1,
+	"minecraft:light_blue_bed":                         1,
+	"minecraft:yellow_bed":                             1,
+	"minecraft:lime_bed":                               1,
+	"minecraft:pink_bed":                               1,
+	"minecraft:gray_bed":                               1,
+	"minecraft:light_gray_bed":                         1,
+	"minecraft:cyan_bed":                               1,
+	"minecraft:purple_bed":                             1,
+	"minecraft:blue_bed":                               1,
+	"minecraft:brown_bed":                              1,
+	"minecraft:green_bed":                              1,
+	"minecraft:red_bed":                                1,
+	"minecraft:black_bed":                              1,
+	"minecraft:cookie":                                 64,
+	"minecraft:crafter":                                64,
+	"minecraft:filled_map":                             64,
+	"minecraft:shears":                                 1,
+	"minecraft:melon_slice":                            64,
+	"minecraft:dried_kelp":                             64,
+	"minecraft:pumpkin_seeds":                          64,
+	"minecraft:melon_seeds":                            64,
+	"minecraft:beef":                                   64,
+	"minecraft:cooked_beef":                            64,
+	"minecraft:chicken":                                64,
+	"minecraft:cooked_chicken":                         64,
+	"minecraft:rotten_flesh":                           64,
+	"minecraft:ender_pearl":                            16,
+	"minecraft:blaze_rod":                              64,
+	"minecraft:ghast_tear":                             64,
+	"minecraft:gold_nugget":                            64,
+	"minecraft:nether_wart":                            64,
+	"minecraft:glass_bottle":                           64,
+	"minecraft:potion":                                 1,
+	"minecraft:spider_eye":                             64,
+	"minecraft:fermented_spider_eye":                   64,
+	"minecraft:blaze_powder":                           64,
+	"minecraft:magma_cream":                            64,
+	"minecraft:brewing_stand":                          64,
+	"minecraft:cauldron":                               64,
+	"minecraft:ender_eye":                              64,
+	"minecraft:glistering_melon_slice":                 64,
+	"minecraft:armadillo_spawn_egg":                    64,
+	"minecraft:allay_spawn_egg":                        64,
+	"minecraft:axolotl_spawn_egg":                      64,
+	"minecraft:bat_spawn_egg":                          64,
+	"minecraft:bee_spawn_egg":                          64,
+	"minecraft:blaze_spawn_egg":                        64,
+	"minecraft:bogged_spawn_egg":                       64,
+	"minecraft:breeze_spawn_egg":                       64,
+	"minecraft:cat_spawn_egg":                          64,
+	"minecraft:camel_spawn_egg":                        64,
+	"minecraft:cave_spider_spawn_egg":                  64,
+	"minecraft:chicken_spawn_egg":                      64,
+	"minecraft:cod_spawn_egg":                          64,
+	"minecraft:cow_spawn_egg":                          64,
+	"minecraft:creeper_spawn_egg":                      64,
+	"minecraft:dolphin_spawn_egg":                      64,
+	"minecraft:donkey_spawn_egg":                       64,
+	"minecraft:drowned_spawn_egg":                      64,
+	"minecraft:elder_guardian_spawn_egg":               64,
+	"minecraft:ender_dragon_spawn_egg":                 64,
+	"minecraft:enderman_spawn_egg":                     64,
+	"minecraft:endermite_spawn_egg":                    64,
+	"minecraft:evoker_spawn_egg":                       64,
+	"minecraft:fox_spawn_egg":                          64,
+	"minecraft:frog_spawn_egg":                         64,
+	"minecraft:ghast_spawn_egg":                        64,
+	"minecraft:happy_ghast_spawn_egg":                  64,
+	"minecraft:glow_squid_spawn_egg":                   64,
+	"minecraft:goat_spawn_egg":                         64,
+	"minecraft:guardian_spawn_egg":                     64,
+	"minecraft:hoglin_spawn_egg":                       64,
+	"minecraft:horse_spawn_egg":                        64,
+	"minecraft:husk_spawn_egg":                         64,
+	"minecraft:iron_golem_spawn_egg":                   64,
+	"minecraft:llama_spawn_egg":                        64,
+	"minecraft:magma_cube_spawn_egg":                   64,
+	"minecraft:mooshroom_spawn_egg":                    64,
+	"minecraft:mule_spawn_egg":                         64,
+	"minecraft:ocelot_spawn_egg":                       64,
+	"minecraft:panda_spawn_egg":                        64,
+	"minecraft:parrot_spawn_egg":                       64,
+	"minecraft:phantom_spawn_egg":                      64,
+	"minecraft:pig_spawn_egg":                          64,
+	"minecraft:piglin_spawn_egg":                       64,
+	"minecraft:piglin_brute_spawn_egg":                 64,
+	"minecraft:pillager_spawn_egg":                     64,
+	"minecraft:polar_bear_spawn_egg":                   64,
+	"minecraft:pufferfish_spawn_egg":                   64,
+	"minecraft:rabbit_spawn_egg":                       64,
+	"minecraft:ravager_spawn_egg":                      64,
+	"minecraft:salmon_spawn_egg":                       64,
+	"minecraft:sheep_spawn_egg":                        64,
+	"minecraft:shulker_spawn_egg":                      64,
+	"minecraft:silverfish_spawn_egg":                   64,
+	"minecraft:skeleton_spawn_egg":                     64,
+	"minecraft:skeleton_horse_spawn_egg":               64,
+	"minecraft:slime_spawn_egg":                        64,
+	"minecraft:sniffer_spawn_egg":                      64,
+	"minecraft:snow_golem_spawn_egg":                   64,
+	"minecraft:spider_spawn_egg":                       64,
+	"minecraft:squid_spawn_egg":                        64,
+	"minecraft:stray_spawn_egg":                        64,
+	"minecraft:strider_spawn_egg":                      64,
+	"minecraft:tadpole_spawn_egg":                      64,
+	"minecraft:trader_llama_spawn_egg":                 64,
+	"minecraft:tropical_fish_spawn_egg":                64,
+	"minecraft:turtle_spawn_egg":                       64,
+	"minecraft:vex_spawn_egg":                          64,
+	"minecraft:villager_spawn_egg":                     64,
+	"minecraft:vindicator_spawn_egg":                   64,
+	"minecraft:wandering_trader_spawn_egg":             64,
+	"minecraft:warden_spawn_egg":                       64,
+	"minecraft:witch_spawn_egg":                        64,
+	"minecraft:wither_spawn_egg":                       64,
+	"minecraft:wither_skeleton_spawn_egg":              64,
+	"minecraft:wolf_spawn_egg":                         64,
+	"minecraft:zoglin_spawn_egg":                       64,
+	"minecraft:creaking_spawn_egg":                     64,
+	"minecraft:zombie_spawn_egg":                       64,
+	"minecraft:zombie_horse_spawn_egg":                 64,
+	"minecraft:zombie_villager_spawn_egg":              64,
+	"minecraft:zombified_piglin_spawn_egg":             64,
+	"minecraft:experience_bottle":                      64,
+	"minecraft:fire_charge":                            64,
+	"minecraft:wind_charge":                            64,
+	"minecraft:writable_book":                          1,
+	"minecraft:written_book":                           16,
+	"minecraft:breeze_rod":                             64,
+	"minecraft:mace":                                   1,
+	"minecraft:item_frame":                             64,
+	"minecraft:glow_item_frame":                        64,
+	"minecraft:flower_pot":                             64,
+	"minecraft:carrot":                                 64,
+	"minecraft:potato":                                 64,
+	"minecraft:baked_potato":                           64,
+	"minecraft:poisonous_potato":                       64,
+	"minecraft:map":                                    64,
+	"minecraft:golden_carrot":                          64,
+	"minecraft:skeleton_skull":                         64,
+	"minecraft:wither_skeleton_skull":                  64,
+	"minecraft:player_head":                            64,
+	"minecraft:zombie_head":                            64,
+	"minecraft:creeper_head":                           64,
+	"minecraft:dragon_head":                            64,
+	"minecraft:piglin_head":                            64,
+	"minecraft:nether_star":                            64,
+	"minecraft:pumpkin_pie":                            64,
+	"minecraft:firework_rocket":                        64,
+	"minecraft:firework_star":                          64,
+	"minecraft:enchanted_book":                         1,
+	"minecraft:nether_brick":                           64,
+	"minecraft:resin_brick":                            64,
+	"minecraft:prismarine_shard":                       64,
+	"minecraft:prismarine_crystals":                    64,
+	"minecraft:rabbit":                                 64,
+	"minecraft:cooked_rabbit":                          64,
+	"minecraft:rabbit_stew":                            1,
+	"minecraft:rabbit_foot":                            64,
+	"minecraft:rabbit_hide":                            64,
+	"minecraft:armor_stand":                            16,
+	"minecraft:iron_horse_armor":                       1,
+	"minecraft:golden_horse_armor":                     1,
+	"minecraft:diamond_horse_armor":                    1,
+	"minecraft:leather_horse_armor":                    1,
+	"minecraft:lead":                                   64,
+	"minecraft:name_tag":                               64,
+	"minecraft:command_block_minecart":                 1,
+	"minecraft:mutton":                                 64,
+	"minecraft:cooked_mutton":                          64,
+	"minecraft:white_banner":                           16,
+	"minecraft:orange_banner":                          16,
+	"minecraft:magenta_banner":                         16,
+	"minecraft:light_blue_banner":                      16,
+	"minecraft:yellow_banner":                          16,
+	"minecraft:lime_banner":                            16,
+	"minecraft:pink_banner":                            16,
+	"minecraft:gray_banner":                            16,
+	"minecraft:light_gray_banner":                      16,
+	"minecraft:cyan_banner":                            16,
+	"minecraft:purple_banner":                          16,
+	"minecraft:blue_banner":                            16,
+	"minecraft:brown_banner":                           16,
+	"minecraft:green_banner":                           16,
+	"minecraft:red_banner":                             16,
+	"minecraft:black_banner":                           16,
+	"minecraft:end_crystal":                            64,
+	"minecraft:chorus_fruit":                           64,
+	"minecraft:popped_chorus_fruit":                    64,
+	"minecraft:torchflower_seeds":                      64,
+	"minecraft:pitcher_pod":                            64,
+	"minecraft:beetroot":                               64,
+	"minecraft:beetroot_seeds":                         64,
+	"minecraft:beetroot_soup":                          1,
+	"minecraft:dragon_breath":                          64,
+	"minecraft:splash_potion":                          1,
+	"minecraft:spectral_arrow":                         64,
+	"minecraft:tipped_arrow":                           64,
+	"minecraft:lingering_potion":                       1,
+	"minecraft:shield":                                 1,
+	"minecraft:totem_of_undying":                       1,
+	"minecraft:shulker_shell":                          64,
+	"minecraft:iron_nugget":                            64,
+	"minecraft:knowledge_book":                         1,
+	"minecraft:debug_stick":                            1,
+	"minecraft:music_disc_13":                          1,
+	"minecraft:music_disc_cat":                         1,
+	"minecraft:music_disc_blocks":                      1,
+	"minecraft:music_disc_chirp":                       1,
+	"minecraft:music_disc_creator":                     1,
+	"minecraft:music_disc_creator_music_box":           1,
+	"minecraft:music_disc_far":                         1,
+	"minecraft:music_disc_lava_chicken":                1,
+	"minecraft:music_disc_mall":                        1,
+	"minecraft:music_disc_mellohi":                     1,
+	"minecraft:music_disc_stal":                        1,
+	"minecraft:music_disc_strad":                       1,
+	"minecraft:music_disc_ward":                        1,
+	"minecraft:music_disc_11":                          1,
+	"minecraft:music_disc_wait":                        1,
+	"minecraft:music_disc_otherside":                   1,
+	"minecraft:music_disc_relic":                       1,
+	"minecraft:music_disc_5":                           1,
+	"minecraft:music_disc_pigstep":                     1,
+	"minecraft:music_disc_precipice":                   1,
+	"minecraft:music_disc_tears":                       1,
+	"minecraft:disc_fragment_5":                        64,
+	"minecraft:trident":                                1,
+	"minecraft:nautilus_shell":                         64,
+	"minecraft:heart_of_the_sea":                       64,
+	"minecraft:crossbow":                               1,
+	"minecraft:suspicious_stew":                        1,
+	"minecraft:loom":                                   64,
+	"minecraft:flower_banner_pattern":                  1,
+	"minecraft:creeper_banner_pattern":                 1,
+	"minecraft:skull_banner_pattern":                   1,
+	"minecraft:mojang_banner_pattern":                  1,
+	"minecraft:globe_banner_pattern":                   1,
+	"minecraft:piglin_banner_pattern":                  1,
+	"minecraft:flow_banner_pattern":                    1,
+	"minecraft:guster_banner_pattern":                  1,
+	"minecraft:field_masoned_banner_pattern":           1,
+	"minecraft:bordure_indented_banner_pattern":        1,
+	"minecraft:goat_horn":                              1,
+	"minecraft:composter":                              64,
+	"minecraft:barrel":                                 64,
+	"minecraft:smoker":                                 64,
+	"minecraft:blast_furnace":                          64,
+	"minecraft:cartography_table":                      64,
+	"minecraft:fletching_table":                        64,
+	"minecraft:grindstone":                             64,
+	"minecraft:smithing_table":                         64,
+	"minecraft:stonecutter":                            64,
+	"minecraft:bell":                                   64,
+	"minecraft:lantern":                                64,
+	"minecraft:soul_lantern":                           64,
+	"minecraft:sweet_berries":                          64,
+	"minecraft:glow_berries":                           64,
+	"minecraft:campfire":                               64,
+	"minecraft:soul_campfire":                          64,
+	"minecraft:shroomlight":                            64,
+	"minecraft:honeycomb":                              64,
+	"minecraft:bee_nest":                               64,
+	"minecraft:beehive":                                64,
+	"minecraft:honey_bottle":                           16,
+	"minecraft:honeycomb_block":                        64,
+	"minecraft:lodestone":                              64,
+	"minecraft:crying_obsidian":                        64,
+	"minecraft:blackstone":                             64,
+	"minecraft:blackstone_slab":                        64,
+	"minecraft:blackstone_stairs":                      64,
+	"minecraft:gilded_blackstone":                      64,
+	"minecraft:polished_blackstone":                    64,
+	"minecraft:polished_blackstone_slab":               64,
+	"minecraft:polished_blackstone_stairs":             64,
+	"minecraft:chiseled_polished_blackstone":           64,
+	"minecraft:polished_blackstone_bricks":             64,
+	"minecraft:polished_blackstone_brick_slab":         64,
+	"minecraft:polished_blackstone_brick_stairs":       64,
+	"minecraft:cracked_polished_blackstone_bricks":     64,
+	"minecraft:respawn_anchor":                         64,
+	"minecraft:candle":                                 64,
+	"minecraft:white_candle":                           64,
+	"minecraft:orange_candle":                          64,
+	"minecraft:magenta_candle":                         64,
+	"minecraft:light_blue_candle":                      64,
+	"minecraft:yellow_candle":                          64,
+	"minecraft:lime_candle":                            64,
+	"minecraft:pink_candle":                            64,
+	"minecraft:gray_candle":                            64,
+	"minecraft:light_gray_candle":                      64,
+	"minecraft:cyan_candle":                            64,
+	"minecraft:purple_candle":                          64,
+	"minecraft:blue_candle":                            64,
+	"minecraft:brown_candle":                           64,
+	"minecraft:green_candle":                           64,
+	"minecraft:red_candle":                             64,
+	"minecraft:black_candle":                           64,
+	"minecraft:small_amethyst_bud":                     64,
+	"minecraft:medium_amethyst_bud":                    64,
+	"minecraft:large_amethyst_bud":                     64,
+	"minecraft:amethyst_cluster":                       64,
+	"minecraft:pointed_dripstone":                      64,
+	"minecraft:ochre_froglight":                        64,
+	"minecraft:verdant_froglight":                      64,
+	"minecraft:pearlescent_froglight":                  64,
+	"minecraft:frogspawn":                              64,
+	"minecraft:echo_shard":                             64,
+	"minecraft:brush":                                  1,
+	"minecraft:netherite_upgrade_smithing_template":    64,
+	"minecraft:sentry_armor_trim_smithing_template":    64,
+	"minecraft:dune_armor_trim_smithing_template":      64,
+	"minecraft:coast_armor_trim_smithing_template":     64,
+	"minecraft:wild_armor_trim_smithing_template":      64,
+	"minecraft:ward_armor_trim_smithing_template":      64,
+	"minecraft:eye_armor_trim_smithing_template":       64,
+	"minecraft:vex_armor_trim_smithing_template":       64,
+	"minecraft:tide_armor_trim_smithing_template":      64,
+	"minecraft:snout_armor_trim_smithing_template":     64,
+	"minecraft:rib_armor_trim_smithing_template":       64,
+	"minecraft:spire_armor_trim_smithing_template":     64,
+	"minecraft:wayfinder_armor_trim_smithing_template": 64,
+	"minecraft:shaper_armor_trim_smithing_template":    64,
+	"minecraft:silence_armor_trim_smithing_template":   64,
+	"minecraft:raiser_armor_trim_smithing_template":    64,
+	"minecraft:host_armor_trim_smithing_template":      64,
+	"minecraft:flow_armor_trim_smithing_template":      64,
+	"minecraft:bolt_armor_trim_smithing_template":      64,
+	"minecraft:angler_pottery_sherd":                   64,
+	"minecraft:archer_pottery_sherd":                   64,
+	"minecraft:arms_up_pottery_sherd":                  64,
+	"minecraft:blade_pottery_sherd":                    64,
+	"minecraft:brewer_pottery_sherd":                   64,
+	"minecraft:burn_pottery_sherd":                     64,
+	"minecraft:danger_pottery_sherd":                   64,
+	"minecraft:explorer_pottery_sherd":                 64,
+	"minecraft:flow_pottery_sherd":                     64,
+	"minecraft:friend_pottery_sherd":                   64,
+	"minecraft:guster_pottery_sherd":                   64,
+	"minecraft:heart_pottery_sherd":                    64,
+	"minecraft:heartbreak_pottery_sherd":               64,
+	"minecraft:howl_pottery_sherd":                     64,
+	"minecraft:miner_pottery_sherd":                    64,
+	"minecraft:mourner_pottery_sherd":                  64,
+	"minecraft:plenty_pottery_sherd":                   64,
+	"minecraft:prize_pottery_sherd":                    64,
+	"minecraft:scrape_pottery_sherd":                   64,
+	"minecraft:sheaf_pottery_sherd":                    64,
+	"minecraft:shelter_pottery_sherd":                  64,
+	"minecraft:skull_pottery_sherd":                    64,
+	"minecraft:snort_pottery_sherd":                    64,
+	"minecraft:copper_grate":                           64,
+	"minecraft:exposed_copper_grate":                   64,
+	"minecraft:weathered_copper_grate":                 64,
+	"minecraft:oxidized_copper_grate":                  64,
+	"minecraft:waxed_copper_grate":                     64,
+	"minecraft:waxed_exposed_copper_grate":             64,
+	"minecraft:waxed_weathered_copper_grate":           64,
+	"minecraft:waxed_oxidized_copper_grate":            64,
+	"minecraft:copper_bulb":                            64,
+	"minecraft:exposed_copper_bulb":                    64,
+	"minecraft:weathered_copper_bulb":                  64,
+	"minecraft:oxidized_copper_bulb":                   64,
+	"minecraft:waxed_copper_bulb":                      64,
+	"minecraft:waxed_exposed_copper_bulb":              64,
+	"minecraft:waxed_weathered_copper_bulb":            64,
+	"minecraft:waxed_oxidized_copper_bulb":             64,
+	"minecraft:trial_spawner":                          64,
+	"minecraft:trial_key":                              64,
+	"minecraft:ominous_trial_key":                      64,
+	"minecraft:vault":                                  64,
+	"minecraft:ominous_bottle":                         64,
+}
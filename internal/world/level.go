@@ -0,0 +1,106 @@
+package world
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/beito123/nbt"
+)
+
+// Level is the metadata kept in the level.dat of a world folder.
+type Level struct {
+	Name        string
+	DataVersion int
+	Seed        int64
+	SpawnX      int
+	SpawnY      int
+	SpawnZ      int
+	Time        int64
+	DayTime     int64
+}
+
+func ReadLevel(dir string) (Level, error) {
+	stream, err := nbtFromFile(filepath.Join(dir, "level.dat"))
+	if err != nil {
+		return Level{}, err
+	}
+
+	root, err := stream.ReadTag()
+	if err != nil {
+		return Level{}, err
+	}
+
+	return levelFromNBT(compoundOf(root, "Data")), nil
+}
+
+func levelFromNBT(data nbt.Tag) Level {
+	return Level{
+		Name:        stringOf(data, "LevelName"),
+		DataVersion: intOf(data, "DataVersion"),
+		Seed:        longOf(compoundOf(data, "WorldGenSettings"), "seed"),
+		SpawnX:      intOf(data, "SpawnX"),
+		SpawnY:      intOf(data, "SpawnY"),
+		SpawnZ:      intOf(data, "SpawnZ"),
+		Time:        longOf(data, "Time"),
+		DayTime:     longOf(data, "DayTime"),
+	}
+}
+
+// encode writes the level into data, the Data compound of a level.dat,
+// keeping the tags it doesn't know about.
+func (self Level) encode(data map[string]nbt.Tag) {
+	settings := make(map[string]nbt.Tag)
+	if c, ok := data["WorldGenSettings"].(*nbt.Compound); ok {
+		for k, v := range c.Value {
+			settings[k] = v
+		}
+	}
+
+	settings["seed"] = nbt.NewLongTag("seed", self.Seed)
+
+	data["LevelName"] = nbt.NewStringTag("LevelName", self.Name)
+	data["DataVersion"] = nbt.NewIntTag("DataVersion", int32(self.DataVersion))
+	data["WorldGenSettings"] = nbt.NewCompoundTag("WorldGenSettings", settings)
+	data["SpawnX"] = nbt.NewIntTag("SpawnX", int32(self.SpawnX))
+	data["SpawnY"] = nbt.NewIntTag("SpawnY", int32(self.SpawnY))
+	data["SpawnZ"] = nbt.NewIntTag("SpawnZ", int32(self.SpawnZ))
+	data["Time"] = nbt.NewLongTag("Time", self.Time)
+	data["DayTime"] = nbt.NewLongTag("DayTime", self.DayTime)
+}
+
+// WriteLevel updates the level.dat of a world folder, creating it when
+// missing.
+func WriteLevel(dir string, level Level) error {
+	path := filepath.Join(dir, "level.dat")
+	data := make(map[string]nbt.Tag)
+
+	stream, err := nbtFromFile(path)
+	if err == nil {
+		root, err := stream.ReadTag()
+		if err != nil {
+			return err
+		}
+
+		if c, ok := compoundOf(root, "Data").(*nbt.Compound); ok {
+			for k, v := range c.Value {
+				data[k] = v
+			}
+		}
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	level.encode(data)
+
+	root := nbt.NewCompoundTag("", map[string]nbt.Tag{
+		"Data": nbt.NewCompoundTag("Data", data),
+	})
+
+	bytes, err := writeGzipNBT(root)
+	if err != nil {
+		return err
+	}
+
+	return writeFileAtomic(path, bytes)
+}
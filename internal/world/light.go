@@ -0,0 +1,568 @@
+package world
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const MaxLight = 15
+
+type lightKind int
+
+const (
+	skyLight lightKind = iota
+	blockLight
+)
+
+// Light of the lit states of the blocks that aren't lit by default, the
+// generated defaultLight holding the light of the default states
+var litEmission = map[string]uint8{
+	"minecraft:furnace":                     13,
+	"minecraft:smoker":                      13,
+	"minecraft:blast_furnace":               13,
+	"minecraft:redstone_lamp":               15,
+	"minecraft:redstone_ore":                9,
+	"minecraft:deepslate_redstone_ore":      9,
+	"minecraft:copper_bulb":                 15,
+	"minecraft:waxed_copper_bulb":           15,
+	"minecraft:exposed_copper_bulb":         12,
+	"minecraft:waxed_exposed_copper_bulb":   12,
+	"minecraft:weathered_copper_bulb":       8,
+	"minecraft:waxed_weathered_copper_bulb": 8,
+	"minecraft:oxidized_copper_bulb":        4,
+	"minecraft:waxed_oxidized_copper_bulb":  4,
+}
+
+type lightProperties struct {
+	emission uint8
+	opacity  uint8
+}
+
+var lightTable = struct {
+	once   sync.Once
+	states []lightProperties
+	// foreign holds the properties of the foreign states
+	foreign sync.Map
+}{}
+
+// lightOf returns how much light a block state emits and absorbs. Blocks
+// that aren't known to let the light through are opaque.
+func lightOf(state uint16) lightProperties {
+	lightTable.once.Do(func() {
+		lightTable.states = make([]lightProperties, len(states))
+		for id := range states {
+			lightTable.states[id] = lightOfState(uint16(id))
+		}
+	})
+
+	if int(state) < len(lightTable.states) {
+		return lightTable.states[state]
+	}
+
+	if cached, ok := lightTable.foreign.Load(state); ok {
+		return cached.(lightProperties)
+	}
+
+	props := lightOfState(state)
+	lightTable.foreign.Store(state, props)
+
+	return props
+}
+
+func lightOfState(state uint16) lightProperties {
+	s, ok := State(state)
+	if !ok {
+		return lightProperties{opacity: MaxLight}
+	}
+
+	props, ok := defaultLight[s.Name]
+	if !ok {
+		props.opacity = MaxLight
+	}
+
+	props.emission = stateEmission(s, props.emission)
+
+	// A double slab is a full block
+	if s.Properties["type"] == "double" && strings.HasSuffix(s.Name, "_slab") {
+		props.opacity = MaxLight
+	}
+
+	if s.Properties["waterlogged"] == "true" {
+		props.opacity = max(props.opacity, 1)
+	}
+
+	return props
+}
+
+// stateEmission returns the light a state emits, from the light of the
+// default state of its block.
+func stateEmission(s BlockState, emission uint8) uint8 {
+	p := s.Properties
+
+	switch {
+	case p["lit"] == "false":
+		return 0
+	case p["lit"] == "true" && litEmission[s.Name] > 0:
+		return litEmission[s.Name]
+	case p["lit"] == "true" && strings.HasSuffix(s.Name, "candle"):
+		candles, _ := strconv.Atoi(p["candles"])
+		return uint8(3 * candles)
+	case p["lit"] == "true" && strings.HasSuffix(s.Name, "candle_cake"):
+		return 3
+	}
+
+	switch s.Name {
+	case "minecraft:light":
+		level, _ := strconv.Atoi(p["level"])
+		return uint8(level)
+	case "minecraft:sea_pickle":
+		if p["waterlogged"] != "true" {
+			return 0
+		}
+
+		pickles, _ := strconv.Atoi(p["pickles"])
+		return uint8(3 + 3*pickles)
+	case "minecraft:respawn_anchor":
+		charges, _ := strconv.Atoi(p["charges"])
+		return uint8(charges * MaxLight / 4)
+	case "minecraft:cave_vines", "minecraft:cave_vines_plant":
+		if p["berries"] == "true" {
+			return 14
+		}
+	case "minecraft:glow_lichen":
+		for _, face := range []string{"down", "up", "north", "south", "west", "east"} {
+			if p[face] == "true" {
+				return 7
+			}
+		}
+	case "minecraft:vault":
+		if p["vault_state"] == "active" || p["vault_state"] == "ejecting" {
+			return 12
+		}
+	}
+
+	return emission
+}
+
+func (self *Section) lightArray(kind lightKind) *[]byte {
+	if kind == skyLight {
+		return &self.SkyLight
+	}
+
+	return &self.BlockLight
+}
+
+// hasLight reports whether the light of every section is known.
+func (self *Chunk) hasLight() bool {
+	for _, s := range self.Sections {
+		if s.SkyLight == nil || s.BlockLight == nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// light returns the light level of a kind at x, y, z, the coordinates
+// being relative to the chunk horizontally. The sky is fully lit above the
+// chunk and the void is dark below it.
+func (self *Chunk) light(kind lightKind, x int, y int, z int) uint8 {
+	s, ly := self.section(y)
+	if s == nil {
+		if kind == skyLight && y >= self.MinY+self.Height() {
+			return MaxLight
+		}
+
+		return 0
+	}
+
+	light := *s.lightArray(kind)
+	if light == nil {
+		return 0
+	}
+
+	i := blockIndex(x&15, ly, z&15)
+	return (light[i>>1] >> ((i & 1) * 4)) & 0x0F
+}
+
+func (self *Chunk) setLight(kind lightKind, x int, y int, z int, level uint8) {
+	s, ly := self.section(y)
+	if s == nil {
+		return
+	}
+
+	light := s.lightArray(kind)
+	if *light == nil {
+		*light = make([]byte, LightLength)
+	}
+
+	i := blockIndex(x&15, ly, z&15)
+	shift := (i & 1) * 4
+	(*light)[i>>1] = (*light)[i>>1]&^(0x0F<<shift) | level<<shift
+}
+
+func (self *Chunk) SkyLight(x int, y int, z int) uint8 {
+	return self.light(skyLight, x, y, z)
+}
+
+func (self *Chunk) BlockLight(x int, y int, z int) uint8 {
+	return self.light(blockLight, x, y, z)
+}
+
+type blockPos struct {
+	x, y, z int
+}
+
+var directions = []blockPos{
+	{1, 0, 0}, {-1, 0, 0}, {0, 1, 0}, {0, -1, 0}, {0, 0, 1}, {0, 0, -1},
+}
+
+type lightRemoval struct {
+	pos   blockPos
+	level uint8
+}
+
+// lighting propagates the light in the chunks loaded in the world,
+// keeping track of the chunks it changed. The world light lock must be
+// held.
+type lighting struct {
+	world   *World
+	kind    lightKind
+	changed map[[2]int32]bool
+	chunks  map[[2]int32]*Chunk
+	last    *Chunk
+
+	increase []blockPos
+	decrease []lightRemoval
+}
+
+func (self *World) newLighting(kind lightKind, changed map[[2]int32]bool) *lighting {
+	return &lighting{
+		world:   self,
+		kind:    kind,
+		changed: changed,
+		chunks:  make(map[[2]int32]*Chunk),
+	}
+}
+
+// chunk returns the loaded chunk holding a block, without loading or
+// generating any.
+func (self *lighting) chunk(x int, z int) *Chunk {
+	key := [2]int32{int32(x >> 4), int32(z >> 4)}
+
+	if self.last != nil && self.last.X == key[0] && self.last.Z == key[1] {
+		return self.last
+	}
+
+	if chunk, ok := self.chunks[key]; ok {
+		if chunk != nil {
+			self.last = chunk
+		}
+
+		return chunk
+	}
+
+	self.world.lock.Lock()
+	chunk := self.world.chunks[key]
+	self.world.lock.Unlock()
+
+	self.chunks[key] = chunk
+	return chunk
+}
+
+func (self *lighting) inWorld(pos blockPos) bool {
+	return pos.y >= self.world.MinY && pos.y < self.world.MinY+self.world.Height
+}
+
+func (self *lighting) get(pos blockPos) uint8 {
+	chunk := self.chunk(pos.x, pos.z)
+	if chunk == nil {
+		return 0
+	}
+
+	return chunk.light(self.kind, pos.x, pos.y, pos.z)
+}
+
+func (self *lighting) set(pos blockPos, level uint8) {
+	chunk := self.chunk(pos.x, pos.z)
+	if chunk == nil || !self.inWorld(pos) {
+		return
+	}
+
+	chunk.setLight(self.kind, pos.x, pos.y, pos.z, level)
+	chunk.MarkDirty()
+	self.changed[[2]int32{chunk.X, chunk.Z}] = true
+}
+
+func (self *lighting) opacity(pos blockPos) uint8 {
+	chunk := self.chunk(pos.x, pos.z)
+	if chunk == nil {
+		return MaxLight
+	}
+
+	return lightOf(chunk.Block(pos.x, pos.y, pos.z)).opacity
+}
+
+// spread returns the level light of a level gets to the neighbour in dir,
+// sky light going straight down without fading.
+func (self *lighting) spread(level uint8, dir blockPos, opacity uint8) uint8 {
+	if self.kind == skyLight && level == MaxLight && dir.y == -1 && opacity == 0 {
+		return MaxLight
+	}
+
+	loss := max(opacity, 1)
+	if loss >= level {
+		return 0
+	}
+
+	return level - loss
+}
+
+func (self *lighting) neighbour(pos blockPos, dir blockPos) (blockPos, bool) {
+	n := blockPos{pos.x + dir.x, pos.y + dir.y, pos.z + dir.z}
+	return n, self.inWorld(n) && self.chunk(n.x, n.z) != nil
+}
+
+// remove darkens the blocks lit from the queued removals, queueing the
+// brighter blocks around them to light the darkened area again.
+func (self *lighting) remove() {
+	for len(self.decrease) > 0 {
+		r := self.decrease[0]
+		self.decrease = self.decrease[1:]
+
+		for _, dir := range directions {
+			n, ok := self.neighbour(r.pos, dir)
+			if !ok {
+				continue
+			}
+
+			level := self.get(n)
+			if level == 0 {
+				continue
+			}
+
+			lit := self.spread(r.level, dir, self.opacity(n))
+			if level <= lit {
+				self.set(n, 0)
+				self.decrease = append(self.decrease, lightRemoval{n, level})
+			} else {
+				self.increase = append(self.increase, n)
+			}
+		}
+	}
+}
+
+// propagate spreads the light from the queued blocks.
+func (self *lighting) propagate() {
+	for len(self.increase) > 0 {
+		pos := self.increase[0]
+		self.increase = self.increase[1:]
+
+		level := self.get(pos)
+		if level <= 1 {
+			continue
+		}
+
+		for _, dir := range directions {
+			n, ok := self.neighbour(pos, dir)
+			if !ok {
+				continue
+			}
+
+			lit := self.spread(level, dir, self.opacity(n))
+			if lit > self.get(n) {
+				self.set(n, lit)
+				self.increase = append(self.increase, n)
+			}
+		}
+	}
+}
+
+// skyTop returns the lowest height of a column the sky shines on directly.
+func skyTop(chunk *Chunk, x int, z int) int {
+	for y := chunk.MinY + chunk.Height() - 1; y >= chunk.MinY; y-- {
+		if chunk.light(skyLight, x, y, z) != MaxLight {
+			return y + 1
+		}
+	}
+
+	return chunk.MinY
+}
+
+// lightChunk computes the light of a chunk that was just added to the
+// world, and spreads it to the loaded chunks around it.
+func (self *World) lightChunk(chunk *Chunk, changed map[[2]int32]bool) {
+	for _, s := range chunk.Sections {
+		s.SkyLight = make([]byte, LightLength)
+		s.BlockLight = make([]byte, LightLength)
+	}
+
+	baseX, baseZ := int(chunk.X)*SectionSize, int(chunk.Z)*SectionSize
+	top := chunk.MinY + chunk.Height()
+
+	sky := self.newLighting(skyLight, changed)
+	blocks := self.newLighting(blockLight, changed)
+
+	// The sky light goes down every column until something dims it
+	var tops [SectionSize + 2][SectionSize + 2]int
+	for x := range SectionSize {
+		for z := range SectionSize {
+			level := uint8(MaxLight)
+			tops[x+1][z+1] = chunk.MinY
+
+			for y := top - 1; y >= chunk.MinY; y-- {
+				state := chunk.Block(x, y, z)
+				level = sky.spread(level, blockPos{0, -1, 0}, lightOf(state).opacity)
+
+				if level < MaxLight && tops[x+1][z+1] == chunk.MinY {
+					tops[x+1][z+1] = y + 1
+				}
+
+				if level > 0 {
+					chunk.setLight(skyLight, x, y, z, level)
+
+					// Dimmed sky light spreads around, as under water
+					if level < MaxLight {
+						sky.increase = append(sky.increase, blockPos{baseX + x, y, baseZ + z})
+					}
+				}
+
+				if emission := lightOf(state).emission; emission > 0 {
+					chunk.setLight(blockLight, x, y, z, emission)
+					blocks.increase = append(blocks.increase, blockPos{baseX + x, y, baseZ + z})
+				}
+			}
+		}
+	}
+
+	// The columns around the chunk, taken from the loaded neighbours or
+	// from the chunk border
+	for i := range SectionSize {
+		edges := [][4]int{
+			{-1, i, 0, i},
+			{SectionSize, i, SectionSize - 1, i},
+			{i, -1, i, 0},
+			{i, SectionSize, i, SectionSize - 1},
+		}
+
+		for _, e := range edges {
+			x, z := baseX+e[0], baseZ+e[1]
+			tops[e[0]+1][e[1]+1] = tops[e[2]+1][e[3]+1]
+
+			if n := sky.chunk(x, z); n != nil {
+				tops[e[0]+1][e[1]+1] = skyTop(n, x, z)
+			}
+		}
+	}
+
+	// Directly lit blocks only spread sideways where a column next to them
+	// is darker at their height, that is between their top and the highest
+	// top around
+	for x := range SectionSize + 2 {
+		for z := range SectionSize + 2 {
+			inside := x > 0 && x <= SectionSize && z > 0 && z <= SectionSize
+			edge := !inside && (x > 0 && x <= SectionSize || z > 0 && z <= SectionSize)
+
+			if !inside && !edge {
+				continue
+			}
+
+			highest := tops[x][z]
+			for _, d := range [][2]int{{1, 0}, {-1, 0}, {0, 1}, {0, -1}} {
+				nx, nz := x+d[0], z+d[1]
+				if nx >= 0 && nx < SectionSize+2 && nz >= 0 && nz < SectionSize+2 {
+					highest = max(highest, tops[nx][nz])
+				}
+			}
+
+			wx, wz := baseX+x-1, baseZ+z-1
+			if edge && sky.chunk(wx, wz) == nil {
+				continue
+			}
+
+			for y := tops[x][z]; y < highest; y++ {
+				sky.increase = append(sky.increase, blockPos{wx, y, wz})
+			}
+
+			// Bring in the dimmed sky light and the block light of the
+			// neighbours
+			if edge {
+				for y := chunk.MinY; y < top; y++ {
+					pos := blockPos{wx, y, wz}
+
+					if y < tops[x][z] && sky.get(pos) > 1 {
+						sky.increase = append(sky.increase, pos)
+					}
+
+					if blocks.get(pos) > 1 {
+						blocks.increase = append(blocks.increase, pos)
+					}
+				}
+			}
+		}
+	}
+
+	sky.propagate()
+	blocks.propagate()
+}
+
+// relight updates the light around a block that changed.
+func (self *World) relight(x int, y int, z int, changed map[[2]int32]bool) {
+	pos := blockPos{x, y, z}
+
+	for _, kind := range []lightKind{skyLight, blockLight} {
+		l := self.newLighting(kind, changed)
+
+		if level := l.get(pos); level > 0 {
+			l.set(pos, 0)
+			l.decrease = append(l.decrease, lightRemoval{pos, level})
+		}
+
+		l.remove()
+
+		if kind == blockLight {
+			if emission := lightOf(l.chunk(x, z).Block(x, y, z)).emission; emission > 0 {
+				l.set(pos, emission)
+				l.increase = append(l.increase, pos)
+			}
+		}
+
+		// The block may let more light through now
+		for _, dir := range directions {
+			if n, ok := l.neighbour(pos, dir); ok {
+				l.increase = append(l.increase, n)
+			}
+		}
+
+		if kind == skyLight && y == self.MinY+self.Height-1 {
+			if l.opacity(pos) == 0 {
+				l.set(pos, MaxLight)
+				l.increase = append(l.increase, pos)
+			}
+		}
+
+		l.propagate()
+	}
+}
+
+// OnLightChanged registers a function called with the position of every
+// loaded chunk whose light changed because of another chunk or a block.
+func (self *World) OnLightChanged(fn func(x int32, z int32)) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	self.lightListeners = append(self.lightListeners, fn)
+}
+
+func (self *World) notifyLight(changed map[[2]int32]bool) {
+	self.lock.Lock()
+	listeners := self.lightListeners
+	self.lock.Unlock()
+
+	for pos := range changed {
+		for _, fn := range listeners {
+			fn(pos[0], pos[1])
+		}
+	}
+}
@@ -0,0 +1,1111 @@
+// Code generated by gendata from minecraft-data. DO NOT EDIT.
+
+package world
+
+var defaultLight = map[string]lightProperties{
+	"minecraft:air":                                {emission: 0, opacity: 0},
+	"minecraft:stone":                              {emission: 0, opacity: 15},
+	"minecraft:granite":                            {emission: 0, opacity: 15},
+	"minecraft:polished_granite":                   {emission: 0, opacity: 15},
+	"minecraft:diorite":                            {emission: 0, opacity: 15},
+	"minecraft:polished_diorite":                   {emission: 0, opacity: 15},
+	"minecraft:andesite":                           {emission: 0, opacity: 15},
+	"minecraft:polished_andesite":                  {emission: 0, opacity: 15},
+	"minecraft:grass_block":                        {emission: 0, opacity: 15},
+	"minecraft:dirt":                               {emission: 0, opacity: 15},
+	"minecraft:coarse_dirt":                        {emission: 0, opacity: 15},
+	"minecraft:podzol":                             {emission: 0, opacity: 15},
+	"minecraft:cobblestone":                        {emission: 0, opacity: 15},
+	"minecraft:oak_planks":                         {emission: 0, opacity: 15},
+	"minecraft:spruce_planks":                      {emission: 0, opacity: 15},
+	"minecraft:birch_planks":                       {emission: 0, opacity: 15},
+	"minecraft:jungle_planks":                      {emission: 0, opacity: 15},
+	"minecraft:acacia_planks":                      {emission: 0, opacity: 15},
+	"minecraft:cherry_planks":                      {emission: 0, opacity: 15},
+	"minecraft:dark_oak_planks":                    {emission: 0, opacity: 15},
+	"minecraft:pale_oak_wood":                      {emission: 0, opacity: 15},
+	"minecraft:pale_oak_planks":                    {emission: 0, opacity: 15},
+	"minecraft:mangrove_planks":                    {emission: 0, opacity: 15},
+	"minecraft:bamboo_planks":                      {emission: 0, opacity: 15},
+	"minecraft:bamboo_mosaic":                      {emission: 0, opacity: 15},
+	"minecraft:oak_sapling":                        {emission: 0, opacity: 0},
+	"minecraft:spruce_sapling":                     {emission: 0, opacity: 0},
+	"minecraft:birch_sapling":                      {emission: 0, opacity: 0},
+	"minecraft:jungle_sapling":                     {emission: 0, opacity: 0},
+	"minecraft:acacia_sapling":                     {emission: 0, opacity: 0},
+	"minecraft:cherry_sapling":                     {emission: 0, opacity: 0},
+	"minecraft:dark_oak_sapling":                   {emission: 0, opacity: 0},
+	"minecraft:pale_oak_sapling":                   {emission: 0, opacity: 0},
+	"minecraft:mangrove_propagule":                 {emission: 0, opacity: 0},
+	"minecraft:bedrock":                            {emission: 0, opacity: 15},
+	"minecraft:water":                              {emission: 0, opacity: 1},
+	"minecraft:lava":                               {emission: 15, opacity: 1},
+	"minecraft:sand":                               {emission: 0, opacity: 15},
+	"minecraft:suspicious_sand":                    {emission: 0, opacity: 15},
+	"minecraft:red_sand":                           {emission: 0, opacity: 15},
+	"minecraft:gravel":                             {emission: 0, opacity: 15},
+	"minecraft:suspicious_gravel":                  {emission: 0, opacity: 15},
+	"minecraft:gold_ore":                           {emission: 0, opacity: 15},
+	"minecraft:deepslate_gold_ore":                 {emission: 0, opacity: 15},
+	"minecraft:iron_ore":                           {emission: 0, opacity: 15},
+	"minecraft:deepslate_iron_ore":                 {emission: 0, opacity: 15},
+	"minecraft:coal_ore":                           {emission: 0, opacity: 15},
+	"minecraft:deepslate_coal_ore":                 {emission: 0, opacity: 15},
+	"minecraft:nether_gold_ore":                    {emission: 0, opacity: 15},
+	"minecraft:oak_log":                            {emission: 0, opacity: 15},
+	"minecraft:spruce_log":                         {emission: 0, opacity: 15},
+	"minecraft:birch_log":                          {emission: 0, opacity: 15},
+	"minecraft:jungle_log":                         {emission: 0, opacity: 15},
+	"minecraft:acacia_log":                         {emission: 0, opacity: 15},
+	"minecraft:cherry_log":                         {emission: 0, opacity: 15},
+	"minecraft:dark_oak_log":                       {emission: 0, opacity: 15},
+	"minecraft:pale_oak_log":                       {emission: 0, opacity: 15},
+	"minecraft:mangrove_log":                       {emission: 0, opacity: 15},
+	"minecraft:mangrove_roots":                     {emission: 0, opacity: 1},
+	"minecraft:muddy_mangrove_roots":               {emission: 0, opacity: 15},
+	"minecraft:bamboo_block":                       {emission: 0, opacity: 15},
+	"minecraft:stripped_spruce_log":                {emission: 0, opacity: 15},
+	"minecraft:stripped_birch_log":                 {emission: 0, opacity: 15},
+	"minecraft:stripped_jungle_log":                {emission: 0, opacity: 15},
+	"minecraft:stripped_acacia_log":                {emission: 0, opacity: 15},
+	"minecraft:stripped_cherry_log":                {emission: 0, opacity: 15},
+	"minecraft:stripped_dark_oak_log":              {emission: 0, opacity: 15},
+	"minecraft:stripped_pale_oak_log":              {emission: 0, opacity: 15},
+	"minecraft:stripped_oak_log":                   {emission: 0, opacity: 15},
+	"minecraft:stripped_mangrove_log":              {emission: 0, opacity: 15},
+	"minecraft:stripped_bamboo_block":              {emission: 0, opacity: 15},
+	"minecraft:oak_wood":                           {emission: 0, opacity: 15},
+	"minecraft:spruce_wood":                        {emission: 0, opacity: 15},
+	"minecraft:birch_wood":                         {emission: 0, opacity: 15},
+	"minecraft:jungle_wood":                        {emission: 0, opacity: 15},
+	"minecraft:acacia_wood":                        {emission: 0, opacity: 15},
+	"minecraft:cherry_wood":                        {emission: 0, opacity: 15},
+	"minecraft:dark_oak_wood":                      {emission: 0, opacity: 15},
+	"minecraft:mangrove_wood":                      {emission: 0, opacity: 15},
+	"minecraft:stripped_oak_wood":                  {emission: 0, opacity: 15},
+	"minecraft:stripped_spruce_wood":               {emission: 0, opacity: 15},
+	"minecraft:stripped_birch_wood":                {emission: 0, opacity: 15},
+	"minecraft:stripped_jungle_wood":               {emission: 0, opacity: 15},
+	"minecraft:stripped_acacia_wood":               {emission: 0, opacity: 15},
+	"minecraft:stripped_cherry_wood":               {emission: 0, opacity: 15},
+	"minecraft:stripped_dark_oak_wood":             {emission: 0, opacity: 15},
+	"minecraft:stripped_pale_oak_wood":             {emission: 0, opacity: 15},
+	"minecraft:stripped_mangrove_wood":             {emission: 0, opacity: 15},
+	"minecraft:oak_leaves":                         {emission: 0, opacity: 1},
+	"minecraft:spruce_leaves":                      {emission: 0, opacity: 1},
+	"minecraft:birch_leaves":                       {emission: 0, opacity: 1},
+	"minecraft:jungle_leaves":                      {emission: 0, opacity: 1},
+	"minecraft:acacia_leaves":                      {emission: 0, opacity: 1},
+	"minecraft:cherry_leaves":                      {emission: 0, opacity: 1},
+	"minecraft:dark_oak_leaves":                    {emission: 0, opacity: 1},
+	"minecraft:pale_oak_leaves":                    {emission: 0, opacity: 1},
+	"minecraft:mangrove_leaves":                    {emission: 0, opacity: 1},
+	"minecraft:azalea_leaves":                      {emission: 0, opacity: 1},
+	"minecraft:flowering_azalea_leaves":            {emission: 0, opacity: 1},
+	"minecraft:sponge":                             {emission: 0, opacity: 15},
+	"minecraft:wet_sponge":                         {emission: 0, opacity: 15},
+	"minecraft:glass":                              {emission: 0, opacity: 0},
+	"minecraft:lapis_ore":                          {emission: 0, opacity: 15},
+	"minecraft:deepslate_lapis_ore":                {emission: 0, opacity: 15},
+	"minecraft:lapis_block":                        {emission: 0, opacity: 15},
+	"minecraft:dispenser":                          {emission: 0, opacity: 15},
+	"minecraft:sandstone":                          {emission: 0, opacity: 15},
+	"minecraft:chiseled_sandstone":                 {emission: 0, opacity: 15},
+	"minecraft:cut_sandstone":                      {emission: 0, opacity: 15},
+	"minecraft:note_block":                         {emission: 0, opacity: 15},
+	"minecraft:white_bed":                          {emission: 0, opacity: 0},
+	"minecraft:orange_bed":                         {emission: 0, opacity: 0},
+	"minecraft:magenta_bed":                        {emission: 0, opacity: 0},
+	"minecraft:light_blue_bed":                     {emission: 0, opacity: 0},
+	"minecraft:yellow_bed":                         {emission: 0, opacity: 0},
+	"minecraft:lime_bed":                           {emission: 0, opacity: 0},
+	"minecraft:pink_bed":                           {emission: 0, opacity: 0},
+	"minecraft:gray_bed":                           {emission: 0, opacity: 0},
+	"minecraft:light_gray_bed":                     {emission: 0, opacity: 0},
+	"minecraft:cyan_bed":                           {emission: 0, opacity: 0},
+	"minecraft:purple_bed":                         {emission: 0, opacity: 0},
+	"minecraft:blue_bed":                           {emission: 0, opacity: 0},
+	"minecraft:brown_bed":                          {emission: 0, opacity: 0},
+	"minecraft:green_bed":                          {emission: 0, opacity: 0},
+	"minecraft:red_bed":                            {emission: 0, opacity: 0},
+	"minecraft:black_bed":                          {emission: 0, opacity: 0},
+	"minecraft:powered_rail":                       {emission: 0, opacity: 0},
+	"minecraft:detector_rail":                      {emission: 0, opacity: 0},
+	"minecraft:sticky_piston":                      {emission: 0, opacity: 15},
+	"minecraft:cobweb":                             {emission: 0, opacity: 1},
+	"minecraft:short_grass":                        {emission: 0, opacity: 0},
+	"minecraft:fern":                               {emission: 0, opacity: 0},
+	"minecraft:dead_bush":                          {emission: 0, opacity: 0},
+	"minecraft:bush":                               {emission: 0, opacity: 0},
+	"minecraft:short_dry_grass":                    {emission: 0, opacity: 0},
+	"minecraft:tall_dry_grass":                     {emission: 0, opacity: 0},
+	"minecraft:seagrass":                           {emission: 0, opacity: 1},
+	"minecraft:tall_seagrass":                      {emission: 0, opacity: 1},
+	"minecraft:piston":                             {emission: 0, opacity: 15},
+	"minecraft:piston_head":                        {emission: 0, opacity: 0},
+	"minecraft:white_wool":                         {emission: 0, opacity: 15},
+	"minecraft:orange_wool":                        {emission: 0, opacity: 15},
+	"minecraft:magenta_wool":                       {emission: 0, opacity: 15},
+	"minecraft:light_blue_wool":                    {emission: 0, opacity: 15},
+	"minecraft:yellow_wool":                        {emission: 0, opacity: 15},
+	"minecraft:lime_wool":                          {emission: 0, opacity: 15},
+	"minecraft:pink_wool":                          {emission: 0, opacity: 15},
+	"minecraft:gray_wool":                          {emission: 0, opacity: 15},
+	"minecraft:light_gray_wool":                    {emission: 0, opacity: 15},
+	"minecraft:cyan_wool":                          {emission: 0, opacity: 15},
+	"minecraft:purple_wool":                        {emission: 0, opacity: 15},
+	"minecraft:blue_wool":                          {emission: 0, opacity: 15},
+	"minecraft:brown_wool":                         {emission: 0, opacity: 15},
+	"minecraft:green_wool":                         {emission: 0, opacity: 15},
+	"minecraft:red_wool":                           {emission: 0, opacity: 15},
+	"minecraft:black_wool":                         {emission: 0, opacity: 15},
+	"minecraft:moving_piston":                      {emission: 0, opacity: 0},
+	"minecraft:dandelion":                          {emission: 0, opacity: 0},
+	"minecraft:torchflower":                        {emission: 0, opacity: 0},
+	"minecraft:poppy":                              {emission: 0, opacity: 0},
+	"minecraft:blue_orchid":                        {emission: 0, opacity: 0},
+	"minecraft:allium":                             {emission: 0, opacity: 0},
+	"minecraft:azure_bluet":                        {emission: 0, opacity: 0},
+	"minecraft:red_tulip":                          {emission: 0, opacity: 0},
+	"minecraft:orange_tulip":                       {emission: 0, opacity: 0},
+	"minecraft:white_tulip":                        {emission: 0, opacity: 0},
+	"minecraft:pink_tulip":                         {emission: 0, opacity: 0},
+	"minecraft:oxeye_daisy":                        {emission: 0, opacity: 0},
+	"minecraft:cornflower":                         {emission: 0, opacity: 0},
+	"minecraft:wither_rose":                        {emission: 0, opacity: 0},
+	"minecraft:lily_of_the_valley":                 {emission: 0, opacity: 0},
+	"minecraft:brown_mushroom":                     {emission: 1, opacity: 0},
+	"minecraft:red_mushroom":                       {emission: 0, opacity: 0},
+	"minecraft:gold_block":                         {emission: 0, opacity: 15},
+	"minecraft:iron_block":                         {emission: 0, opacity: 15},
+	"minecraft:bricks":                             {emission: 0, opacity: 15},
+	"minecraft:tnt":                                {emission: 0, opacity: 15},
+	"minecraft:bookshelf":                          {emission: 0, opacity: 15},
+	"minecraft:chiseled_bookshelf":                 {emission: 0, opacity: 15},
+	"minecraft:mossy_cobblestone":                  {emission: 0, opacity: 15},
+	"minecraft:obsidian":                           {emission: 0, opacity: 15},
+	"minecraft:torch":                              {emission: 14, opacity: 0},
+	"minecraft:wall_torch":                         {emission: 14, opacity: 0},
+	"minecraft:fire":                               {emission: 15, opacity: 0},
+	"minecraft:soul_fire":                          {emission: 10, opacity: 0},
+	"minecraft:spawner":                            {emission: 0, opacity: 1},
+	"minecraft:creaking_heart":                     {emission: 0, opacity: 15},
+	"minecraft:oak_stairs":                         {emission: 0, opacity: 0},
+	"minecraft:chest":                              {emission: 0, opacity: 0},
+	"minecraft:redstone_wire":                      {emission: 0, opacity: 0},
+	"minecraft:diamond_ore":                        {emission: 0, opacity: 15},
+	"minecraft:deepslate_diamond_ore":              {emission: 0, opacity: 15},
+	"minecraft:diamond_block":                      {emission: 0, opacity: 15},
+	"minecraft:crafting_table":                     {emission: 0, opacity: 15},
+	"minecraft:wheat":                              {emission: 0, opacity: 0},
+	"minecraft:farmland":                           {emission: 0, opacity: 0},
+	"minecraft:furnace":                            {emission: 0, opacity: 15},
+	"minecraft:oak_sign":                           {emission: 0, opacity: 0},
+	"minecraft:spruce_sign":                        {emission: 0, opacity: 0},
+	"minecraft:birch_sign":                         {emission: 0, opacity: 0},
+	"minecraft:acacia_sign":                        {emission: 0, opacity: 0},
+	"minecraft:cherry_sign":                        {emission: 0, opacity: 0},
+	"minecraft:jungle_sign":                        {emission: 0, opacity: 0},
+	"minecraft:dark_oak_sign":                      {emission: 0, opacity: 0},
+	"minecraft:pale_oak_sign":                      {emission: 0, opacity: 0},
+	"minecraft:mangrove_sign":                      {emission: 0, opacity: 0},
+	"minecraft:bamboo_sign":                        {emission: 0, opacity: 0},
+	"minecraft:oak_door":                           {emission: 0, opacity: 0},
+	"minecraft:ladder":                             {emission: 0, opacity: 0},
+	"minecraft:rail":                               {emission: 0, opacity: 0},
+	"minecraft:cobblestone_stairs":                 {emission: 0, opacity: 0},
+	"minecraft:oak_wall_sign":                      {emission: 0, opacity: 0},
+	"minecraft:spruce_wall_sign":                   {emission: 0, opacity: 0},
+	"minecraft:birch_wall_sign":                    {emission: 0, opacity: 0},
+	"minecraft:acacia_wall_sign":                   {emission: 0, opacity: 0},
+	"minecraft:cherry_wall_sign":                   {emission: 0, opacity: 0},
+	"minecraft:jungle_wall_sign":                   {emission: 0, opacity: 0},
+	"minecraft:dark_oak_wall_sign":                 {emission: 0, opacity: 0},
+	"minecraft:pale_oak_wall_sign":                 {emission: 0, opacity: 0},
+	"minecraft:mangrove_wall_sign":                 {emission: 0, opacity: 0},
+	"minecraft:bamboo_wall_sign":                   {emission: 0, opacity: 0},
+	"minecraft:oak_hanging_sign":                   {emission: 0, opacity: 0},
+	"minecraft:spruce_hanging_sign":                {emission: 0, opacity: 0},
+	"minecraft:birch_hanging_sign":                 {emission: 0, opacity: 0},
+	"minecraft:acacia_hanging_sign":                {emission: 0, opacity: 0},
+	"minecraft:cherry_hanging_sign":                {emission: 0, opacity: 0},
+	"minecraft:jungle_hanging_sign":                {emission: 0, opacity: 0},
+	"minecraft:dark_oak_hanging_sign":              {emission: 0, opacity: 0},
+	"minecraft:pale_oak_hanging_sign":              {emission: 0, opacity: 0},
+	"minecraft:crimson_hanging_sign":               {emission: 0, opacity: 0},
+	"minecraft:warped_hanging_sign":                {emission: 0, opacity: 0},
+	"minecraft:mangrove_hanging_sign":              {emission: 0, opacity: 0},
+	"minecraft:bamboo_hanging_sign":                {emission: 0, opacity: 0},
+	"minecraft:oak_wall_hanging_sign":              {emission: 0, opacity: 0},
+	"minecraft:spruce_wall_hanging_sign":           {emission: 0, opacity: 0},
+	"minecraft:birch_wall_hanging_sign":            {emission: 0, opacity: 0},
+	"minecraft:acacia_wall_hanging_sign":           {emission: 0, opacity: 0},
+	"minecraft:cherry_wall_hanging_sign":           {emission: 0, opacity: 0},
+	"minecraft:jungle_wall_hanging_sign":           {emission: 0, opacity: 0},
+	"minecraft:dark_oak_wall_hanging_sign":         {emission: 0, opacity: 0},
+	"minecraft:pale_oak_wall_hanging_sign":         {emission: 0, opacity: 0},
+	"minecraft:mangrove_wall_hanging_sign":         {emission: 0, opacity: 0},
+	"minecraft:crimson_wall_hanging_sign":          {emission: 0, opacity: 0},
+	"minecraft:warped_wall_hanging_sign":           {emission: 0, opacity: 0},
+	"minecraft:bamboo_wall_hanging_sign":           {emission: 0, opacity: 0},
+	"minecraft:lever":                              {emission: 0, opacity: 0},
+	"minecraft:stone_pressure_plate":               {emission: 0, opacity: 0},
+	"minecraft:iron_door":                          {emission: 0, opacity: 0},
+	"minecraft:oak_pressure_plate":                 {emission: 0, opacity: 0},
+	"minecraft:spruce_pressure_plate":              {emission: 0, opacity: 0},
+	"minecraft:birch_pressure_plate":               {emission: 0, opacity: 0},
+	"minecraft:jungle_pressure_plate":              {emission: 0, opacity: 0},
+	"minecraft:acacia_pressure_plate":              {emission: 0, opacity: 0},
+	"minecraft:cherry_pressure_plate":              {emission: 0, opacity: 0},
+	"minecraft:dark_oak_pressure_plate":            {emission: 0, opacity: 0},
+	"minecraft:pale_oak_pressure_plate":            {emission: 0, opacity: 0},
+	"minecraft:mangrove_pressure_plate":            {emission: 0, opacity: 0},
+	"minecraft:bamboo_pressure_plate":              {emission: 0, opacity: 0},
+	"minecraft:redstone_ore":                       {emission: 0, opacity: 15},
+	"minecraft:deepslate_redstone_ore":             {emission: 0, opacity: 15},
+	"minecraft:redstone_torch":                     {emission: 7, opacity: 0},
+	"minecraft:redstone_wall_torch":                {emission: 7, opacity: 0},
+	"minecraft:stone_button":                       {emission: 0, opacity: 0},
+	"minecraft:snow":                               {emission: 0, opacity: 0},
+	"minecraft:ice":                                {emission: 0, opacity: 1},
+	"minecraft:snow_block":                         {emission: 0, opacity: 15},
+	"minecraft:cactus":                             {emission: 0, opacity: 0},
+	"minecraft:cactus_flower":                      {emission: 0, opacity: 0},
+	"minecraft:clay":                               {emission: 0, opacity: 15},
+	"minecraft:sugar_cane":                         {emission: 0, opacity: 0},
+	"minecraft:jukebox":                            {emission: 0, opacity: 15},
+	"minecraft:oak_fence":                          {emission: 0, opacity: 0},
+	"minecraft:netherrack":                         {emission: 0, opacity: 15},
+	"minecraft:soul_sand":                          {emission: 0, opacity: 15},
+	"minecraft:soul_soil":                          {emission: 0, opacity: 15},
+	"minecraft:basalt":                             {emission: 0, opacity: 15},
+	"minecraft:polished_basalt":                    {emission: 0, opacity: 15},
+	"minecraft:soul_torch":                         {emission: 10, opacity: 0},
+	"minecraft:soul_wall_torch":                    {emission: 10, opacity: 0},
+	"minecraft:glowstone":                          {emission: 15, opacity: 15},
+	"minecraft:nether_portal":                      {emission: 11, opacity: 0},
+	"minecraft:carved_pumpkin":                     {emission: 0, opacity: 15},
+	"minecraft:jack_o_lantern":                     {emission: 15, opacity: 15},
+	"minecraft:cake":                               {emission: 0, opacity: 0},
+	"minecraft:repeater":                           {emission: 0, opacity: 0},
+	"minecraft:white_stained_glass":                {emission: 0, opacity: 0},
+	"minecraft:orange_stained_glass":               {emission: 0, opacity: 0},
+	"minecraft:magenta_stained_glass":              {emission: 0, opacity: 0},
+	"minecraft:light_blue_stained_glass":           {emission: 0, opacity: 0},
+	"minecraft:yellow_stained_glass":               {emission: 0, opacity: 0},
+	"minecraft:lime_stained_glass":                 {emission: 0, opacity: 0},
+	"minecraft:pink_stained_glass":                 {emission: 0, opacity: 0},
+	"minecraft:gray_stained_glass":                 {emission: 0, opacity: 0},
+	"minecraft:light_gray_stained_glass":           {emission: 0, opacity: 0},
+	"minecraft:cyan_stained_glass":                 {emission: 0, opacity: 0},
+	"minecraft:purple_stained_glass":               {emission: 0, opacity: 0},
+	"minecraft:blue_stained_glass":                 {emission: 0, opacity: 0},
+	"minecraft:brown_stained_glass":                {emission: 0, opacity: 0},
+	"minecraft:green_stained_glass":                {emission: 0, opacity: 0},
+	"minecraft:red_stained_glass":                  {emission: 0, opacity: 0},
+	"minecraft:black_stained_glass":                {emission: 0, opacity: 0},
+	"minecraft:oak_trapdoor":                       {emission: 0, opacity: 0},
+	"minecraft:spruce_trapdoor":                    {emission: 0, opacity: 0},
+	"minecraft:birch_trapdoor":                     {emission: 0, opacity: 0},
+	"minecraft:jungle_trapdoor":                    {emission: 0, opacity: 0},
+	"minecraft:acacia_trapdoor":                    {emission: 0, opacity: 0},
+	"minecraft:cherry_trapdoor":                    {emission: 0, opacity: 0},
+	"minecraft:dark_oak_trapdoor":                  {emission: 0, opacity: 0},
+	"minecraft:pale_oak_trapdoor":                  {emission: 0, opacity: 0},
+	"minecraft:mangrove_trapdoor":                  {emission: 0, opacity: 0},
+	"minecraft:bamboo_trapdoor":                    {emission: 0, opacity: 0},
+	"minecraft:stone_bricks":                       {emission: 0, opacity: 15},
+	"minecraft:mossy_stone_bricks":                 {emission: 0, opacity: 15},
+	"minecraft:cracked_stone_bricks":               {emission: 0, opacity: 15},
+	"minecraft:chiseled_stone_bricks":              {emission: 0, opacity: 15},
+	"minecraft:packed_mud":                         {emission: 0, opacity: 15},
+	"minecraft:mud_bricks":                         {emission: 0, opacity: 15},
+	"minecraft:infested_stone":                     {emission: 0, opacity: 15},
+	"minecraft:infested_cobblestone":               {emission: 0, opacity: 15},
+	"minecraft:infested_stone_bricks":              {emission: 0, opacity: 15},
+	"minecraft:infested_mossy_stone_bricks":        {emission: 0, opacity: 15},
+	"minecraft:infested_cracked_stone_bricks":      {emission: 0, opacity: 15},
+	"minecraft:infested_chiseled_stone_bricks":     {emission: 0, opacity: 15},
+	"minecraft:brown_mushroom_block":               {emission: 0, opacity: 15},
+	"minecraft:red_mushroom_block":                 {emission: 0, opacity: 15},
+	"minecraft:mushroom_stem":                      {emission: 0, opacity: 15},
+	"minecraft:iron_bars":                          {emission: 0, opacity: 0},
+	"minecraft:chain":                              {emission: 0, opacity: 0},
+	"minecraft:glass_pane":                         {emission: 0, opacity: 0},
+	"minecraft:pumpkin":                            {emission: 0, opacity: 15},
+	"minecraft:melon":                              {emission: 0, opacity: 15},
+	"minecraft:attached_pumpkin_stem":              {emission: 0, opacity: 0},
+	"minecraft:attached_melon_stem":                {emission: 0, opacity: 0},
+	"minecraft:pumpkin_stem":                       {emission: 0, opacity: 0},
+	"minecraft:melon_stem":                         {emission: 0, opacity: 0},
+	"minecraft:vine":                               {emission: 0, opacity: 0},
+	"minecraft:glow_lichen":                        {emission: 0, opacity: 0},
+	"minecraft:resin_clump":                        {emission: 0, opacity: 1},
+	"minecraft:oak_fence_gate":                     {emission: 0, opacity: 0},
+	"minecraft:brick_stairs":                       {emission: 0, opacity: 0},
+	"minecraft:stone_brick_stairs":                 {emission: 0, opacity: 0},
+	"minecraft:mud_brick_stairs":                   {emission: 0, opacity: 0},
+	"minecraft:mycelium":                           {emission: 0, opacity: 15},
+	"minecraft:lily_pad":                           {emission: 0, opacity: 0},
+	"minecraft:resin_block":                        {emission: 0, opacity: 15},
+	"minecraft:resin_bricks":                       {emission: 0, opacity: 15},
+	"minecraft:resin_brick_stairs":                 {emission: 0, opacity: 0},
+	"minecraft:resin_brick_slab":                   {emission: 0, opacity: 0},
+	"minecraft:resin_brick_wall":                   {emission: 0, opacity: 0},
+	"minecraft:chiseled_resin_bricks":              {emission: 0, opacity: 15},
+	"minecraft:nether_bricks":                      {emission: 0, opacity: 15},
+	"minecraft:nether_brick_fence":                 {emission: 0, opacity: 0},
+	"minecraft:nether_brick_stairs":                {emission: 0, opacity: 0},
+	"minecraft:nether_wart":                        {emission: 0, opacity: 0},
+	"minecraft:enchanting_table":                   {emission: 7, opacity: 0},
+	"minecraft:brewing_stand":                      {emission: 1, opacity: 0},
+	"minecraft:cauldron":                           {emission: 0, opacity: 0},
+	"minecraft:water_cauldron":                     {emission: 0, opacity: 0},
+	"minecraft:lava_cauldron":                      {emission: 15, opacity: 0},
+	"minecraft:powder_snow_cauldron":               {emission: 0, opacity: 0},
+	"minecraft:end_portal":                         {emission: 15, opacity: 0},
+	"minecraft:end_portal_frame":                   {emission: 1, opacity: 0},
+	"minecraft:end_stone":                          {emission: 0, opacity: 15},
+	"minecraft:dragon_egg":                         {emission: 1, opacity: 0},
+	"minecraft:redstone_lamp":                      {emission: 0, opacity: 15},
+	"minecraft:cocoa":                              {emission: 0, opacity: 0},
+	"minecraft:sandstone_stairs":                   {emission: 0, opacity: 0},
+	"minecraft:emerald_ore":                        {emission: 0, opacity: 15},
+	"minecraft:deepslate_emerald_ore":              {emission: 0, opacity: 15},
+	"minecraft:ender_chest":                        {emission: 7, opacity: 0},
+	"minecraft:tripwire_hook":                      {emission: 0, opacity: 0},
+	"minecraft:tripwire":                           {emission: 0, opacity: 0},
+	"minecraft:emerald_block":                      {emission: 0, opacity: 15},
+	"minecraft:spruce_stairs":                      {emission: 0, opacity: 0},
+	"minecraft:birch_stairs":                       {emission: 0, opacity: 0},
+	"minecraft:jungle_stairs":                      {emission: 0, opacity: 0},
+	"minecraft:command_block":                      {emission: 0, opacity: 15},
+	"minecraft:beacon":                             {emission: 15, opacity: 1},
+	"minecraft:cobblestone_wall":                   {emission: 0, opacity: 0},
+	"minecraft:mossy_cobblestone_wall":             {emission: 0, opacity: 0},
+	"minecraft:flower_pot":                         {emission: 0, opacity: 0},
+	"minecraft:potted_torchflower":                 {emission: 0, opacity: 0},
+	"minecraft:potted_oak_sapling":                 {emission: 0, opacity: 0},
+	"minecraft:potted_spruce_sapling":              {emission: 0, opacity: 0},
+	"minecraft:potted_birch_sapling":               {emission: 0, opacity: 0},
+	"minecraft:potted_jungle_sapling":              {emission: 0, opacity: 0},
+	"minecraft:potted_acacia_sapling":              {emission: 0, opacity: 0},
+	"minecraft:potted_cherry_sapling":              {emission: 0, opacity: 0},
+	"minecraft:potted_dark_oak_sapling":            {emission: 0, opacity: 0},
+	"minecraft:potted_pale_oak_sapling":            {emission: 0, opacity: 0},
+	"minecraft:potted_mangrove_propagule":          {emission: 0, opacity: 0},
+	"minecraft:potted_fern":                        {emission: 0, opacity: 0},
+	"minecraft:potted_dandelion":                   {emission: 0, opacity: 0},
+	"minecraft:potted_poppy":                       {emission: 0, opacity: 0},
+	"minecraft:potted_blue_orchid":                 {emission: 0, opacity: 0},
+	"minecraft:potted_allium":                      {emission: 0, opacity: 0},
+	"minecraft:potted_azure_bluet":                 {emission: 0, opacity: 0},
+	"minecraft:potted_red_tulip":                   {emission: 0, opacity: 0},
+	"minecraft:potted_orange_tulip":                {emission: 0, opacity: 0},
+	"minecraft:potted_white_tulip":                 {emission: 0, opacity: 0},
+	"minecraft:potted_pink_tulip":                  {emission: 0, opacity: 0},
+	"minecraft:potted_oxeye_daisy":                 {emission: 0, opacity: 0},
+	"minecraft:potted_cornflower":                  {emission: 0, opacity: 0},
+	"minecraft:potted_lily_of_the_valley":          {emission: 0, opacity: 0},
+	"minecraft:potted_wither_rose":                 {emission: 0, opacity: 0},
+	"minecraft:potted_red_mushroom":                {emission: 0, opacity: 0},
+	"minecraft:potted_brown_mushroom":              {emission: 0, opacity: 0},
+	"minecraft:potted_dead_bush":                   {emission: 0, opacity: 0},
+	"minecraft:potted_cactus":                      {emission: 0, opacity: 0},
+	"minecraft:carrots":                            {emission: 0, opacity: 0},
+	"minecraft:potatoes":                           {emission: 0, opacity: 0},
+	"minecraft:oak_button":                         {emission: 0, opacity: 0},
+	"minecraft:spruce_button":                      {emission: 0, opacity: 0},
+	"minecraft:birch_button":                       {emission: 0, opacity: 0},
+	"minecraft:jungle_button":                      {emission: 0, opacity: 0},
+	"minecraft:acacia_button":                      {emission: 0, opacity: 0},
+	"minecraft:cherry_button":                      {emission: 0, opacity: 0},
+	"minecraft:dark_oak_button":                    {emission: 0, opacity: 0},
+	"minecraft:pale_oak_button":                    {emission: 0, opacity: 0},
+	"minecraft:mangrove_button":                    {emission: 0, opacity: 0},
+	"minecraft:bamboo_button":                      {emission: 0, opacity: 0},
+	"minecraft:skeleton_skull":                     {emission: 0, opacity: 0},
+	"minecraft:skeleton_wall_skull":                {emission: 0, opacity: 0},
+	"minecraft:wither_skeleton_skull":              {emission: 0, opacity: 0},
+	"minecraft:wither_skeleton_wall_skull":         {emission: 0, opacity: 0},
+	"minecraft:zombie_head":                        {emission: 0, opacity: 0},
+	"minecraft:zombie_wall_head":                   {emission: 0, opacity: 0},
+	"minecraft:player_head":                        {emission: 0, opacity: 0},
+	"minecraft:player_wall_head":                   {emission: 0, opacity: 0},
+	"minecraft:creeper_head":                       {emission: 0, opacity: 0},
+	"minecraft:creeper_wall_head":                  {emission: 0, opacity: 0},
+	"minecraft:dragon_head":                        {emission: 0, opacity: 0},
+	"minecraft:dragon_wall_head":                   {emission: 0, opacity: 0},
+	"minecraft:piglin_head":                        {emission: 0, opacity: 0},
+	"minecraft:piglin_wall_head":                   {emission: 0, opacity: 0},
+	"minecraft:anvil":                              {emission: 0, opacity: 0},
+	"minecraft:chipped_anvil":                      {emission: 0, opacity: 0},
+	"minecraft:damaged_anvil":                      {emission: 0, opacity: 0},
+	"minecraft:trapped_chest":                      {emission: 0, opacity: 0},
+	"minecraft:light_weighted_pressure_plate":      {emission: 0, opacity: 0},
+	"minecraft:heavy_weighted_pressure_plate":      {emission: 0, opacity: 0},
+	"minecraft:comparator":                         {emission: 0, opacity: 0},
+	"minecraft:daylight_detector":                  {emission: 0, opacity: 0},
+	"minecraft:redstone_block":                     {emission: 0, opacity: 15},
+	"minecraft:nether_quartz_ore":                  {emission: 0, opacity: 15},
+	"minecraft:hopper":                             {emission: 0, opacity: 0},
+	"minecraft:quartz_block":                       {emission: 0, opacity: 15},
+	"minecraft:chiseled_quartz_block":              {emission: 0, opacity: 15},
+	"minecraft:quartz_pillar":                      {emission: 0, opacity: 15},
+	"minecraft:quartz_stairs":                      {emission: 0, opacity: 0},
+	"minecraft:activator_rail":                     {emission: 0, opacity: 0},
+	"minecraft:dropper":                            {emission: 0, opacity: 15},
+	"minecraft:white_terracotta":                   {emission: 0, opacity: 15},
+	"minecraft:orange_terracotta":                  {emission: 0, opacity: 15},
+	"minecraft:magenta_terracotta":                 {emission: 0, opacity: 15},
+	"minecraft:light_blue_terracotta":              {emission: 0, opacity: 15},
+	"minecraft:yellow_terracotta":                  {emission: 0, opacity: 15},
+	"minecraft:lime_terracotta":                    {emission: 0, opacity: 15},
+	"minecraft:pink_terracotta":                    {emission: 0, opacity: 15},
+	"minecraft:gray_terracotta":                    {emission: 0, opacity: 15},
+	"minecraft:light_gray_terracotta":              {emission: 0, opacity: 15},
+	"minecraft:cyan_terracotta":                    {emission: 0, opacity: 15},
+	"minecraft:purple_terracotta":                  {emission: 0, opacity: 15},
+	"minecraft:blue_terracotta":                    {emission: 0, opacity: 15},
+	"minecraft:brown_terracotta":                   {emission: 0, opacity: 15},
+	"minecraft:green_terracotta":                   {emission: 0, opacity: 15},
+	"minecraft:red_terracotta":                     {emission: 0, opacity: 15},
+	"minecraft:black_terracotta":                   {emission: 0, opacity: 15},
+	"minecraft:white_stained_glass_pane":           {emission: 0, opacity: 0},
+	"minecraft:orange_stained_glass_pane":          {emission: 0, opacity: 0},
+	"minecraft:magenta_stained_glass_pane":         {emission: 0, opacity: 0},
+	"minecraft:light_blue_stained_glass_pane":      {emission: 0, opacity: 0},
+	"minecraft:yellow_stained_glass_pane":          {emission: 0, opacity: 0},
+	"minecraft:lime_stained_glass_pane":            {emission: 0, opacity: 0},
+	"minecraft:pink_stained_glass_pane":            {emission: 0, opacity: 0},
+	"minecraft:gray_stained_glass_pane":            {emission: 0, opacity: 0},
+	"minecraft:light_gray_stained_glass_pane":      {emission: 0, opacity: 0},
+	"minecraft:cyan_stained_glass_pane":            {emission: 0, opacity: 0},
+	"minecraft:purple_stained_glass_pane":          {emission: 0, opacity: 0},
+	"minecraft:blue_stained_glass_pane":            {emission: 0, opacity: 0},
+	"minecraft:brown_stained_glass_pane":           {emission: 0, opacity: 0},
+	"minecraft:green_stained_glass_pane":           {emission: 0, opacity: 0},
+	"minecraft:red_stained_glass_pane":             {emission: 0, opacity: 0},
+	"minecraft:black_stained_glass_pane":           {emission: 0, opacity: 0},
+	"minecraft:acacia_stairs":                      {emission: 0, opacity: 0},
+	"minecraft:cherry_stairs":                      {emission: 0, opacity: 0},
+	"minecraft:dark_oak_stairs":                    {emission: 0, opacity: 0},
+	"minecraft:pale_oak_stairs":                    {emission: 0, opacity: 0},
+	"minecraft:mangrove_stairs":                    {emission: 0, opacity: 0},
+	"minecraft:bamboo_stairs":                      {emission: 0, opacity: 0},
+	"minecraft:bamboo_mosaic_stairs":               {emission: 0, opacity: 0},
+	"minecraft:slime_block":                        {emission: 0, opacity: 1},
+	"minecraft:barrier":                            {emission: 0, opacity: 0},
+	"minecraft:light":                              {emission: 15, opacity: 0},
+	"minecraft:iron_trapdoor":                      {emission: 0, opacity: 0},
+	"minecraft:prismarine":                         {emission: 0, opacity: 15},
+	"minecraft:prismarine_bricks":                  {emission: 0, opacity: 15},
+	"minecraft:dark_prismarine":                    {emission: 0, opacity: 15},
+	"minecraft:prismarine_stairs":                  {emission: 0, opacity: 0},
+	"minecraft:prismarine_brick_stairs":            {emission: 0, opacity: 0},
+	"minecraft:dark_prismarine_stairs":             {emission: 0, opacity: 0},
+	"minecraft:prismarine_slab":                    {emission: 0, opacity: 0},
+	"minecraft:prismarine_brick_slab":              {emission: 0, opacity: 0},
+	"minecraft:dark_prismarine_slab":               {emission: 0, opacity: 0},
+	"minecraft:sea_lantern":                        {emission: 15, opacity: 15},
+	"minecraft:hay_block":                          {emission: 0, opacity: 15},
+	"minecraft:white_carpet":                       {emission: 0, opacity: 0},
+	"minecraft:orange_carpet":                      {emission: 0, opacity: 0},
+	"minecraft:magenta_carpet":                     {emission: 0, opacity: 0},
+	"minecraft:light_blue_carpet":                  {emission: 0, opacity: 0},
+	"minecraft:yellow_carpet":                      {emission: 0, opacity: 0},
+	"minecraft:lime_carpet":                        {emission: 0, opacity: 0},
+	"minecraft:pink_carpet":                        {emission: 0, opacity: 0},
+	"minecraft:gray_carpet":                        {emission: 0, opacity: 0},
+	"minecraft:light_gray_carpet":                  {emission: 0, opacity: 0},
+	"minecraft:cyan_carpet":                        {emission: 0, opacity: 0},
+	"minecraft:purple_carpet":                      {emission: 0, opacity: 0},
+	"minecraft:blue_carpet":                        {emission: 0, opacity: 0},
+	"minecraft:brown_carpet":                       {emission: 0, opacity: 0},
+	"minecraft:green_carpet":                       {emission: 0, opacity: 0},
+	"minecraft:red_carpet":                         {emission: 0, opacity: 0},
+	"minecraft:black_carpet":                       {emission: 0, opacity: 0},
+	"minecraft:terracotta":                         {emission: 0, opacity: 15},
+	"minecraft:coal_block":                         {emission: 0, opacity: 15},
+	"minecraft:packed_ice":                         {emission: 0, opacity: 15},
+	"minecraft:sunflower":                          {emission: 0, opacity: 0},
+	"minecraft:lilac":                              {emission: 0, opacity: 0},
+	"minecraft:rose_bush":                          {emission: 0, opacity: 0},
+	"minecraft:peony":                              {emission: 0, opacity: 0},
+	"minecraft:tall_grass":                         {emission: 0, opacity: 0},
+	"minecraft:large_fern":                         {emission: 0, opacity: 0},
+	"minecraft:white_banner":                       {emission: 0, opacity: 0},
+	"minecraft:orange_banner":                      {emission: 0, opacity: 0},
+	"minecraft:magenta_banner":                     {emission: 0, opacity: 0},
+	"minecraft:light_blue_banner":                  {emission: 0, opacity: 0},
+	"minecraft:yellow_banner":                      {emission: 0, opacity: 0},
+	"minecraft:lime_banner":                        {emission: 0, opacity: 0},
+	"minecraft:pink_banner":                        {emission: 0, opacity: 0},
+	"minecraft:gray_banner":                        {emission: 0, opacity: 0},
+	"minecraft:light_gray_banner":                  {emission: 0, opacity: 0},
+	"minecraft:cyan_banner":                        {emission: 0, opacity: 0},
+	"minecraft:purple_banner":                      {emission: 0, opacity: 0},
+	"minecraft:blue_banner":                        {emission: 0, opacity: 0},
+	"minecraft:brown_banner":                       {emission: 0, opacity: 0},
+	"minecraft:green_banner":                       {emission: 0, opacity: 0},
+	"minecraft:red_banner":                         {emission: 0, opacity: 0},
+	"minecraft:black_banner":                       {emission: 0, opacity: 0},
+	"minecraft:white_wall_banner":                  {emission: 0, opacity: 0},
+	"minecraft:orange_wall_banner":                 {emission: 0, opacity: 0},
+	"minecraft:magenta_wall_banner":                {emission: 0, opacity: 0},
+	"minecraft:light_blue_wall_banner":             {emission: 0, opacity: 0},
+	"minecraft:yellow_wall_banner":                 {emission: 0, opacity: 0},
+	"minecraft:lime_wall_banner":                   {emission: 0, opacity: 0},
+	"minecraft:pink_wall_banner":                   {emission: 0, opacity: 0},
+	"minecraft:gray_wall_banner":                   {emission: 0, opacity: 0},
+	"minecraft:light_gray_wall_banner":             {emission: 0, opacity: 0},
+	"minecraft:cyan_wall_banner":                   {emission: 0, opacity: 0},
+	"minecraft:purple_wall_banner":                 {emission: 0, opacity: 0},
+	"minecraft:blue_wall_banner":                   {emission: 0, opacity: 0},
+	"minecraft:brown_wall_banner":                  {emission: 0, opacity: 0},
+	"minecraft:green_wall_banner":                  {emission: 0, opacity: 0},
+	"minecraft:red_wall_banner":                    {emission: 0, opacity: 0},
+	"minecraft:black_wall_banner":                  {emission: 0, opacity: 0},
+	"minecraft:red_sandstone":                      {emission: 0, opacity: 15},
+	"minecraft:chiseled_red_sandstone":             {emission: 0, opacity: 15},
+	"minecraft:cut_red_sandstone":                  {emission: 0, opacity: 15},
+	"minecraft:red_sandstone_stairs":               {emission: 0, opacity: 0},
+	"minecraft:oak_slab":                           {emission: 0, opacity: 0},
+	"minecraft:spruce_slab":                        {emission: 0, opacity: 0},
+	"minecraft:birch_slab":                         {emission: 0, opacity: 0},
+	"minecraft:jungle_slab":                        {emission: 0, opacity: 0},
+	"minecraft:acacia_slab":                        {emission: 0, opacity: 0},
+	"minecraft:cherry_slab":                        {emission: 0, opacity: 0},
+	"minecraft:dark_oak_slab":                      {emission: 0, opacity: 0},
+	"minecraft:pale_oak_slab":                      {emission: 0, opacity: 0},
+	"minecraft:mangrove_slab":                      {emission: 0, opacity: 0},
+	"minecraft:bamboo_slab":                        {emission: 0, opacity: 0},
+	"minecraft:bamboo_mosaic_slab":                 {emission: 0, opacity: 0},
+	"minecraft:stone_slab":                         {emission: 0, opacity: 0},
+	"minecraft:smooth_stone_slab":                  {emission: 0, opacity: 0},
+	"minecraft:sandstone_slab":                     {emission: 0, opacity: 0},
+	"minecraft:cut_sandstone_slab":                 {emission: 0, opacity: 0},
+	"minecraft:petrified_oak_slab":                 {emission: 0, opacity: 0},
+	"minecraft:cobblestone_slab":                   {emission: 0, opacity: 0},
+	"minecraft:brick_slab":                         {emission: 0, opacity: 0},
+	"minecraft:stone_brick_slab":                   {emission: 0, opacity: 0},
+	"minecraft:mud_brick_slab":                     {emission: 0, opacity: 0},
+	"minecraft:nether_brick_slab":                  {emission: 0, opacity: 0},
+	"minecraft:quartz_slab":                        {emission: 0, opacity: 0},
+	"minecraft:red_sandstone_slab":                 {emission: 0, opacity: 0},
+	"minecraft:cut_red_sandstone_slab":             {emission: 0, opacity: 0},
+	"minecraft:purpur_slab":                        {emission: 0, opacity: 0},
+	"minecraft:smooth_stone":                       {emission: 0, opacity: 15},
+	"minecraft:smooth_sandstone":                   {emission: 0, opacity: 15},
+	"minecraft:smooth_quartz":                      {emission: 0, opacity: 15},
+	"minecraft:smooth_red_sandstone":               {emission: 0, opacity: 15},
+	"minecraft:spruce_fence_gate":                  {emission: 0, opacity: 0},
+	"minecraft:birch_fence_gate":                   {emission: 0, opacity: 0},
+	"minecraft:jungle_fence_gate":                  {emission: 0, opacity: 0},
+	"minecraft:acacia_fence_gate":                  {emission: 0, opacity: 0},
+	"minecraft:cherry_fence_gate":                  {emission: 0, opacity: 0},
+	"minecraft:dark_oak_fence_gate":                {emission: 0, opacity: 0},
+	"minecraft:pale_oak_fence_gate":                {emission: 0, opacity: 0},
+	"minecraft:mangrove_fence_gate":                {emission: 0, opacity: 0},
+	"minecraft:bamboo_fence_gate":                  {emission: 0, opacity: 0},
+	"minecraft:spruce_fence":                       {emission: 0, opacity: 0},
+	"minecraft:birch_fence":                        {emission: 0, opacity: 0},
+	"minecraft:jungle_fence":                       {emission: 0, opacity: 0},
+	"minecraft:acacia_fence":                       {emission: 0, opacity: 0},
+	"minecraft:cherry_fence":                       {emission: 0, opacity: 0},
+	"minecraft:dark_oak_fence":                     {emission: 0, opacity: 0},
+	"minecraft:pale_oak_fence":                     {emission: 0, opacity: 0},
+	"minecraft:mangrove_fence":                     {emission: 0, opacity: 0},
+	"minecraft:bamboo_fence":                       {emission: 0, opacity: 0},
+	"minecraft:spruce_door":                        {emission: 0, opacity: 0},
+	"minecraft:birch_door":                         {emission: 0, opacity: 0},
+	"minecraft:jungle_door":                        {emission: 0, opacity: 0},
+	"minecraft:acacia_door":                        {emission: 0, opacity: 0},
+	"minecraft:cherry_door":                        {emission: 0, opacity: 0},
+	"minecraft:dark_oak_door":                      {emission: 0, opacity: 0},
+	"minecraft:pale_oak_door":                      {emission: 0, opacity: 0},
+	"minecraft:mangrove_door":                      {emission: 0, opacity: 0},
+	"minecraft:bamboo_door":                        {emission: 0, opacity: 0},
+	"minecraft:end_rod":                            {emission: 14, opacity: 0},
+	"minecraft:chorus_plant":                       {emission: 0, opacity: 1},
+	"minecraft:chorus_flower":                      {emission: 0, opacity: 1},
+	"minecraft:purpur_block":                       {emission: 0, opacity: 15},
+	"minecraft:purpur_pillar":                      {emission: 0, opacity: 15},
+	"minecraft:purpur_stairs":                      {emission: 0, opacity: 0},
+	"minecraft:end_stone_bricks":                   {emission: 0, opacity: 15},
+	"minecraft:torchflower_crop":                   {emission: 0, opacity: 0},
+	"minecraft:pitcher_crop":                       {emission: 0, opacity: 0},
+	"minecraft:pitcher_plant":                      {emission: 0, opacity: 0},
+	"minecraft:beetroots":                          {emission: 0, opacity: 0},
+	"minecraft:dirt_path":                          {emission: 0, opacity: 0},
+	"minecraft:end_gateway":                        {emission: 15, opacity: 1},
+	"minecraft:repeating_command_block":            {emission: 0, opacity: 15},
+	"minecraft:chain_command_block":                {emission: 0, opacity: 15},
+	"minecraft:frosted_ice":                        {emission: 0, opacity: 1},
+	"minecraft:magma_block":                        {emission: 3, opacity: 15},
+	"minecraft:nether_wart_block":                  {emission: 0, opacity: 15},
+	"minecraft:red_nether_bricks":                  {emission: 0, opacity: 15},
+	"minecraft:bone_block":                         {emission: 0, opacity: 15},
+	"minecraft:structure_void":                     {emission: 0, opacity: 0},
+	"minecraft:observer":                           {emission: 0, opacity: 15},
+	"minecraft:shulker_box":                        {emission: 0, opacity: 1},
+	"minecraft:white_shulker_box":                  {emission: 0, opacity: 1},
+	"minecraft:orange_shulker_box":                 {emission: 0, opacity: 1},
+	"minecraft:magenta_shulker_box":                {emission: 0, opacity: 1},
+	"minecraft:light_blue_shulker_box":             {emission: 0, opacity: 1},
+	"minecraft:yellow_shulker_box":                 {emission: 0, opacity: 1},
+	"minecraft:lime_shulker_box":                   {emission: 0, opacity: 1},
+	"minecraft:pink_shulker_box":                   {emission: 0, opacity: 1},
+	"minecraft:gray_shulker_box":                   {emission: 0, opacity: 1},
+	"minecraft:light_gray_shulker_box":             {emission: 0, opacity: 1},
+	"minecraft:cyan_shulker_box":                   {emission: 0, opacity: 1},
+	"minecraft:purple_shulker_box":                 {emission: 0, opacity: 1},
+	"minecraft:blue_shulker_box":                   {emission: 0, opacity: 1},
+	"minecraft:brown_shulker_box":                  {emission: 0, opacity: 1},
+	"minecraft:green_shulker_box":                  {emission: 0, opacity: 1},
+	"minecraft:red_shulker_box":                    {emission: 0, opacity: 1},
+	"minecraft:black_shulker_box":                  {emission: 0, opacity: 1},
+	"minecraft:white_glazed_terracotta":            {emission: 0, opacity: 15},
+	"minecraft:orange_glazed_terracotta":           {emission: 0, opacity: 15},
+	"minecraft:magenta_glazed_terracotta":          {emission: 0, opacity: 15},
+	"minecraft:light_blue_glazed_terracotta":       {emission: 0, opacity: 15},
+	"minecraft:yellow_glazed_terracotta":           {emission: 0, opacity: 15},
+	"minecraft:lime_glazed_terracotta":             {emission: 0, opacity: 15},
+	"minecraft:pink_glazed_terracotta":             {emission: 0, opacity: 15},
+	"minecraft:gray_glazed_terracotta":             {emission: 0, opacity: 15},
+	"minecraft:light_gray_glazed_terracotta":       {emission: 0, opacity: 15},
+	"minecraft:cyan_glazed_terracotta":             {emission: 0, opacity: 15},
+	"minecraft:purple_glazed_terracotta":           {emission: 0, opacity: 15},
+	"minecraft:blue_glazed_terracotta":             {emission: 0, opacity: 15},
+	"minecraft:brown_glazed_terracotta":            {emission: 0, opacity: 15},
+	"minecraft:green_glazed_terracotta":            {emission: 0, opacity: 15},
+	"minecraft:red_glazed_terracotta":              {emission: 0, opacity: 15},
+	"minecraft:black_glazed_terracotta":            {emission: 0, opacity: 15},
+	"minecraft:white_concrete":                     {emission: 0, opacity: 15},
+	"minecraft:orange_concrete":                    {emission: 0, opacity: 15},
+	"minecraft:magenta_concrete":                   {emission: 0, opacity: 15},
+	"minecraft:light_blue_concrete":                {emission: 0, opacity: 15},
+	"minecraft:yellow_concrete":                    {emission: 0, opacity: 15},
+	"minecraft:lime_concrete":                      {emission: 0, opacity: 15},
+	"minecraft:pink_concrete":                      {emission: 0, opacity: 15},
+	"minecraft:gray_concrete":                      {emission: 0, opacity: 15},
+	"minecraft:light_gray_concrete":                {emission: 0, opacity: 15},
+	"minecraft:cyan_concrete":                      {emission: 0, opacity: 15},
+	"minecraft:purple_concrete":                    {emission: 0, opacity: 15},
+	"minecraft:blue_concrete":                      {emission: 0, opacity: 15},
+	"minecraft:brown_concrete":                     {emission: 0, opacity: 15},
+	"minecraft:green_concrete":                     {emission: 0, opacity: 15},
+	"minecraft:red_concrete":                       {emission: 0, opacity: 15},
+	"minecraft:black_concrete":                     {emission: 0, opacity: 15},
+	"minecraft:white_concrete_powder":              {emission: 0, opacity: 15},
+	"minecraft:orange_concrete_powder":             {emission: 0, opacity: 15},
+	"minecraft:magenta_concrete_powder":            {emission: 0, opacity: 15},
+	"minecraft:light_blue_concrete_powder":         {emission: 0, opacity: 15},
+	"minecraft:yellow_concrete_powder":             {emission: 0, opacity: 15},
+	"minecraft:lime_concrete_powder":               {emission: 0, opacity: 15},
+	"minecraft:pink_concrete_powder":               {emission: 0, opacity: 15},
+	"minecraft:gray_concrete_powder":               {emission: 0, opacity: 15},
+	"minecraft:light_gray_concrete_powder":         {emission: 0, opacity: 15},
+	"minecraft:cyan_concrete_powder":               {emission: 0, opacity: 15},
+	"minecraft:purple_concrete_powder":             {emission: 0, opacity: 15},
+	"minecraft:blue_concrete_powder":               {emission: 0, opacity: 15},
+	"minecraft:brown_concrete_powder":              {emission: 0, opacity: 15},
+	"minecraft:green_concrete_powder":              {emission: 0, opacity: 15},
+	"minecraft:red_concrete_powder":                {emission: 0, opacity: 15},
+	"minecraft:black_concrete_powder":              {emission: 0, opacity: 15},
+	"minecraft:kelp":                               {emission: 0, opacity: 1},
+	"minecraft:kelp_plant":                         {emission: 0, opacity: 1},
+	"minecraft:dried_kelp_block":                   {emission: 0, opacity: 15},
+	"minecraft:turtle_egg":                         {emission: 0, opacity: 0},
+	"minecraft:sniffer_egg":                        {emission: 0, opacity: 0},
+	"minecraft:dried_ghast":                        {emission: 0, opacity: 0},
+	"minecraft:dead_tube_coral_block":              {emission: 0, opacity: 15},
+	"minecraft:dead_brain_coral_block":             {emission: 0, opacity: 15},
+	"minecraft:dead_bubble_coral_block":            {emission: 0, opacity: 15},
+	"minecraft:dead_fire_coral_block":              {emission: 0, opacity: 15},
+	"minecraft:dead_horn_coral_block":              {emission: 0, opacity: 15},
+	"minecraft:tube_coral_block":                   {emission: 0, opacity: 15},
+	"minecraft:brain_coral_block":                  {emission: 0, opacity: 15},
+	"minecraft:bubble_coral_block":                 {emission: 0, opacity: 15},
+	"minecraft:fire_coral_block":                   {emission: 0, opacity: 15},
+	"minecraft:horn_coral_block":                   {emission: 0, opacity: 15},
+	"minecraft:dead_tube_coral":                    {emission: 0, opacity: 1},
+	"minecraft:dead_brain_coral":                   {emission: 0, opacity: 1},
+	"minecraft:dead_bubble_coral":                  {emission: 0, opacity: 1},
+	"minecraft:dead_fire_coral":                    {emission: 0, opacity: 1},
+	"minecraft:dead_horn_coral":                    {emission: 0, opacity: 1},
+	"minecraft:tube_coral":                         {emission: 0, opacity: 1},
+	"minecraft:brain_coral":                        {emission: 0, opacity: 1},
+	"minecraft:bubble_coral":                       {emission: 0, opacity: 1},
+	"minecraft:fire_coral":                         {emission: 0, opacity: 1},
+	"minecraft:horn_coral":                         {emission: 0, opacity: 1},
+	"minecraft:dead_tube_coral_fan":                {emission: 0, opacity: 1},
+	"minecraft:dead_brain_coral_fan":               {emission: 0, opacity: 1},
+	"minecraft:dead_bubble_coral_fan":              {emission: 0, opacity: 1},
+	"minecraft:dead_fire_coral_fan":                {emission: 0, opacity: 1},
+	"minecraft:dead_horn_coral_fan":                {emission: 0, opacity: 1},
+	"minecraft:tube_coral_fan":                     {emission: 0, opacity: 1},
+	"minecraft:brain_coral_fan":                    {emission: 0, opacity: 1},
+	"minecraft:bubble_coral_fan":                   {emission: 0, opacity: 1},
+	"minecraft:fire_coral_fan":                     {emission: 0, opacity: 1},
+	"minecraft:horn_coral_fan":                     {emission: 0, opacity: 1},
+	"minecraft:dead_tube_coral_wall_fan":           {emission: 0, opacity: 1},
+	"minecraft:dead_brain_coral_wall_fan":          {emission: 0, opacity: 1},
+	"minecraft:dead_bubble_coral_wall_fan":         {emission: 0, opacity: 1},
+	"minecraft:dead_fire_coral_wall_fan":           {emission: 0, opacity: 1},
+	"minecraft:dead_horn_coral_wall_fan":           {emission: 0, opacity: 1},
+	"minecraft:tube_coral_wall_fan":                {emission: 0, opacity: 1},
+	"minecraft:brain_coral_wall_fan":               {emission: 0, opacity: 1},
+	"minecraft:bubble_coral_wall_fan":              {emission: 0, opacity: 1},
+	"minecraft:fire_coral_wall_fan":                {emission: 0, opacity: 1},
+	"minecraft:horn_coral_wall_fan":                {emission: 0, opacity: 1},
+	"minecraft:sea_pickle":                         {emission: 6, opacity: 1},
+	"minecraft:blue_ice":                           {emission: 0, opacity: 15},
+	"minecraft:conduit":                            {emission: 15, opacity: 1},
+	"minecraft:bamboo_sapling":                     {emission: 0, opacity: 0},
+	"minecraft:bamboo":                             {emission: 0, opacity: 0},
+	"minecraft:potted_bamboo":                      {emission: 0, opacity: 0},
+	"minecraft:void_air":                           {emission: 0, opacity: 0},
+	"minecraft:cave_air":                           {emission: 0, opacity: 0},
+	"minecraft:bubble_column":                      {emission: 0, opacity: 1},
+	"minecraft:polished_granite_stairs":            {emission: 0, opacity: 0},
+	"minecraft:smooth_red_sandstone_stairs":        {emission: 0, opacity: 0},
+	"minecraft:mossy_stone_brick_stairs":           {emission: 0, opacity: 0},
+	"minecraft:polished_diorite_stairs":            {emission: 0, opacity: 0},
+	"minecraft:mossy_cobblestone_stairs":           {emission: 0, opacity: 0},
+	"minecraft:end_stone_brick_stairs":             {emission: 0, opacity: 0},
+	"minecraft:stone_stairs":                       {emission: 0, opacity: 0},
+	"minecraft:smooth_sandstone_stairs":            {emission: 0, opacity: 0},
+	"minecraft:smooth_quartz_stairs":               {emission: 0, opacity: 0},
+	"minecraft:granite_stairs":                     {emission: 0, opacity: 0},
+	"minecraft:andesite_stairs":                    {emission: 0, opacity: 0},
+	"minecraft:red_nether_brick_stairs":            {emission: 0, opacity: 0},
+	"minecraft:polished_andesite_stairs":           {emission: 0, opacity: 0},
+	"minecraft:diorite_stairs":                     {emission: 0, opacity: 0},
+	"minecraft:polished_granite_slab":              {emission: 0, opacity: 0},
+	"minecraft:smooth_red_sandstone_slab":          {emission: 0, opacity: 0},
+	"minecraft:mossy_stone_brick_slab":             {emission: 0, opacity: 0},
+	"minecraft:polished_diorite_slab":              {emission: 0, opacity: 0},
+	"minecraft:mossy_cobblestone_slab":             {emission: 0, opacity: 0},
+	"minecraft:end_stone_brick_slab":               {emission: 0, opacity: 0},
+	"minecraft:smooth_sandstone_slab":              {emission: 0, opacity: 0},
+	"minecraft:smooth_quartz_slab":                 {emission: 0, opacity: 0},
+	"minecraft:granite_slab":                       {emission: 0, opacity: 0},
+	"minecraft:andesite_slab":                      {emission: 0, opacity: 0},
+	"minecraft:red_nether_brick_slab":              {emission: 0, opacity: 0},
+	"minecraft:polished_andesite_slab":             {emission: 0, opacity: 0},
+	"minecraft:diorite_slab":                       {emission: 0, opacity: 0},
+	"minecraft:brick_wall":                         {emission: 0, opacity: 0},
+	"minecraft:prismarine_wall":                    {emission: 0, opacity: 0},
+	"minecraft:red_sandstone_wall":                 {emission: 0, opacity: 0},
+	"minecraft:mossy_stone_brick_wall":             {emission: 0, opacity: 0},
+	"minecraft:granite_wall":                       {emission: 0, opacity: 0},
+	"minecraft:stone_brick_wall":                   {emission: 0, opacity: 0},
+	"minecraft:mud_brick_wall":                     {emission: 0, opacity: 0},
+	"minecraft:nether_brick_wall":                  {emission: 0, opacity: 0},
+	"minecraft:andesite_wall":                      {emission: 0, opacity: 0},
+	"minecraft:red_nether_brick_wall":              {emission: 0, opacity: 0},
+	"minecraft:sandstone_wall":                     {emission: 0, opacity: 0},
+	"minecraft:end_stone_brick_wall":               {emission: 0, opacity: 0},
+	"minecraft:diorite_wall":                       {emission: 0, opacity: 0},
+	"minecraft:scaffolding":                        {emission: 0, opacity: 0},
+	"minecraft:loom":                               {emission: 0, opacity: 15},
+	"minecraft:barrel":                             {emission: 0, opacity: 15},
+	"minecraft:smoker":                             {emission: 0, opacity: 15},
+	"minecraft:blast_furnace":                      {emission: 0, opacity: 15},
+	"minecraft:cartography_table":                  {emission: 0, opacity: 15},
+	"minecraft:fletching_table":                    {emission: 0, opacity: 15},
+	"minecraft:grindstone":                         {emission: 0, opacity: 0},
+	"minecraft:lectern":                            {emission: 0, opacity: 0},
+	"minecraft:smithing_table":                     {emission: 0, opacity: 15},
+	"minecraft:stonecutter":                        {emission: 0, opacity: 0},
+	"minecraft:bell":                               {emission: 0, opacity: 0},
+	"minecraft:lantern":                            {emission: 15, opacity: 0},
+	"minecraft:soul_lantern":                       {emission: 10, opacity: 0},
+	"minecraft:campfire":                           {emission: 15, opacity: 0},
+	"minecraft:soul_campfire":                      {emission: 10, opacity: 0},
+	"minecraft:sweet_berry_bush":                   {emission: 0, opacity: 0},
+	"minecraft:warped_stem":                        {emission: 0, opacity: 15},
+	"minecraft:stripped_warped_stem":               {emission: 0, opacity: 15},
+	"minecraft:warped_hyphae":                      {emission: 0, opacity: 15},
+	"minecraft:stripped_warped_hyphae":             {emission: 0, opacity: 15},
+	"minecraft:warped_nylium":                      {emission: 0, opacity: 15},
+	"minecraft:warped_fungus":                      {emission: 0, opacity: 0},
+	"minecraft:warped_wart_block":                  {emission: 0, opacity: 15},
+	"minecraft:warped_roots":                       {emission: 0, opacity: 0},
+	"minecraft:nether_sprouts":                     {emission: 0, opacity: 0},
+	"minecraft:crimson_stem":                       {emission: 0, opacity: 15},
+	"minecraft:stripped_crimson_stem":              {emission: 0, opacity: 15},
+	"minecraft:crimson_hyphae":                     {emission: 0, opacity: 15},
+	"minecraft:stripped_crimson_hyphae":            {emission: 0, opacity: 15},
+	"minecraft:crimson_nylium":                     {emission: 0, opacity: 15},
+	"minecraft:crimson_fungus":                     {emission: 0, opacity: 0},
+	"minecraft:shroomlight":                        {emission: 15, opacity: 15},
+	"minecraft:weeping_vines":                      {emission: 0, opacity: 0},
+	"minecraft:weeping_vines_plant":                {emission: 0, opacity: 0},
+	"minecraft:twisting_vines":                     {emission: 0, opacity: 0},
+	"minecraft:twisting_vines_plant":               {emission: 0, opacity: 0},
+	"minecraft:crimson_roots":                      {emission: 0, opacity: 0},
+	"minecraft:crimson_planks":                     {emission: 0, opacity: 15},
+	"minecraft:warped_planks":                      {emission: 0, opacity: 15},
+	"minecraft:crimson_slab":                       {emission: 0, opacity: 0},
+	"minecraft:warped_slab":                        {emission: 0, opacity: 0},
+	"minecraft:crimson_pressure_plate":             {emission: 0, opacity: 0},
+	"minecraft:warped_pressure_plate":              {emission: 0, opacity: 0},
+	"minecraft:crimson_fence":                      {emission: 0, opacity: 0},
+	"minecraft:warped_fence":                       {emission: 0, opacity: 0},
+	"minecraft:crimson_trapdoor":                   {emission: 0, opacity: 0},
+	"minecraft:warped_trapdoor":                    {emission: 0, opacity: 0},
+	"minecraft:crimson_fence_gate":                 {emission: 0, opacity: 0},
+	"minecraft:warped_fence_gate":                  {emission: 0, opacity: 0},
+	"minecraft:crimson_stairs":                     {emission: 0, opacity: 0},
+	"minecraft:warped_stairs":                      {emission: 0, opacity: 0},
+	"minecraft:crimson_button":                     {emission: 0, opacity: 0},
+	"minecraft:warped_button":                      {emission: 0, opacity: 0},
+	"minecraft:crimson_door":                       {emission: 0, opacity: 0},
+	"minecraft:warped_door":                        {emission: 0, opacity: 0},
+	"minecraft:crimson_sign":                       {emission: 0, opacity: 0},
+	"minecraft:warped_sign":                        {emission: 0, opacity: 0},
+	"minecraft:crimson_wall_sign":                  {emission: 0, opacity: 0},
+	"minecraft:warped_wall_sign":                   {emission: 0, opacity: 0},
+	"minecraft:structure_block":                    {emission: 0, opacity: 15},
+	"minecraft:jigsaw":                             {emission: 0, opacity: 15},
+	"minecraft:test_block":                         {emission: 0, opacity: 15},
+	"minecraft:test_instance_block":                {emission: 0, opacity: 1},
+	"minecraft:composter":                          {emission: 0, opacity: 0},
+	"minecraft:target":                             {emission: 0, opacity: 15},
+	"minecraft:bee_nest":                           {emission: 0, opacity: 15},
+	"minecraft:beehive":                            {emission: 0, opacity: 15},
+	"minecraft:honey_block":                        {emission: 0, opacity: 1},
+	"minecraft:honeycomb_block":                    {emission: 0, opacity: 15},
+	"minecraft:netherite_block":                    {emission: 0, opacity: 15},
+	"minecraft:ancient_debris":                     {emission: 0, opacity: 15},
+	"minecraft:crying_obsidian":                    {emission: 10, opacity: 15},
+	"minecraft:respawn_anchor":                     {emission: 0, opacity: 15},
+	"minecraft:potted_crimson_fungus":              {emission: 0, opacity: 0},
+	"minecraft:potted_warped_fungus":               {emission: 0, opacity: 0},
+	"minecraft:potted_crimson_roots":               {emission: 0, opacity: 0},
+	"minecraft:potted_warped_roots":                {emission: 0, opacity: 0},
+	"minecraft:lodestone":                          {emission: 0, opacity: 15},
+	"minecraft:blackstone":                         {emission: 0, opacity: 15},
+	"minecraft:blackstone_stairs":                  {emission: 0, opacity: 0},
+	"minecraft:blackstone_wall":                    {emission: 0, opacity: 0},
+	"minecraft:blackstone_slab":                    {emission: 0, opacity: 0},
+	"minecraft:polished_blackstone":                {emission: 0, opacity: 15},
+	"minecraft:polished_blackstone_bricks":         {emission: 0, opacity: 15},
+	"minecraft:cracked_polished_blackstone_bricks": {emission: 0, opacity: 15},
+	"minecraft:chiseled_polished_blackstone":       {emission: 0, opacity: 15},
+	"minecraft:polished_blackstone_brick_slab":     {emission: 0, opacity: 0},
+	"minecraft:polished_blackstone_brick_stairs":   {emission: 0, opacity: 0},
+	"minecraft:polished_blackstone_brick_wall":     {emission: 0, opacity: 0},
+	"minecraft:gilded_blackstone":                  {emission: 0, opacity: 15},
+	"minecraft:polished_blackstone_stairs":         {emission: 0, opacity: 0},
+	"minecraft:polished_blackstone_slab":           {emission: 0, opacity: 0},
+	"minecraft:polished_blackstone_pressure_plate": {emission: 0, opacity: 0},
+	"minecraft:polished_blackstone_button":         {emission: 0, opacity: 0},
+	"minecraft:polished_blackstone_wall":           {emission: 0, opacity: 0},
+	"minecraft:chiseled_nether_bricks":             {emission: 0, opacity: 15},
+	"minecraft:cracked_nether_bricks":              {emission: 0, opacity: 15},
+	"minecraft:quartz_bricks":                      {emission: 0, opacity: 15},
+	"minecraft:candle":                             {emission: 0, opacity: 0},
+	"minecraft:white_candle":                       {emission: 0, opacity: 0},
+	"minecraft:orange_candle":                      {emission: 0, opacity: 0},
+	"minecraft:magenta_candle":                     {emission: 0, opacity: 0},
+	"minecraft:light_blue_candle":                  {emission: 0, opacity: 0},
+	"minecraft:yellow_candle":                      {emission: 0, opacity: 0},
+	"minecraft:lime_candle":                        {emission: 0, opacity: 0},
+	"minecraft:pink_candle":                        {emission: 0, opacity: 0},
+	"minecraft:gray_candle":                        {emission: 0, opacity: 0},
+	"minecraft:light_gray_candle":                  {emission: 0, opacity: 0},
+	"minecraft:cyan_candle":                        {emission: 0, opacity: 0},
+	"minecraft:purple_candle":                      {emission: 0, opacity: 0},
+	"minecraft:blue_candle":                        {emission: 0, opacity: 0},
+	"minecraft:brown_candle":                       {emission: 0, opacity: 0},
+	"minecraft:green_candle":                       {emission: 0, opacity: 0},
+	"minecraft:red_candle":                         {emission: 0, opacity: 0},
+	"minecraft:black_candle":                       {emission: 0, opacity: 0},
+	"minecraft:candle_cake":                        {emission: 0, opacity: 0},
+	"minecraft:white_candle_cake":                  {emission: 0, opacity: 0},
+	"minecraft:orange_candle_cake":                 {emission: 0, opacity: 0},
+	"minecraft:magenta_candle_cake":                {emission: 0, opacity: 0},
+	"minecraft:light_blue_candle_cake":             {emission: 0, opacity: 0},
+	"minecraft:yellow_candle_cake":                 {emission: 0, opacity: 0},
+	"minecraft:lime_candle_cake":                   {emission: 0, opacity: 0},
+	"minecraft:pink_candle_cake":                   {emission: 0, opacity: 0},
+	"minecraft:gray_candle_cake":                   {emission: 0, opacity: 0},
+	"minecraft:light_gray_candle_cake":             {emission: 0, opacity: 0},
+	"minecraft:cyan_candle_cake":                   {emission: 0, opacity: 0},
+	"minecraft:purple_candle_cake":                 {emission: 0, opacity: 0},
+	"minecraft:blue_candle_cake":                   {emission: 0, opacity: 0},
+	"minecraft:brown_candle_cake":                  {emission: 0, opacity: 0},
+	"minecraft:green_candle_cake":                  {emission: 0, opacity: 0},
+	"minecraft:red_candle_cake":                    {emission: 0, opacity: 0},
+	"minecraft:black_candle_cake":                  {emission: 0, opacity: 0},
+	"minecraft:amethyst_block":                     {emission: 0, opacity: 15},
+	"minecraft:budding_amethyst":                   {emission: 0, opacity: 15},
+	"minecraft:amethyst_cluster":                   {emission: 5, opacity: 0},
+	"minecraft:large_amethyst_bud":                 {emission: 4, opacity: 0},
+	"minecraft:medium_amethyst_bud":                {emission: 2, opacity: 0},
+	"minecraft:small_amethyst_bud":                 {emission: 1, opacity: 0},
+	"minecraft:tuff":                               {emission: 0, opacity: 15},
+	"minecraft:tuff_slab":                          {emission: 0, opacity: 0},
+	"minecraft:tuff_stairs":                        {emission: 0, opacity: 0},
+	"minecraft:tuff_wall":                          {emission: 0, opacity: 0},
+	"minecraft:polished_tuff":                      {emission: 0, opacity: 15},
+	"minecraft:polished_tuff_slab":                 {emission: 0, opacity: 0},
+	"minecraft:polished_tuff_stairs":               {emission: 0, opacity: 0},
+	"minecraft:polished_tuff_wall":                 {emission: 0, opacity: 0},
+	"minecraft:chiseled_tuff":                      {emission: 0, opacity: 15},
+	"minecraft:tuff_bricks":                        {emission: 0, opacity: 15},
+	"minecraft:tuff_brick_slab":                    {emission: 0, opacity: 0},
+	"minecraft:tuff_brick_stairs":                  {emission: 0, opacity: 0},
+	"minecraft:tuff_brick_wall":                    {emission: 0, opacity: 0},
+	"minecraft:chiseled_tuff_bricks":               {emission: 0, opacity: 15},
+	"minecraft:calcite":                            {emission: 0, opacity: 15},
+	"minecraft:tinted_glass":                       {emission: 0, opacity: 15},
+	"minecraft:powder_snow":                        {emission: 0, opacity: 1},
+	"minecraft:sculk_sensor":                       {emission: 1, opacity: 0},
+	"minecraft:calibrated_sculk_sensor":            {emission: 1, opacity: 0},
+	"minecraft:sculk":                              {emission: 0, opacity: 15},
+	"minecraft:sculk_vein":                         {emission: 0, opacity: 1},
+	"minecraft:sculk_catalyst":                     {emission: 6, opacity: 15},
+	"minecraft:sculk_shrieker":                     {emission: 0, opacity: 1},
+	"minecraft:copper_block":                       {emission: 0, opacity: 15},
+	"minecraft:exposed_copper":                     {emission: 0, opacity: 15},
+	"minecraft:weathered_copper":                   {emission: 0, opacity: 15},
+	"minecraft:oxidized_copper":                    {emission: 0, opacity: 15},
+	"minecraft:copper_ore":                         {emission: 0, opacity: 15},
+	"minecraft:deepslate_copper_ore":               {emission: 0, opacity: 15},
+	"minecraft:oxidized_cut_copper":                {emission: 0, opacity: 15},
+	"minecraft:weathered_cut_copper":               {emission: 0, opacity: 15},
+	"minecraft:exposed_cut_copper":                 {emission: 0, opacity: 15},
+	"minecraft:cut_copper":                         {emission: 0, opacity: 15},
+	"minecraft:oxidized_chiseled_copper":           {emission: 0, opacity: 15},
+	"minecraft:weathered_chiseled_copper":          {emission: 0, opacity: 15},
+	"minecraft:exposed_chiseled_copper":            {emission: 0, opacity: 15},
+	"minecraft:chiseled_copper":                    {emission: 0, opacity: 15},
+	"minecraft:waxed_oxidized_chiseled_copper":     {emission: 0, opacity: 15},
+	"minecraft:waxed_weathered_chiseled_copper":    {emission: 0, opacity: 15},
+	"minecraft:waxed_exposed_chiseled_copper":      {emission: 0, opacity: 15},
+	"minecraft:waxed_chiseled_copper":              {emission: 0, opacity: 15},
+	"minecraft:oxidized_cut_copper_stairs":         {emission: 0, opacity: 0},
+	"minecraft:weathered_cut_copper_stairs":        {emission: 0, opacity: 0},
+	"minecraft:exposed_cut_copper_stairs":          {emission: 0, opacity: 0},
+	"minecraft:cut_copper_stairs":                  {emission: 0, opacity: 0},
+	"minecraft:oxidized_cut_copper_slab":           {emission: 0, opacity: 0},
+	"minecraft:weathered_cut_copper_slab":          {emission: 0, opacity: 0},
+	"minecraft:exposed_cut_copper_slab":            {emission: 0, opacity: 0},
+	"minecraft:cut_copper_slab":                    {emission: 0, opacity: 0},
+	"minecraft:waxed_copper_block":                 {emission: 0, opacity: 15},
+	"minecraft:waxed_weathered_copper":             {emission: 0, opacity: 15},
+	"minecraft:waxed_exposed_copper":               {emission: 0, opacity: 15},
+	"minecraft:waxed_oxidized_copper":              {emission: 0, opacity: 15},
+	"minecraft:waxed_oxidized_cut_copper":          {emission: 0, opacity: 15},
+	"minecraft:waxed_weathered_cut_copper":         {emission: 0, opacity: 15},
+	"minecraft:waxed_exposed_cut_copper":           {emission: 0, opacity: 15},
+	"minecraft:waxed_cut_copper":                   {emission: 0, opacity: 15},
+	"minecraft:waxed_oxidized_cut_copper_stairs":   {emission: 0, opacity: 0},
+	"minecraft:waxed_weathered_cut_copper_stairs":  {emission: 0, opacity: 0},
+	"minecraft:waxed_exposed_cut_copper_stairs":    {emission: 0, opacity: 0},
+	"minecraft:waxed_cut_copper_stairs":            {emission: 0, opacity: 0},
+	"minecraft:waxed_oxidized_cut_copper_slab":     {emission: 0, opacity: 0},
+	"minecraft:waxed_weathered_cut_copper_slab":    {emission: 0, opacity: 0},
+	"minecraft:waxed_exposed_cut_copper_slab":      {emission: 0, opacity: 0},
+	"minecraft:waxed_cut_copper_slab":              {emission: 0, opacity: 0},
+	"minecraft:copper_door":                        {emission: 0, opacity: 0},
+	"minecraft:exposed_copper_door":                {emission: 0, opacity: 0},
+	"minecraft:oxidized_copper_door":               {emission: 0, opacity: 0},
+	"minecraft:weathered_copper_door":              {emission: 0, opacity: 0},
+	"minecraft:waxed_copper_door":                  {emission: 0, opacity: 0},
+	"minecraft:waxed_exposed_copper_door":          {emission: 0, opacity: 0},
+	"minecraft:waxed_oxidized_copper_door":         {emission: 0, opacity: 0},
+	"minecraft:waxed_weathered_copper_door":        {emission: 0, opacity: 0},
+	"minecraft:copper_trapdoor":                    {emission: 0, opacity: 0},
+	"minecraft:exposed_copper_trapdoor":            {emission: 0, opacity: 0},
+	"minecraft:oxidized_copper_trapdoor":           {emission: 0, opacity: 0},
+	"minecraft:weathered_copper_trapdoor":          {emission: 0, opacity: 0},
+	"minecraft:waxed_copper_trapdoor":              {emission: 0, opacity: 0},
+	"minecraft:waxed_exposed_copper_trapdoor":      {emission: 0, opacity: 0},
+	"minecraft:waxed_oxidized_copper_trapdoor":     {emission: 0, opacity: 0},
+	"minecraft:waxed_weathered_copper_trapdoor":    {emission: 0, opacity: 0},
+	"minecraft:copper_grate":                       {emission: 0, opacity: 0},
+	"minecraft:exposed_copper_grate":               {emission: 0, opacity: 0},
+	"minecraft:weathered_copper_grate":             {emission: 0, opacity: 0},
+	"minecraft:oxidized_copper_grate":              {emission: 0, opacity: 0},
+	"minecraft:waxed_copper_grate":                 {emission: 0, opacity: 0},
+	"minecraft:waxed_exposed_copper_grate":         {emission: 0, opacity: 0},
+	"minecraft:waxed_weathered_copper_grate":       {emission: 0, opacity: 0},
+	"minecraft:waxed_oxidized_copper_grate":        {emission: 0, opacity: 0},
+	"minecraft:copper_bulb":                        {emission: 0, opacity: 15},
+	"minecraft:exposed_copper_bulb":                {emission: 0, opacity: 15},
+	"minecraft:weathered_copper_bulb":              {emission: 0, opacity: 15},
+	"minecraft:oxidized_copper_bulb":               {emission: 0, opacity: 15},
+	"minecraft:waxed_copper_bulb":                  {emission: 0, opacity: 15},
+	"minecraft:waxed_exposed_copper_bulb":          {emission: 0, opacity: 15},
+	"minecraft:waxed_weathered_copper_bulb":        {emission: 0, opacity: 15},
+	"minecraft:waxed_oxidized_copper_bulb":         {emission: 0, opacity: 15},
+	"minecraft:lightning_rod":                      {emission: 0, opacity: 0},
+	"minecraft:pointed_dripstone":                  {emission: 0, opacity: 0},
+	"minecraft:dripstone_block":                    {emission: 0, opacity: 15},
+	"minecraft:cave_vines":                         {emission: 0, opacity: 0},
+	"minecraft:cave_vines_plant":                   {emission: 0, opacity: 0},
+	"minecraft:spore_blossom":                      {emission: 0, opacity: 0},
+	"minecraft:azalea":                             {emission: 0, opacity: 0},
+	"minecraft:flowering_azalea":                   {emission: 0, opacity: 0},
+	"minecraft:moss_carpet":                        {emission: 0, opacity: 0},
+	"minecraft:pink_petals":                        {emission: 0, opacity: 0},
+	"minecraft:wildflowers":                        {emission: 0, opacity: 0},
+	"minecraft:leaf_litter":                        {emission: 0, opacity: 0},
+	"minecraft:moss_block":                         {emission: 0, opacity: 15},
+	"minecraft:big_dripleaf":                       {emission: 0, opacity: 0},
+	"minecraft:big_dripleaf_stem":                  {emission: 0, opacity: 0},
+	"minecraft:small_dripleaf":                     {emission: 0, opacity: 0},
+	"minecraft:hanging_roots":                      {emission: 0, opacity: 0},
+	"minecraft:rooted_dirt":                        {emission: 0, opacity: 15},
+	"minecraft:mud":                                {emission: 0, opacity: 15},
+	"minecraft:deepslate":                          {emission: 0, opacity: 15},
+	"minecraft:cobbled_deepslate":                  {emission: 0, opacity: 15},
+	"minecraft:cobbled_deepslate_stairs":           {emission: 0, opacity: 0},
+	"minecraft:cobbled_deepslate_slab":             {emission: 0, opacity: 0},
+	"minecraft:cobbled_deepslate_wall":             {emission: 0, opacity: 0},
+	"minecraft:polished_deepslate":                 {emission: 0, opacity: 15},
+	"minecraft:polished_deepslate_stairs":          {emission: 0, opacity: 0},
+	"minecraft:polished_deepslate_slab":            {emission: 0, opacity: 0},
+	"minecraft:polished_deepslate_wall":            {emission: 0, opacity: 0},
+	"minecraft:deepslate_tiles":                    {emission: 0, opacity: 15},
+	"minecraft:deepslate_tile_stairs":              {emission: 0, opacity: 0},
+	"minecraft:deepslate_tile_slab":                {emission: 0, opacity: 0},
+	"minecraft:deepslate_tile_wall":                {emission: 0, opacity: 0},
+	"minecraft:deepslate_bricks":                   {emission: 0, opacity: 15},
+	"minecraft:deepslate_brick_stairs":             {emission: 0, opacity: 0},
+	"minecraft:deepslate_brick_slab":               {emission: 0, opacity: 0},
+	"minecraft:deepslate_brick_wall":               {emission: 0, opacity: 0},
+	"minecraft:chiseled_deepslate":                 {emission: 0, opacity: 15},
+	"minecraft:cracked_deepslate_bricks":           {emission: 0, opacity: 15},
+	"minecraft:cracked_deepslate_tiles":            {emission: 0, opacity: 15},
+	"minecraft:infested_deepslate":                 {emission: 0, opacity: 15},
+	"minecraft:smooth_basalt":                      {emission: 0, opacity: 15},
+	"minecraft:raw_iron_block":                     {emission: 0, opacity: 15},
+	"minecraft:raw_copper_block":                   {emission: 0, opacity: 15},
+	"minecraft:raw_gold_block":                     {emission: 0, opacity: 15},
+	"minecraft:potted_azalea_bush":                 {emission: 0, opacity: 0},
+	"minecraft:potted_flowering_azalea_bush":       {emission: 0, opacity: 0},
+	"minecraft:ochre_froglight":                    {emission: 15, opacity: 15},
+	"minecraft:verdant_froglight":                  {emission: 15, opacity: 15},
+	"minecraft:pearlescent_froglight":              {emission: 15, opacity: 15},
+	"minecraft:frogspawn":                          {emission: 0, opacity: 0},
+	"minecraft:reinforced_deepslate":               {emission: 0, opacity: 15},
+	"minecraft:decorated_pot":                      {emission: 0, opacity: 0},
+	"minecraft:crafter":                            {emission: 0, opacity: 15},
+	"minecraft:trial_spawner":                      {emission: 0, opacity: 1},
+	"minecraft:vault":                              {emission: 6, opacity: 1},
+	"minecraft:heavy_core":                         {emission: 0, opacity: 0},
+	"minecraft:pale_moss_block":                    {emission: 0, opacity: 15},
+	"minecraft:pale_moss_carpet":                   {emission: 0, opacity: 0},
+	"minecraft:pale_hanging_moss":                  {emission: 0, opacity: 0},
+	"minecraft:open_eyeblossom":                    {emission: 0, opacity: 0},
+	"minecraft:closed_eyeblossom":                  {emission: 0, opacity: 0},
+	"minecraft:potted_open_eyeblossom":             {emission: 0, opacity: 0},
+	"minecraft:potted_closed_eyeblossom":           {emission: 0, opacity: 0},
+	"minecraft:firefly_bush":                       {emission: 2, opacity: 0},
+}
@@ -0,0 +1,181 @@
+package world
+
+import "testing"
+
+func mustStateID(t *testing.T, name string, properties map[string]string) uint16 {
+	t.Helper()
+
+	id, ok := StateID("minecraft:"+name, properties)
+	if !ok {
+		t.Fatalf("Unknown state %s %v", name, properties)
+	}
+
+	return id
+}
+
+func TestLightOf(t *testing.T) {
+	cases := []struct {
+		name       string
+		properties map[string]string
+		emission   uint8
+		opacity    uint8
+	}{
+		{"stone", nil, 0, 15},
+		{"glowstone", nil, 15, 15},
+		{"glass", nil, 0, 0},
+		{"oak_leaves", nil, 0, 1},
+		{"water", nil, 0, 1},
+		{"white_bed", nil, 0, 0},
+		{"poppy", nil, 0, 0},
+		{"wheat", nil, 0, 0},
+		{"beacon", nil, 15, 1},
+		{"nether_portal", nil, 11, 0},
+		{"conduit", nil, 15, 1},
+		{"ender_chest", nil, 7, 0},
+		{"small_amethyst_bud", nil, 1, 0},
+		{"furnace", map[string]string{"lit": "false"}, 0, 15},
+		{"furnace", map[string]string{"lit": "true"}, 13, 15},
+		{"campfire", map[string]string{"lit": "false"}, 0, 0},
+		{"white_candle", map[string]string{"lit": "true", "candles": "3"}, 9, 0},
+		{"sea_pickle", map[string]string{"waterlogged": "true", "pickles": "4"}, 15, 1},
+		{"sea_pickle", map[string]string{"waterlogged": "false"}, 0, 1},
+		{"light", map[string]string{"level": "7"}, 7, 0},
+		{"respawn_anchor", map[string]string{"charges": "2"}, 7, 15},
+		{"cave_vines", map[string]string{"berries": "true"}, 14, 0},
+		{"glow_lichen", map[string]string{"north": "true"}, 7, 0},
+		{"oak_slab", map[string]string{"type": "bottom"}, 0, 0},
+		{"oak_slab", map[string]string{"type": "double"}, 0, 15},
+		{"oak_slab", map[string]string{"waterlogged": "true"}, 0, 1},
+	}
+
+	for _, c := range cases {
+		props := lightOf(mustStateID(t, c.name, c.properties))
+		if props.emission != c.emission || props.opacity != c.opacity {
+			t.Fatalf("%s %v emits %d and absorbs %d instead of %d and %d", c.name, c.properties, props.emission, props.opacity, c.emission, c.opacity)
+		}
+	}
+}
+
+// testLightWorld returns a superflat world, the grass block being at
+// y = -61.
+func testLightWorld(t *testing.T) *World {
+	t.Helper()
+
+	gen, err := NewFlat(DefaultFlatPreset, -64, 384)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w := New("minecraft:overworld", -64, 384, gen)
+	t.Cleanup(func() { w.Close() })
+
+	return w
+}
+
+func expectLight(t *testing.T, w *World, kind lightKind, x int, y int, z int, level uint8) {
+	t.Helper()
+
+	chunk, err := w.Chunk(int32(x>>4), int32(z>>4))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := chunk.light(kind, x, y, z); got != level {
+		t.Fatalf("Light %d at %d %d %d is %d instead of %d", kind, x, y, z, got, level)
+	}
+}
+
+func TestLightChunk(t *testing.T) {
+	w := testLightWorld(t)
+
+	// The sky lights everything down to the grass
+	expectLight(t, w, skyLight, 3, 100, 3, MaxLight)
+	expectLight(t, w, skyLight, 3, -60, 3, MaxLight)
+	expectLight(t, w, skyLight, 3, -61, 3, 0)
+	expectLight(t, w, blockLight, 3, -60, 3, 0)
+
+	// A glowstone on the border of a chunk lights the next chunk once it
+	// is loaded
+	glowstone := mustStateID(t, "glowstone", nil)
+	if err := w.SetBlock(15, -50, 3, glowstone); err != nil {
+		t.Fatal(err)
+	}
+
+	expectLight(t, w, blockLight, 15, -50, 3, 15)
+	expectLight(t, w, blockLight, 14, -50, 3, 14)
+	expectLight(t, w, blockLight, 16, -50, 3, 14)
+	expectLight(t, w, blockLight, 20, -50, 3, 10)
+
+	// A roof in the next chunk darkens what is under it, the sky light
+	// coming in from the sides
+	stone := mustStateID(t, "stone", nil)
+	for x := 16; x < 32; x++ {
+		for z := 0; z < 16; z++ {
+			w.SetBlock(x, -55, z, stone)
+		}
+	}
+
+	chunk, err := w.Chunk(1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w.lightLock.Lock()
+	w.lightChunk(chunk, make(map[[2]int32]bool))
+	w.lightLock.Unlock()
+
+	expectLight(t, w, skyLight, 24, -54, 8, MaxLight)
+	expectLight(t, w, skyLight, 24, -55, 8, 0)
+	expectLight(t, w, skyLight, 16, -56, 8, MaxLight-1)
+	expectLight(t, w, skyLight, 18, -56, 8, MaxLight-3)
+	expectLight(t, w, blockLight, 16, -50, 3, 14)
+}
+
+func TestRelightAcrossChunks(t *testing.T) {
+	w := testLightWorld(t)
+
+	for x := range int32(2) {
+		if _, err := w.Chunk(x, 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var changed [][2]int32
+	w.OnLightChanged(func(x int32, z int32) {
+		changed = append(changed, [2]int32{x, z})
+	})
+
+	torch := mustStateID(t, "torch", nil)
+	if err := w.SetBlock(15, -60, 8, torch); err != nil {
+		t.Fatal(err)
+	}
+
+	expectLight(t, w, blockLight, 15, -60, 8, 14)
+	expectLight(t, w, blockLight, 17, -60, 8, 12)
+
+	if len(changed) != 2 {
+		t.Fatalf("Light changed in chunks %v", changed)
+	}
+
+	// Removing the torch darkens both chunks
+	if err := w.SetBlock(15, -60, 8, Air); err != nil {
+		t.Fatal(err)
+	}
+
+	expectLight(t, w, blockLight, 15, -60, 8, 0)
+	expectLight(t, w, blockLight, 17, -60, 8, 0)
+
+	// A block over a column shades it, the light coming back once removed
+	stone := mustStateID(t, "stone", nil)
+	if err := w.SetBlock(16, -59, 8, stone); err != nil {
+		t.Fatal(err)
+	}
+
+	expectLight(t, w, skyLight, 16, -60, 8, MaxLight-1)
+
+	if err := w.SetBlock(16, -59, 8, Air); err != nil {
+		t.Fatal(err)
+	}
+
+	expectLight(t, w, skyLight, 16, -60, 8, MaxLight)
+}
@@ -0,0 +1,137 @@
+package world
+
+import (
+	"bytes"
+	"errors"
+
+	"encoding/binary"
+)
+
+// Chunks compressed with LZ4 are written with the LZ4BlockOutputStream of
+// lz4-java: a sequence of blocks, each with its own header, ended by an
+// empty one.
+var lz4Magic = []byte("LZ4Block")
+
+const (
+	lz4Raw        = 0x10
+	lz4Compressed = 0x20
+	lz4HeaderSize = 8 + 1 + 4 + 4 + 4
+)
+
+func decompressLZ4(src []byte) ([]byte, error) {
+	out := make([]byte, 0, len(src)*4)
+
+	for len(src) > 0 {
+		if len(src) < lz4HeaderSize || !bytes.Equal(src[:8], lz4Magic) {
+			return []byte{}, errors.New("lz4: bad block header")
+		}
+
+		method := src[8] & 0xf0
+		compressed := int(binary.LittleEndian.Uint32(src[9:13]))
+		decompressed := int(binary.LittleEndian.Uint32(src[13:17]))
+		src = src[lz4HeaderSize:]
+
+		if decompressed == 0 {
+			break
+		}
+
+		if compressed > len(src) {
+			return []byte{}, errors.New("lz4: block is truncated")
+		}
+
+		switch method {
+		case lz4Raw:
+			out = append(out, src[:compressed]...)
+		case lz4Compressed:
+			block, err := decompressLZ4Block(src[:compressed], decompressed)
+			if err != nil {
+				return []byte{}, err
+			}
+
+			out = append(out, block...)
+		default:
+			return []byte{}, errors.New("lz4: unknown compression method")
+		}
+
+		src = src[compressed:]
+	}
+
+	return out, nil
+}
+
+// decompressLZ4Block decodes a raw LZ4 block: sequences of literals followed
+// by a match copied from the output already decoded.
+func decompressLZ4Block(src []byte, size int) ([]byte, error) {
+	dst := make([]byte, 0, size)
+	i := 0
+
+	readLength := func(length int) (int, error) {
+		if length != 15 {
+			return length, nil
+		}
+
+		for {
+			if i >= len(src) {
+				return 0, errors.New("lz4: unexpected end of block")
+			}
+
+			b := src[i]
+			i += 1
+			length += int(b)
+
+			if b != 255 {
+				return length, nil
+			}
+		}
+	}
+
+	for i < len(src) {
+		token := src[i]
+		i += 1
+
+		literals, err := readLength(int(token >> 4))
+		if err != nil {
+			return []byte{}, err
+		}
+
+		if i+literals > len(src) {
+			return []byte{}, errors.New("lz4: literals out of block")
+		}
+
+		dst = append(dst, src[i:i+literals]...)
+		i += literals
+
+		// The last sequence only has literals
+		if i >= len(src) {
+			break
+		}
+
+		if i+2 > len(src) {
+			return []byte{}, errors.New("lz4: unexpected end of block")
+		}
+
+		offset := int(src[i]) | int(src[i+1])<<8
+		i += 2
+
+		if offset == 0 || offset > len(dst) {
+			return []byte{}, errors.New("lz4: match offset out of output")
+		}
+
+		length, err := readLength(int(token & 0x0f))
+		if err != nil {
+			return []byte{}, err
+		}
+
+		// Matches may overlap the bytes they produce
+		start := len(dst) - offset
+		for j := range length + 4 {
+			dst = append(dst, dst[start+j])
+		}
+	}
+
+	if len(dst) != size {
+		return []byte{}, errors.New("lz4: decompressed size mismatch")
+	}
+
+	return dst, nil
+}
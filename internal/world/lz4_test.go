@@ -0,0 +1,111 @@
+package world
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+)
+
+type lz4TestBlock struct {
+	method byte
+	size   int
+	data   []byte
+}
+
+// lz4Stream wraps blocks as the LZ4BlockOutputStream of lz4-java does, the
+// checksums being left at zero as they aren't checked.
+func lz4Stream(blocks ...lz4TestBlock) []byte {
+	var stream []byte
+	for _, block := range blocks {
+		stream = append(stream, lz4Magic...)
+		stream = append(stream, block.method)
+		stream = binary.LittleEndian.AppendUint32(stream, uint32(len(block.data)))
+		stream = binary.LittleEndian.AppendUint32(stream, uint32(block.size))
+		stream = binary.LittleEndian.AppendUint32(stream, 0)
+		stream = append(stream, block.data...)
+	}
+
+	// The empty block ending the stream
+	stream = append(stream, lz4Magic...)
+	stream = append(stream, lz4Raw)
+	return append(stream, make([]byte, 12)...)
+}
+
+func TestLZ4Block(t *testing.T) {
+	// Three literals, then a match of nine bytes overlapping its own
+	// output, then the last literal
+	block := []byte{0x35, 'a', 'b', 'c', 3, 0, 0x10, 'X'}
+
+	data, err := decompressLZ4Block(block, 13)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(data) != "abcabcabcabcX" {
+		t.Fatalf("Decoded %q", data)
+	}
+}
+
+func TestLZ4LongLengths(t *testing.T) {
+	literals := bytes.Repeat([]byte("0123456789"), 3)
+
+	// 15 in the token goes on in the next bytes, up to a byte under 255
+	block := append([]byte{0xff, byte(len(literals) - 15)}, literals...)
+	block = append(block, 10, 0, 255, 6)
+
+	data, err := decompressLZ4Block(block, len(literals)+15+255+6+4)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := append(bytes.Clone(literals), bytes.Repeat([]byte("0123456789"), 28)...)
+	if !bytes.Equal(data, want) {
+		t.Fatalf("Decoded %d bytes: %q", len(data), data)
+	}
+}
+
+func TestLZ4Stream(t *testing.T) {
+	stream := lz4Stream(
+		lz4TestBlock{lz4Compressed, 13, []byte{0x35, 'a', 'b', 'c', 3, 0, 0x10, 'X'}},
+		lz4TestBlock{lz4Raw, 3, []byte("raw")},
+	)
+
+	data, err := decompress(compressionLZ4, stream)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(data) != "abcabcabcabcXraw" {
+		t.Fatalf("Decoded %q", data)
+	}
+}
+
+func TestLZ4Errors(t *testing.T) {
+	blocks := map[string][]byte{
+		"offset out of output": {0x10, 'a', 5, 0},
+		"zero offset":          {0x10, 'a', 0, 0},
+		"literals out":         {0x50, 'a'},
+		"missing offset":       {0x10, 'a', 1},
+	}
+
+	for name, block := range blocks {
+		if _, err := decompressLZ4Block(block, 16); err == nil {
+			t.Fatalf("%s: accepted", name)
+		}
+	}
+
+	if _, err := decompressLZ4Block([]byte{0x10, 'a'}, 2); err == nil {
+		t.Fatal("Accepted a block shorter than announced")
+	}
+
+	if _, err := decompressLZ4([]byte("LZ4Blocx")); err == nil {
+		t.Fatal("Accepted a bad header")
+	}
+
+	// The block announces more bytes than the stream has
+	truncated := lz4Stream(lz4TestBlock{lz4Raw, 10, []byte("raw")})
+	binary.LittleEndian.PutUint32(truncated[9:], 10)
+	if _, err := decompressLZ4(truncated[:lz4HeaderSize+3]); err == nil {
+		t.Fatal("Accepted a truncated block")
+	}
+}
@@ -0,0 +1,228 @@
+package world
+
+import (
+	"github.com/beito123/nbt"
+)
+
+// Helpers to walk NBT trees, missing or mistyped tags read as zero values.
+
+func child(tag nbt.Tag, name string) nbt.Tag {
+	c, ok := tag.(*nbt.Compound)
+	if !ok || c == nil {
+		return nil
+	}
+
+	return c.Value[name]
+}
+
+func compoundOf(tag nbt.Tag, name string) nbt.Tag {
+	t, ok := child(tag, name).(*nbt.Compound)
+	if !ok {
+		return nil
+	}
+
+	return t
+}
+
+func listOf(tag nbt.Tag, name string) []nbt.Tag {
+	t, ok := child(tag, name).(*nbt.List)
+	if !ok {
+		return []nbt.Tag{}
+	}
+
+	return t.Value
+}
+
+func intOf(tag nbt.Tag, name string) int {
+	t := child(tag, name)
+	if t == nil {
+		return 0
+	}
+
+	v, err := t.ToInt()
+	if err != nil {
+		return 0
+	}
+
+	return v
+}
+
+func longOf(tag nbt.Tag, name string) int64 {
+	t := child(tag, name)
+	if t == nil {
+		return 0
+	}
+
+	v, err := t.ToInt64()
+	if err != nil {
+		return 0
+	}
+
+	return v
+}
+
+func floatOf(tag nbt.Tag, name string) float64 {
+	t := child(tag, name)
+	if t == nil {
+		return 0
+	}
+
+	v, err := t.ToFloat64()
+	if err != nil {
+		return 0
+	}
+
+	return v
+}
+
+// floatsOf reads a list of floats or doubles.
+func floatsOf(tag nbt.Tag, name string) []float64 {
+	list := listOf(tag, name)
+
+	values := make([]float64, 0, len(list))
+	for _, t := range list {
+		v, err := t.ToFloat64()
+		if err != nil {
+			return nil
+		}
+
+		values = append(values, v)
+	}
+
+	return values
+}
+
+func stringOf(tag nbt.Tag, name string) string {
+	t := child(tag, name)
+	if t == nil {
+		return ""
+	}
+
+	v, err := t.ToString()
+	if err != nil {
+		return ""
+	}
+
+	return v
+}
+
+func bytesOf(tag nbt.Tag, name string) []byte {
+	t, ok := child(tag, name).(*nbt.ByteArray)
+	if !ok {
+		return nil
+	}
+
+	v, err := t.ToByteArray()
+	if err != nil {
+		return nil
+	}
+
+	return v
+}
+
+func longsOf(tag nbt.Tag, name string) []int64 {
+	t, ok := child(tag, name).(*nbt.LongArray)
+	if !ok {
+		return nil
+	}
+
+	return t.Value
+}
+
+func readNBT(data []byte) (nbt.Tag, error) {
+	stream, err := nbt.FromBytes(data, nbt.BigEndian)
+	if err != nil {
+		return nil, err
+	}
+
+	return stream.ReadTag()
+}
+
+func nbtFromFile(path string) (*nbt.Stream, error) {
+	return nbt.FromFile(path, nbt.BigEndian)
+}
+
+// longArray writes the length of the array as an int, the nbt package
+// writing it as a long.
+type longArray struct {
+	*nbt.LongArray
+}
+
+// byteArray writes the length of the array, which the nbt package leaves
+// out.
+type byteArray struct {
+	*nbt.ByteArray
+}
+
+func (self byteArray) Write(n *nbt.Stream) error {
+	if err := n.Stream.PutInt(int32(len(self.Value))); err != nil {
+		return err
+	}
+
+	return n.Stream.Put(self.Value)
+}
+
+// Writable returns tag with its byte and long arrays, at any depth,
+// replaced by ones written correctly, as tags read from a file or made with
+// the nbt package are not.
+func Writable(tag nbt.Tag) nbt.Tag {
+	switch t := tag.(type) {
+	case *nbt.ByteArray:
+		return byteArray{t}
+	case *nbt.LongArray:
+		return longArray{t}
+	case *nbt.List:
+		values := make([]nbt.Tag, len(t.Value))
+		for i, v := range t.Value {
+			values[i] = Writable(v)
+		}
+
+		return nbt.NewListTag(t.Name(), values, t.ListType)
+	case *nbt.Compound:
+		values := make(map[string]nbt.Tag, len(t.Value))
+		for k, v := range t.Value {
+			values[k] = Writable(v)
+		}
+
+		return nbt.NewCompoundTag(t.Name(), values)
+	default:
+		return tag
+	}
+}
+
+func newLongArray(name string, values []int64) nbt.Tag {
+	return longArray{nbt.NewLongArrayTag(name, values)}
+}
+
+func (self longArray) Write(n *nbt.Stream) error {
+	if err := n.Stream.PutInt(int32(len(self.Value))); err != nil {
+		return err
+	}
+
+	for _, v := range self.Value {
+		if err := n.Stream.PutLong(v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeNBT(tag nbt.Tag) ([]byte, error) {
+	stream := nbt.NewStream(nbt.BigEndian)
+	if err := stream.WriteTag(Writable(tag)); err != nil {
+		return []byte{}, err
+	}
+
+	return stream.Bytes(), nil
+}
+
+// writeGzipNBT writes tag compressed as level.dat and player data files are.
+func writeGzipNBT(tag nbt.Tag) ([]byte, error) {
+	stream := nbt.NewStream(nbt.BigEndian)
+	if err := stream.WriteTag(Writable(tag)); err != nil {
+		return []byte{}, err
+	}
+
+	return nbt.Compress(stream, nbt.CompressGZip, nbt.DefaultCompressionLevel)
+}
@@ -0,0 +1,78 @@
+package world
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/beito123/nbt"
+)
+
+func TestArraysRoundTrip(t *testing.T) {
+	longs := []int64{1, -2, 1 << 40}
+	raw := []byte{1, 2, 3, 4, 5}
+
+	root := nbt.NewCompoundTag("", map[string]nbt.Tag{
+		"bytes": nbt.NewByteArrayTag("bytes", raw),
+		"longs": nbt.NewLongArrayTag("longs", longs),
+		"ints":  nbt.NewIntArrayTag("ints", []int32{7, 8}),
+		"sections": nbt.NewListTag("sections", []nbt.Tag{
+			nbt.NewCompoundTag("", map[string]nbt.Tag{
+				"SkyLight": nbt.NewByteArrayTag("SkyLight", raw),
+				"data":     nbt.NewLongArrayTag("data", longs),
+			}),
+		}, nbt.IDTagCompound),
+		// Tags after the arrays are only read back when the lengths are
+		// right
+		"after": nbt.NewStringTag("after", "end"),
+	})
+
+	data, err := writeNBT(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tag, err := readNBT(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := bytesOf(tag, "bytes"); !slices.Equal(got, raw) {
+		t.Fatalf("Byte array is %v", got)
+	}
+
+	if got := longsOf(tag, "longs"); !slices.Equal(got, longs) {
+		t.Fatalf("Long array is %v", got)
+	}
+
+	if got, ok := child(tag, "ints").(*nbt.IntArray); !ok || !slices.Equal(got.Value, []int32{7, 8}) {
+		t.Fatalf("Int array is %v", child(tag, "ints"))
+	}
+
+	sections := listOf(tag, "sections")
+	if len(sections) != 1 {
+		t.Fatalf("Read %d sections", len(sections))
+	}
+
+	if got := bytesOf(sections[0], "SkyLight"); !slices.Equal(got, raw) {
+		t.Fatalf("Nested byte array is %v", got)
+	}
+
+	if got := longsOf(sections[0], "data"); !slices.Equal(got, longs) {
+		t.Fatalf("Nested long array is %v", got)
+	}
+
+	if got := stringOf(tag, "after"); got != "end" {
+		t.Fatalf("Tag after the arrays is %q", got)
+	}
+}
+
+func TestWritableKeepsTag(t *testing.T) {
+	array := nbt.NewByteArrayTag("bytes", []byte{1})
+	root := nbt.NewCompoundTag("", map[string]nbt.Tag{"bytes": array})
+
+	Writable(root)
+
+	if child(root, "bytes") != array {
+		t.Fatal("Writable changed the tag it was given")
+	}
+}
@@ -0,0 +1,175 @@
+package world
+
+import (
+	"math"
+)
+
+// random is a splitmix64 generator. Everything the generators draw comes
+// from it, so a seed always gives the same world.
+type random struct {
+	state uint64
+}
+
+func newRandom(seed int64) *random {
+	return &random{state: uint64(seed)}
+}
+
+// chunkRandom returns a generator specific to a chunk and a salt, to draw
+// the features of that chunk whatever the order chunks are generated in.
+func chunkRandom(seed int64, x int32, z int32, salt int64) *random {
+	r := newRandom(seed ^ salt)
+	r.state ^= uint64(int64(x)*341873128712 + int64(z)*132897987541)
+	r.next()
+
+	return r
+}
+
+func (self *random) next() uint64 {
+	self.state += 0x9e3779b97f4a7c15
+
+	z := self.state
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+
+	return z ^ (z >> 31)
+}
+
+// float returns a number in [0, 1)
+func (self *random) float() float64 {
+	return float64(self.next()>>11) / (1 << 53)
+}
+
+// intn returns a number in [0, n)
+func (self *random) intn(n int) int {
+	return int(self.next() % uint64(n))
+}
+
+// perlin is Ken Perlin's improved noise, with a permutation and an offset
+// drawn from the seed.
+type perlin struct {
+	perm       [512]uint8
+	ox, oy, oz float64
+}
+
+func newPerlin(r *random) *perlin {
+	p := &perlin{
+		ox: r.float() * 256,
+		oy: r.float() * 256,
+		oz: r.float() * 256,
+	}
+
+	for i := range 256 {
+		p.perm[i] = uint8(i)
+	}
+
+	for i := 255; i > 0; i-- {
+		j := r.intn(i + 1)
+		p.perm[i], p.perm[j] = p.perm[j], p.perm[i]
+	}
+
+	copy(p.perm[256:], p.perm[:256])
+	return p
+}
+
+func fade(t float64) float64 {
+	return t * t * t * (t*(t*6-15) + 10)
+}
+
+func lerp(t float64, a float64, b float64) float64 {
+	return a + t*(b-a)
+}
+
+func grad(hash uint8, x float64, y float64, z float64) float64 {
+	h := hash & 15
+
+	u := y
+	if h < 8 {
+		u = x
+	}
+
+	v := z
+	if h < 4 {
+		v = y
+	} else if h == 12 || h == 14 {
+		v = x
+	}
+
+	if h&1 != 0 {
+		u = -u
+	}
+
+	if h&2 != 0 {
+		v = -v
+	}
+
+	return u + v
+}
+
+// sample returns the noise at x, y, z, roughly within [-1, 1].
+func (self *perlin) sample(x float64, y float64, z float64) float64 {
+	x += self.ox
+	y += self.oy
+	z += self.oz
+
+	fx, fy, fz := math.Floor(x), math.Floor(y), math.Floor(z)
+	X, Y, Z := int(fx)&255, int(fy)&255, int(fz)&255
+	x, y, z = x-fx, y-fy, z-fz
+
+	u, v, w := fade(x), fade(y), fade(z)
+	p := &self.perm
+
+	A := int(p[X]) + Y
+	AA := int(p[A]) + Z
+	AB := int(p[A+1]) + Z
+	B := int(p[X+1]) + Y
+	BA := int(p[B]) + Z
+	BB := int(p[B+1]) + Z
+
+	return lerp(w,
+		lerp(v,
+			lerp(u, grad(p[AA], x, y, z), grad(p[BA], x-1, y, z)),
+			lerp(u, grad(p[AB], x, y-1, z), grad(p[BB], x-1, y-1, z)),
+		),
+		lerp(v,
+			lerp(u, grad(p[AA+1], x, y, z-1), grad(p[BA+1], x-1, y, z-1)),
+			lerp(u, grad(p[AB+1], x, y-1, z-1), grad(p[BB+1], x-1, y-1, z-1)),
+		),
+	)
+}
+
+// octaves sums perlin noises, each of twice the frequency and half the
+// amplitude of the previous one.
+type octaves struct {
+	layers []*perlin
+	scale  float64
+}
+
+func newOctaves(r *random, count int, scale float64) *octaves {
+	layers := make([]*perlin, count)
+	for i := range layers {
+		layers[i] = newPerlin(r)
+	}
+
+	return &octaves{layers: layers, scale: scale}
+}
+
+func (self *octaves) sample(x float64, y float64, z float64) float64 {
+	total := 0.0
+	amplitude := 1.0
+	frequency := 1.0 / self.scale
+	norm := 0.0
+
+	for _, p := range self.layers {
+		total += p.sample(x*frequency, y*frequency, z*frequency) * amplitude
+		norm += amplitude
+
+		amplitude /= 2
+		frequency *= 2
+	}
+
+	return total / norm
+}
+
+func (self *octaves) sample2D(x float64, z float64) float64 {
+	return self.sample(x, 0, z)
+}
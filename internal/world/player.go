@@ -0,0 +1,247 @@
+package world
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/beito123/nbt"
+)
+
+const playerFolder = "playerdata"
+
+// Health of a player who was never saved
+const defaultHealth = 20
+
+// PlayerData is what a world folder keeps of a player, in the
+// playerdata/<uuid>.dat file.
+type PlayerData struct {
+	X, Y, Z    float64
+	Yaw, Pitch float32
+	OnGround   bool
+	Dimension  string
+	GameMode   int
+	// PreviousGameMode is -1 when the game mode never changed
+	PreviousGameMode int
+	Health           float32
+	XpLevel          int
+	XpProgress       float32
+	XpTotal          int
+	SelectedSlot     int
+	// Inventory are the items of the hotbar and the main inventory, their
+	// Slot tag counting from 0 for the first slot of the hotbar
+	Inventory []nbt.Tag
+	// Equipment are the items worn and held in the off hand, by slot name:
+	// head, chest, legs, feet and offhand
+	Equipment map[string]nbt.Tag
+	// Respawn is nil when the player respawns at the world spawn
+	Respawn *Respawn
+	// Abilities is nil for the players saved without them
+	Abilities *Abilities
+}
+
+// Abilities are what the game mode of a player lets them do.
+type Abilities struct {
+	Flying       bool
+	MayFly       bool
+	Instabuild   bool
+	Invulnerable bool
+	MayBuild     bool
+	FlySpeed     float32
+	WalkSpeed    float32
+}
+
+// Respawn is the bed or the respawn anchor a player last used.
+type Respawn struct {
+	X, Y, Z   int
+	Angle     float32
+	Dimension string
+	Forced    bool
+}
+
+// NewPlayerData returns the data of a player joining for the first time.
+func NewPlayerData() PlayerData {
+	return PlayerData{
+		Dimension:        "minecraft:overworld",
+		PreviousGameMode: -1,
+		Health:           defaultHealth,
+		Equipment:        make(map[string]nbt.Tag),
+	}
+}
+
+func playerFromNBT(data nbt.Tag) PlayerData {
+	player := NewPlayerData()
+
+	if pos := floatsOf(data, "Pos"); len(pos) == 3 {
+		player.X, player.Y, player.Z = pos[0], pos[1], pos[2]
+	}
+
+	if rotation := floatsOf(data, "Rotation"); len(rotation) == 2 {
+		player.Yaw, player.Pitch = float32(rotation[0]), float32(rotation[1])
+	}
+
+	player.OnGround = intOf(data, "OnGround") != 0
+	if dimension := stringOf(data, "Dimension"); dimension != "" {
+		player.Dimension = dimension
+	}
+
+	player.GameMode = intOf(data, "playerGameType")
+	if child(data, "previousPlayerGameType") != nil {
+		player.PreviousGameMode = intOf(data, "previousPlayerGameType")
+	}
+
+	if child(data, "Health") != nil {
+		player.Health = float32(floatOf(data, "Health"))
+	}
+
+	player.XpLevel = intOf(data, "XpLevel")
+	player.XpProgress = float32(floatOf(data, "XpP"))
+	player.XpTotal = intOf(data, "XpTotal")
+	player.SelectedSlot = intOf(data, "SelectedItemSlot")
+	player.Inventory = listOf(data, "Inventory")
+
+	if equipment, ok := compoundOf(data, "equipment").(*nbt.Compound); ok {
+		for k, v := range equipment.Value {
+			player.Equipment[k] = v
+		}
+	}
+
+	if respawn := compoundOf(data, "respawn"); respawn != nil {
+		pos, ok := child(respawn, "pos").(*nbt.IntArray)
+		if ok && len(pos.Value) == 3 {
+			player.Respawn = &Respawn{
+				X: int(pos.Value[0]), Y: int(pos.Value[1]), Z: int(pos.Value[2]),
+				Angle:     float32(floatOf(respawn, "angle")),
+				Dimension: stringOf(respawn, "dimension"),
+				Forced:    intOf(respawn, "forced") != 0,
+			}
+		}
+	}
+
+	if abilities := compoundOf(data, "abilities"); abilities != nil {
+		player.Abilities = &Abilities{
+			Flying:       intOf(abilities, "flying") != 0,
+			MayFly:       intOf(abilities, "mayfly") != 0,
+			Instabuild:   intOf(abilities, "instabuild") != 0,
+			Invulnerable: intOf(abilities, "invulnerable") != 0,
+			MayBuild:     intOf(abilities, "mayBuild") != 0,
+			FlySpeed:     float32(floatOf(abilities, "flySpeed")),
+			WalkSpeed:    float32(floatOf(abilities, "walkSpeed")),
+		}
+	}
+
+	return player
+}
+
+func boolTag(name string, v bool) nbt.Tag {
+	if v {
+		return nbt.NewByteTag(name, 1)
+	}
+
+	return nbt.NewByteTag(name, 0)
+}
+
+// encode writes the player into data, keeping the tags it doesn't know
+// about, as the vanilla server does: the attributes, the effects, the
+// food...
+func (self PlayerData) encode(data map[string]nbt.Tag) {
+	data["DataVersion"] = nbt.NewIntTag("DataVersion", DataVersion)
+	data["Pos"] = nbt.NewListTag("Pos", []nbt.Tag{
+		nbt.NewDoubleTag("", self.X), nbt.NewDoubleTag("", self.Y), nbt.NewDoubleTag("", self.Z),
+	}, nbt.IDTagDouble)
+	data["Rotation"] = nbt.NewListTag("Rotation", []nbt.Tag{
+		nbt.NewFloatTag("", self.Yaw), nbt.NewFloatTag("", self.Pitch),
+	}, nbt.IDTagFloat)
+	data["OnGround"] = boolTag("OnGround", self.OnGround)
+	data["Dimension"] = nbt.NewStringTag("Dimension", self.Dimension)
+	data["playerGameType"] = nbt.NewIntTag("playerGameType", int32(self.GameMode))
+	data["Health"] = nbt.NewFloatTag("Health", self.Health)
+	data["XpLevel"] = nbt.NewIntTag("XpLevel", int32(self.XpLevel))
+	data["XpP"] = nbt.NewFloatTag("XpP", self.XpProgress)
+	data["XpTotal"] = nbt.NewIntTag("XpTotal", int32(self.XpTotal))
+	data["SelectedItemSlot"] = nbt.NewIntTag("SelectedItemSlot", int32(self.SelectedSlot))
+	data["Inventory"] = nbt.NewListTag("Inventory", self.Inventory, nbt.IDTagCompound)
+	data["equipment"] = nbt.NewCompoundTag("equipment", self.Equipment)
+
+	delete(data, "previousPlayerGameType")
+	if self.PreviousGameMode >= 0 {
+		data["previousPlayerGameType"] = nbt.NewIntTag("previousPlayerGameType", int32(self.PreviousGameMode))
+	}
+
+	delete(data, "respawn")
+	if r := self.Respawn; r != nil {
+		data["respawn"] = nbt.NewCompoundTag("respawn", map[string]nbt.Tag{
+			"pos":       nbt.NewIntArrayTag("pos", []int32{int32(r.X), int32(r.Y), int32(r.Z)}),
+			"angle":     nbt.NewFloatTag("angle", r.Angle),
+			"dimension": nbt.NewStringTag("dimension", r.Dimension),
+			"forced":    boolTag("forced", r.Forced),
+		})
+	}
+
+	if a := self.Abilities; a != nil {
+		data["abilities"] = nbt.NewCompoundTag("abilities", map[string]nbt.Tag{
+			"flying":       boolTag("flying", a.Flying),
+			"mayfly":       boolTag("mayfly", a.MayFly),
+			"instabuild":   boolTag("instabuild", a.Instabuild),
+			"invulnerable": boolTag("invulnerable", a.Invulnerable),
+			"mayBuild":     boolTag("mayBuild", a.MayBuild),
+			"flySpeed":     nbt.NewFloatTag("flySpeed", a.FlySpeed),
+			"walkSpeed":    nbt.NewFloatTag("walkSpeed", a.WalkSpeed),
+		})
+	}
+}
+
+// ReadPlayerData reads the data of the player id, a UUID, from the world
+// folder dir. It returns nil when the player never joined the world.
+func ReadPlayerData(dir string, id string) (*PlayerData, error) {
+	stream, err := nbtFromFile(filepath.Join(dir, playerFolder, id+".dat"))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	root, err := stream.ReadTag()
+	if err != nil {
+		return nil, err
+	}
+
+	player := playerFromNBT(root)
+	return &player, nil
+}
+
+// WritePlayerData updates the data of the player id in the world folder
+// dir, creating the file when missing.
+func WritePlayerData(dir string, id string, player PlayerData) error {
+	if err := os.MkdirAll(filepath.Join(dir, playerFolder), 0755); err != nil {
+		return err
+	}
+
+	path := filepath.Join(dir, playerFolder, id+".dat")
+	data := make(map[string]nbt.Tag)
+
+	stream, err := nbtFromFile(path)
+	if err == nil {
+		root, err := stream.ReadTag()
+		if err != nil {
+			return err
+		}
+
+		if c, ok := root.(*nbt.Compound); ok {
+			for k, v := range c.Value {
+				data[k] = v
+			}
+		}
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	player.encode(data)
+
+	bytes, err := writeGzipNBT(nbt.NewCompoundTag("", data))
+	if err != nil {
+		return err
+	}
+
+	return writeFileAtomic(path, bytes)
+}
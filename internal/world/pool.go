@@ -0,0 +1,105 @@
+package world
+
+import "sync"
+
+type generation struct {
+	done  chan struct{}
+	chunk *Chunk
+}
+
+type generationJob struct {
+	x, z int32
+	gen  *generation
+}
+
+// Pool spreads the work of a generator over a fixed number of goroutines.
+// A chunk asked for several times while being generated is only made once,
+// the callers all getting the same result.
+type Pool struct {
+	gen     Generator
+	jobs    chan generationJob
+	lock    sync.Mutex
+	pending map[[2]int32]*generation
+	// closeLock is held while sending jobs, so that Close never closes
+	// jobs under a sender
+	closeLock sync.RWMutex
+	closed    bool
+}
+
+func NewPool(gen Generator, workers int) *Pool {
+	pool := &Pool{
+		gen:     gen,
+		jobs:    make(chan generationJob),
+		pending: make(map[[2]int32]*generation),
+	}
+
+	for range max(workers, 1) {
+		go pool.work()
+	}
+
+	return pool
+}
+
+func (self *Pool) work() {
+	for job := range self.jobs {
+		self.finish(job.x, job.z, job.gen, self.gen.Generate(job.x, job.z))
+	}
+}
+
+func (self *Pool) Generate(x int32, z int32) *Chunk {
+	key := [2]int32{x, z}
+
+	self.lock.Lock()
+	gen, ok := self.pending[key]
+	if !ok {
+		gen = &generation{done: make(chan struct{})}
+		self.pending[key] = gen
+	}
+	self.lock.Unlock()
+
+	if !ok {
+		self.closeLock.RLock()
+		if self.closed {
+			self.closeLock.RUnlock()
+
+			// The workers are gone, the caller makes the chunk itself
+			self.finish(x, z, gen, self.gen.Generate(x, z))
+			return gen.chunk
+		}
+
+		self.jobs <- generationJob{x: x, z: z, gen: gen}
+		self.closeLock.RUnlock()
+	}
+
+	<-gen.done
+	return gen.chunk
+}
+
+// finish hands a generated chunk to the callers waiting for it.
+func (self *Pool) finish(x int32, z int32, gen *generation, chunk *Chunk) {
+	gen.chunk = chunk
+
+	self.lock.Lock()
+	delete(self.pending, [2]int32{x, z})
+	self.lock.Unlock()
+
+	close(gen.done)
+}
+
+func (self *Pool) SpawnHeight(x int, z int) int {
+	return self.gen.SpawnHeight(x, z)
+}
+
+// Close stops the workers, the chunks asked for afterwards being generated
+// by their callers.
+func (self *Pool) Close() error {
+	self.closeLock.Lock()
+	defer self.closeLock.Unlock()
+
+	if !self.closed {
+		self.closed = true
+		close(self.jobs)
+	}
+
+	return nil
+}
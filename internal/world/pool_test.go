@@ -0,0 +1,73 @@
+package world
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"testing"
+)
+
+func TestPoolSameSeed(t *testing.T) {
+	const seed = 1234
+
+	a := NewPool(NewNoise(seed, -64, 384), 4)
+	defer a.Close()
+
+	b := NewPool(NewNoise(seed, -64, 384), 1)
+	defer b.Close()
+
+	for _, pos := range [][2]int32{{0, 0}, {3, -2}, {-17, 40}} {
+		first := a.Generate(pos[0], pos[1])
+		second := b.Generate(pos[0], pos[1])
+
+		if len(first.Sections) != len(second.Sections) {
+			t.Fatalf("Chunk %v has %d and %d sections", pos, len(first.Sections), len(second.Sections))
+		}
+
+		for i := range first.Sections {
+			if first.Sections[i].Blocks != second.Sections[i].Blocks {
+				t.Fatalf("Chunk %v differs in the blocks of section %d", pos, i)
+			}
+
+			if first.Sections[i].Biomes != second.Sections[i].Biomes {
+				t.Fatalf("Chunk %v differs in the biomes of section %d", pos, i)
+			}
+		}
+	}
+}
+
+// The blocks and biomes of a few chunks of a fixed seed, so that changes to
+// the generator don't go unnoticed
+const goldenHash = "45b15c8243ef0b71f564ab5aa0580e9015688bc53a74f938fa3c5f43c33dc207"
+
+func TestPoolGolden(t *testing.T) {
+	pool := NewPool(NewNoise(1234, -64, 384), 2)
+	defer pool.Close()
+
+	hash := sha256.New()
+	for _, pos := range [][2]int32{{0, 0}, {3, -2}, {-17, 40}} {
+		for _, s := range pool.Generate(pos[0], pos[1]).Sections {
+			binary.Write(hash, binary.BigEndian, s.Blocks)
+			binary.Write(hash, binary.BigEndian, s.Biomes)
+		}
+	}
+
+	if sum := hex.EncodeToString(hash.Sum(nil)); sum != goldenHash {
+		t.Fatalf("Generated chunks hash to %s instead of %s", sum, goldenHash)
+	}
+}
+
+func TestPoolClosed(t *testing.T) {
+	gen, err := NewFlat(DefaultFlatPreset, -64, 384)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pool := NewPool(gen, 1)
+	pool.Close()
+	pool.Close()
+
+	if chunk := pool.Generate(0, 0); chunk == nil {
+		t.Fatal("No chunk once the pool is closed")
+	}
+}
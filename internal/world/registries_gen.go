@@ -0,0 +1,2890 @@
+// Code generated by gendata from minecraft-data. DO NOT EDIT.
+
+package world
+
+var registries = map[string][]string{
+	"minecraft:block": {
+		"minecraft:air",
+		"minecraft:stone",
+		"minecraft:granite",
+		"minecraft:polished_granite",
+		"minecraft:diorite",
+		"minecraft:polished_diorite",
+		"minecraft:andesite",
+		"minecraft:polished_andesite",
+		"minecraft:grass_block",
+		"minecraft:dirt",
+		"minecraft:coarse_dirt",
+		"minecraft:podzol",
+		"minecraft:cobblestone",
+		"minecraft:oak_planks",
+		"minecraft:spruce_planks",
+		"minecraft:birch_planks",
+		"minecraft:jungle_planks",
+		"minecraft:acacia_planks",
+		"minecraft:cherry_planks",
+		"minecraft:dark_oak_planks",
+		"minecraft:pale_oak_wood",
+		"minecraft:pale_oak_planks",
+		"minecraft:mangrove_planks",
+		"minecraft:bamboo_planks",
+		"minecraft:bamboo_mosaic",
+		"minecraft:oak_sapling",
+		"minecraft:spruce_sapling",
+		"minecraft:birch_sapling",
+		"minecraft:jungle_sapling",
+		"minecraft:acacia_sapling",
+		"minecraft:cherry_sapling",
+		"minecraft:dark_oak_sapling",
+		"minecraft:pale_oak_sapling",
+		"minecraft:mangrove_propagule",
+		"minecraft:bedrock",
+		"minecraft:water",
+		"minecraft:lava",
+		"minecraft:sand",
+		"minecraft:suspicious_sand",
+		"minecraft:red_sand",
+		"minecraft:gravel",
+		"minecraft:suspicious_gravel",
+		"minecraft:gold_ore",
+		"minecraft:deepslate_gold_ore",
+		"minecraft:iron_ore",
+		"minecraft:deepslate_iron_ore",
+		"minecraft:coal_ore",
+		"minecraft:deepslate_coal_ore",
+		"minecraft:nether_gold_ore",
+		"minecraft:oak_log",
+		"minecraft:spruce_log",
+		"minecraft:birch_log",
+		"minecraft:jungle_log",
+		"minecraft:acacia_log",
+		"minecraft:cherry_log",
+		"minecraft:dark_oak_log",
+		"minecraft:pale_oak_log",
+		"minecraft:mangrove_log",
+		"minecraft:mangrove_roots",
+		"minecraft:muddy_mangrove_roots",
+		"minecraft:bamboo_block",
+		"minecraft:stripped_spruce_log",
+		"minecraft:stripped_birch_log",
+		"minecraft:stripped_jungle_log",
+		"minecraft:stripped_acacia_log",
+		"minecraft:stripped_cherry_log",
+		"minecraft:stripped_dark_oak_log",
+		"minecraft:stripped_pale_oak_log",
+		"minecraft:stripped_oak_log",
+		"minecraft:stripped_mangrove_log",
+		"minecraft:stripped_bamboo_block",
+		"minecraft:oak_wood",
+		"minecraft:spruce_wood",
+		"minecraft:birch_wood",
+		"minecraft:jungle_wood",
+		"minecraft:acacia_wood",
+		"minecraft:cherry_wood",
+		"minecraft:dark_oak_wood",
+		"minecraft:mangrove_wood",
+		"minecraft:stripped_oak_wood",
+		"minecraft:stripped_spruce_wood",
+		"minecraft:stripped_birch_wood",
+		"minecraft:stripped_jungle_wood",
+		"minecraft:stripped_acacia_wood",
+		"minecraft:stripped_cherry_wood",
+		"minecraft:stripped_dark_oak_wood",
+		"minecraft:stripped_pale_oak_wood",
+		"minecraft:stripped_mangrove_wood",
+		"minecraft:oak_leaves",
+		"minecraft:spruce_leaves",
+		"minecraft:birch_leaves",
+		"minecraft:jungle_leaves",
+		"minecraft:acacia_leaves",
+		"minecraft:cherry_leaves",
+		"minecraft:dark_oak_leaves",
+		"minecraft:pale_oak_leaves",
+		"minecraft:mangrove_leaves",
+		"minecraft:azalea_leaves",
+		"minecraft:flowering_azalea_leaves",
+		"minecraft:sponge",
+		"minecraft:wet_sponge",
+		"minecraft:glass",
+		"minecraft:lapis_ore",
+		"minecraft:deepslate_lapis_ore",
+		"minecraft:lapis_block",
+		"minecraft:dispenser",
+		"minecraft:sandstone",
+		"minecraft:chiseled_sandstone",
+		"minecraft:cut_sandstone",
+		"minecraft:note_block",
+		"minecraft:white_bed",
+		"minecraft:orange_bed",
+		"minecraft:magenta_bed",
+		"minecraft:light_blue_bed",
+		"minecraft:yellow_bed",
+		"minecraft:lime_bed",
+		"minecraft:pink_bed",
+		"minecraft:gray_bed",
+		"minecraft:light_gray_bed",
+		"minecraft:cyan_bed",
+		"minecraft:purple_bed",
+		"minecraft:blue_bed",
+		"minecraft:brown_bed",
+		"minecraft:green_bed",
+		"minecraft:red_bed",
+		"minecraft:black_bed",
+		"minecraft:powered_rail",
+		"minecraft:detector_rail",
+		"minecraft:sticky_piston",
+		"minecraft:cobweb",
+		"minecraft:short_grass",
+		"minecraft:fern",
+		"minecraft:dead_bush",
+		"minecraft:bush",
+		"minecraft:short_dry_grass",
+		"minecraft:tall_dry_grass",
+		"minecraft:seagrass",
+		"minecraft:tall_seagrass",
+		"minecraft:piston",
+		"minecraft:piston_head",
+		"minecraft:white_wool",
+		"minecraft:orange_wool",
+		"minecraft:magenta_wool",
+		"minecraft:light_blue_wool",
+		"minecraft:yellow_wool",
+		"minecraft:lime_wool",
+		"minecraft:pink_wool",
+		"minecraft:gray_wool",
+		"minecraft:light_gray_wool",
+		"minecraft:cyan_wool",
+		"minecraft:purple_wool",
+		"minecraft:blue_wool",
+		"minecraft:brown_wool",
+		"minecraft:green_wool",
+		"minecraft:red_wool",
+		"minecraft:black_wool",
+		"minecraft:moving_piston",
+		"minecraft:dandelion",
+		"minecraft:torchflower",
+		"minecraft:poppy",
+		"minecraft:blue_orchid",
+		"minecraft:allium",
+		"minecraft:azure_bluet",
+		"minecraft:red_tulip",
+		"minecraft:orange_tulip",
+		"minecraft:white_tulip",
+		"minecraft:pink_tulip",
+		"minecraft:oxeye_daisy",
+		"minecraft:cornflower",
+		"minecraft:wither_rose",
+		"minecraft:lily_of_the_valley",
+		"minecraft:brown_mushroom",
+		"minecraft:red_mushroom",
+		"minecraft:gold_block",
+		"minecraft:iron_block",
+		"minecraft:bricks",
+		"minecraft:tnt",
+		"minecraft:bookshelf",
+		"minecraft:chiseled_bookshelf",
+		"minecraft:mossy_cobblestone",
+		"minecraft:obsidian",
+		"minecraft:torch",
+		"minecraft:wall_torch",
+		"minecraft:fire",
+		"minecraft:soul_fire",
+		"minecraft:spawner",
+		"minecraft:creaking_heart",
+		"minecraft:oak_stairs",
+		"minecraft:chest",
+		"minecraft:redstone_wire",
+		"minecraft:diamond_ore",
+		"minecraft:deepslate_diamond_ore",
+		"minecraft:diamond_block",
+		"minecraft:crafting_table",
+		"minecraft:wheat",
+		"minecraft:farmland",
+		"minecraft:furnace",
+		"minecraft:oak_sign",
+		"minecraft:spruce_sign",
+		"minecraft:birch_sign",
+		"minecraft:acacia_sign",
+		"minecraft:cherry_sign",
+		"minecraft:jungle_sign",
+		"minecraft:dark_oak_sign",
+		"minecraft:pale_oak_sign",
+		"minecraft:mangrove_sign",
+		"minecraft:bamboo_sign",
+		"minecraft:oak_door",
+		"minecraft:ladder",
+		"minecraft:rail",
+		"minecraft:cobblestone_stairs",
+		"minecraft:oak_wall_sign",
+		"minecraft:spruce_wall_sign",
+		"minecraft:birch_wall_sign",
+		"minecraft:acacia_wall_sign",
+		"minecraft:cherry_wall_sign",
+		"minecraft:jungle_wall_sign",
+		"minecraft:dark_oak_wall_sign",
+		"minecraft:pale_oak_wall_sign",
+		"minecraft:mangrove_wall_sign",
+		"minecraft:bamboo_wall_sign",
+		"minecraft:oak_hanging_sign",
+		"minecraft:spruce_hanging_sign",
+		"minecraft:birch_hanging_sign",
+		"minecraft:acacia_hanging_sign",
+		"minecraft:cherry_hanging_sign",
+		"minecraft:jungle_hanging_sign",
+		"minecraft:dark_oak_hanging_sign",
+		"minecraft:pale_oak_hanging_sign",
+		"minecraft:crimson_hanging_sign",
+		"minecraft:warped_hanging_sign",
+		"minecraft:mangrove_hanging_sign",
+		"minecraft:bamboo_hanging_sign",
+		"minecraft:oak_wall_hanging_sign",
+		"minecraft:spruce_wall_hanging_sign",
+		"minecraft:birch_wall_hanging_sign",
+		"minecraft:acacia_wall_hanging_sign",
+		"minecraft:cherry_wall_hanging_sign",
+		"minecraft:jungle_wall_hanging_sign",
+		"minecraft:dark_oak_wall_hanging_sign",
+		"minecraft:pale_oak_wall_hanging_sign",
+		"minecraft:mangrove_wall_hanging_sign",
+		"minecraft:crimson_wall_hanging_sign",
+		"minecraft:warped_wall_hanging_sign",
+		"minecraft:bamboo_wall_hanging_sign",
+		"minecraft:lever",
+		"minecraft:stone_pressure_plate",
+		"minecraft:iron_door",
+		"minecraft:oak_pressure_plate",
+		"minecraft:spruce_pressure_plate",
+		"minecraft:birch_pressure_plate",
+		"minecraft:jungle_pressure_plate",
+		"minecraft:acacia_pressure_plate",
+		"minecraft:cherry_pressure_plate",
+		"minecraft:dark_oak_pressure_plate",
+		"minecraft:pale_oak_pressure_plate",
+		"minecraft:mangrove_pressure_plate",
+		"minecraft:bamboo_pressure_plate",
+		"minecraft:redstone_ore",
+		"minecraft:deepslate_redstone_ore",
+		"minecraft:redstone_torch",
+		"minecraft:redstone_wall_torch",
+		"minecraft:stone_button",
+		"minecraft:snow",
+		"minecraft:ice",
+		"minecraft:snow_block",
+		"minecraft:cactus",
+		"minecraft:cactus_flower",
+		"minecraft:clay",
+		"minecraft:sugar_cane",
+		"minecraft:jukebox",
+		"minecraft:oak_fence",
+		"minecraft:netherrack",
+		"minecraft:soul_sand",
+		"minecraft:soul_soil",
+		"minecraft:basalt",
+		"minecraft:polished_basalt",
+		"minecraft:soul_torch",
+		"minecraft:soul_wall_torch",
+		"minecraft:glowstone",
+		"minecraft:nether_portal",
+		"minecraft:carved_pumpkin",
+		"minecraft:jack_o_lantern",
+		"minecraft:cake",
+		"minecraft:repeater",
+		"minecraft:white_stained_glass",
+		"minecraft:orange_stained_glass",
+		"minecraft:magenta_stained_glass",
+		"minecraft:light_blue_stained_glass",
+		"minecraft:yellow_stained_glass",
+		"minecraft:lime_stained_glass",
+		"minecraft:pink_stained_glass",
+		"minecraft:gray_stained_glass",
+		"minecraft:light_gray_stained_glass",
+		"minecraft:cyan_stained_glass",
+		"minecraft:purple_stained_glass",
+		"minecraft:blue_stained_glass",
+		"minecraft:brown_stained_glass",
+		"minecraft:green_stained_glass",
+		"minecraft:red_stained_glass",
+		"minecraft:black_stained_glass",
+		"minecraft:oak_trapdoor",
+		"minecraft:spruce_trapdoor",
+		"minecraft:birch_trapdoor",
+		"minecraft:jungle_trapdoor",
+		"minecraft:acacia_trapdoor",
+		"minecraft:cherry_trapdoor",
+		"minecraft:dark_oak_trapdoor",
+		"minecraft:pale_oak_trapdoor",
+		"minecraft:mangrove_trapdoor",
+		"minecraft:bamboo_trapdoor",
+		"minecraft:stone_bricks",
+		"minecraft:mossy_stone_bricks",
+		"minecraft:cracked_stone_bricks",
+		"minecraft:chiseled_stone_bricks",
+		"minecraft:packed_mud",
+		"minecraft:mud_bricks",
+		"minecraft:infested_stone",
+		"minecraft:infested_cobblestone",
+		"minecraft:infested_stone_bricks",
+		"minecraft:infested_mossy_stone_bricks",
+		"minecraft:infested_cracked_stone_bricks",
+		"minecraft:infested_chiseled_stone_bricks",
+		"minecraft:brown_mushroom_block",
+		"minecraft:red_mushroom_block",
+		"minecraft:mushroom_stem",
+		"minecraft:iron_bars",
+		"minecraft:chain",
+		"minecraft:glass_pane",
+		"minecraft:pumpkin",
+		"minecraft:melon",
+		"minecraft:attached_pumpkin_stem",
+		"minecraft:attached_melon_stem",
+		"minecraft:pumpkin_stem",
+		"minecraft:melon_stem",
+		"minecraft:vine",
+		"minecraft:glow_lichen",
+		"minecraft:resin_clump",
+		"minecraft:oak_fence_gate",
+		"minecraft:brick_stairs",
+		"minecraft:stone_brick_stairs",
+		"minecraft:mud_brick_stairs",
+		"minecraft:mycelium",
+		"minecraft:lily_pad",
+		"minecraft:resin_block",
+		"minecraft:resin_bricks",
+		"minecraft:resin_brick_stairs",
+		"minecraft:resin_brick_slab",
+		"minecraft:resin_brick_wall",
+		"minecraft:chiseled_resin_bricks",
+		"minecraft:nether_bricks",
+		"minecraft:nether_brick_fence",
+		"minecraft:nether_brick_stairs",
+		"minecraft:nether_wart",
+		"minecraft:enchanting_table",
+		"minecraft:brewing_stand",
+		"minecraft:cauldron",
+		"minecraft:water_cauldron",
+		"minecraft:lava_cauldron",
+		"minecraft:powder_snow_cauldron",
+		"minecraft:end_portal",
+		"minecraft:end_portal_frame",
+		"minecraft:end_stone",
+		"minecraft:dragon_egg",
+		"minecraft:redstone_lamp",
+		"minecraft:cocoa",
+		"minecraft:sandstone_stairs",
+		"minecraft:emerald_ore",
+		"minecraft:deepslate_emerald_ore",
+		"minecraft:ender_chest",
+		"minecraft:tripwire_hook",
+		"minecraft:tripwire",
+		"minecraft:emerald_block",
+		"minecraft:spruce_stairs",
+		"minecraft:birch_stairs",
+		"minecraft:jungle_stairs",
+		"minecraft:command_block",
+		"minecraft:beacon",
+		"minecraft:cobblestone_wall",
+		"minecraft:mossy_cobblestone_wall",
+		"minecraft:flower_pot",
+		"minecraft:potted_torchflower",
+		"minecraft:potted_oak_sapling",
+		"minecraft:potted_spruce_sapling",
+		"minecraft:potted_birch_sapling",
+		"minecraft:potted_jungle_sapling",
+		"minecraft:potted_acacia_sapling",
+		"minecraft:potted_cherry_sapling",
+		"minecraft:potted_dark_oak_sapling",
+		"minecraft:potted_pale_oak_sapling",
+		"minecraft:potted_mangrove_propagule",
+		"minecraft:potted_fern",
+		"minecraft:potted_dandelion",
+		"minecraft:potted_poppy",
+		"minecraft:potted_blue_orchid",
+		"minecraft:potted_allium",
+		"minecraft:potted_azure_bluet",
+		"minecraft:potted_red_tulip",
+		"minecraft:potted_orange_tulip",
+		"minecraft:potted_white_tulip",
+		"minecraft:potted_pink_tulip",
+		"minecraft:potted_oxeye_daisy",
+		"minecraft:potted_cornflower",
+		"minecraft:potted_lily_of_the_valley",
+		"minecraft:potted_wither_rose",
+		"minecraft:potted_red_mushroom",
+		"minecraft:potted_brown_mushroom",
+		"minecraft:potted_dead_bush",
+		"minecraft:potted_cactus",
+		"minecraft:carrots",
+		"minecraft:potatoes",
+		"minecraft:oak_button",
+		"minecraft:spruce_button",
+		"minecraft:birch_button",
+		"minecraft:jungle_button",
+		"minecraft:acacia_button",
+		"minecraft:cherry_button",
+		"minecraft:dark_oak_button",
+		"minecraft:pale_oak_button",
+		"minecraft:mangrove_button",
+		"minecraft:bamboo_button",
+		"minecraft:skeleton_skull",
+		"minecraft:skeleton_wall_skull",
+		"minecraft:wither_skeleton_skull",
+		"minecraft:wither_skeleton_wall_skull",
+		"minecraft:zombie_head",
+		"minecraft:zombie_wall_head",
+		"minecraft:player_head",
+		"minecraft:player_wall_head",
+		"minecraft:creeper_head",
+		"minecraft:creeper_wall_head",
+		"minecraft:dragon_head",
+		"minecraft:dragon_wall_head",
+		"minecraft:piglin_head",
+		"minecraft:piglin_wall_head",
+		"minecraft:anvil",
+		"minecraft:chipped_anvil",
+		"minecraft:damaged_anvil",
+		"minecraft:trapped_chest",
+		"minecraft:light_weighted_pressure_plate",
+		"minecraft:heavy_weighted_pressure_plate",
+		"minecraft:comparator",
+		"minecraft:daylight_detector",
+		"minecraft:redstone_block",
+		"minecraft:nether_quartz_ore",
+		"minecraft:hopper",
+		"minecraft:quartz_block",
+		"minecraft:chiseled_quartz_block",
+		"minecraft:quartz_pillar",
+		"minecraft:quartz_stairs",
+		"minecraft:activator_rail",
+		"minecraft:dropper",
+		"minecraft:white_terracotta",
+		"minecraft:orange_terracotta",
+		"minecraft:magenta_terracotta",
+		"minecraft:light_blue_terracotta",
+		"minecraft:yellow_terracotta",
+		"minecraft:lime_terracotta",
+		"minecraft:pink_terracotta",
+		"minecraft:gray_terracotta",
+		"minecraft:light_gray_terracotta",
+		"minecraft:cyan_terracotta",
+		"minecraft:purple_terracotta",
+		"minecraft:blue_terracotta",
+		"minecraft:brown_terracotta",
+		"minecraft:green_terracotta",
+		"minecraft:red_terracotta",
+		"minecraft:black_terracotta",
+		"minecraft:white_stained_glass_pane",
+		"minecraft:orange_stained_glass_pane",
+		"minecraft:magenta_stained_glass_pane",
+		"minecraft:light_blue_stained_glass_pane",
+		"minecraft:yellow_stained_glass_pane",
+		"minecraft:lime_stained_glass_pane",
+		"minecraft:pink_stained_glass_pane",
+		"minecraft:gray_stained_glass_pane",
+		"minecraft:light_gray_stained_glass_pane",
+		"minecraft:cyan_stained_glass_pane",
+		"minecraft:purple_stained_glass_pane",
+		"minecraft:blue_stained_glass_pane",
+		"minecraft:brown_stained_glass_pane",
+		"minecraft:green_stained_glass_pane",
+		"minecraft:red_stained_glass_pane",
+		"minecraft:black_stained_glass_pane",
+		"minecraft:acacia_stairs",
+		"minecraft:cherry_stairs",
+		"minecraft:dark_oak_stairs",
+		"minecraft:pale_oak_stairs",
+		"minecraft:mangrove_stairs",
+		"minecraft:bamboo_stairs",
+		"minecraft:bamboo_mosaic_stairs",
+		"minecraft:slime_block",
+		"minecraft:barrier",
+		"minecraft:light",
+		"minecraft:iron_trapdoor",
+		"minecraft:prismarine",
+		"minecraft:prismarine_bricks",
+		"minecraft:dark_prismarine",
+		"minecraft:prismarine_stairs",
+		"minecraft:prismarine_brick_stairs",
+		"minecraft:dark_prismarine_stairs",
+		"minecraft:prismarine_slab",
+		"minecraft:prismarine_brick_slab",
+		"minecraft:dark_prismarine_slab",
+		"minecraft:sea_lantern",
+		"minecraft:hay_block",
+		"minecraft:white_carpet",
+		"minecraft:orange_carpet",
+		"minecraft:magenta_carpet",
+		"minecraft:light_blue_carpet",
+		"minecraft:yellow_carpet",
+		"minecraft:lime_carpet",
+		"minecraft:pink_carpet",
+		"minecraft:gray_carpet",
+		"minecraft:light_gray_carpet",
+		"minecraft:cyan_carpet",
+		"minecraft:purple_carpet",
+		"minecraft:blue_carpet",
+		"minecraft:brown_carpet",
+		"minecraft:green_carpet",
+		"minecraft:red_carpet",
+		"minecraft:black_carpet",
+		"minecraft:terracotta",
+		"minecraft:coal_block",
+		"minecraft:packed_ice",
+		"minecraft:sunflower",
+		"minecraft:lilac",
+		"minecraft:rose_bush",
+		"minecraft:peony",
+		"minecraft:tall_grass",
+		"minecraft:large_fern",
+		"minecraft:white_banner",
+		"minecraft:orange_banner",
+		"minecraft:magenta_banner",
+		"minecraft:light_blue_banner",
+		"minecraft:yellow_banner",
+		"minecraft:lime_banner",
+		"minecraft:pink_banner",
+		"minecraft:gray_banner",
+		"minecraft:light_gray_banner",
+		"minecraft:cyan_banner",
+		"minecraft:purple_banner",
+		"minecraft:blue_banner",
+		"minecraft:brown_banner",
+		"minecraft:green_banner",
+		"minecraft:red_banner",
+		"minecraft:black_banner",
+		"minecraft:white_wall_banner",
+		"minecraft:orange_wall_banner",
+		"minecraft:magenta_wall_banner",
+		"minecraft:light_blue_wall_banner",
+		"minecraft:yellow_wall_banner",
+		"minecraft:lime_wall_banner",
+		"minecraft:pink_wall_banner",
+		"minecraft:gray_wall_banner",
+		"minecraft:light_gray_wall_banner",
+		"minecraft:cyan_wall_banner",
+		"minecraft:purple_wall_banner",
+		"minecraft:blue_wall_banner",
+		"minecraft:brown_wall_banner",
+		"minecraft:green_wall_banner",
+		"minecraft:red_wall_banner",
+		"minecraft:black_wall_banner",
+		"minecraft:red_sandstone",
+		"minecraft:chiseled_red_sandstone",
+		"minecraft:cut_red_sandstone",
+		"minecraft:red_sandstone_stairs",
+		"minecraft:oak_slab",
+		"minecraft:spruce_slab",
+		"minecraft:birch_slab",
+		"minecraft:jungle_slab",
+		"minecraft:acacia_slab",
+		"minecraft:cherry_slab",
+		"minecraft:dark_oak_slab",
+		"minecraft:pale_oak_slab",
+		"minecraft:mangrove_slab",
+		"minecraft:bamboo_slab",
+		"minecraft:bamboo_mosaic_slab",
+		"minecraft:stone_slab",
+		"minecraft:smooth_stone_slab",
+		"minecraft:sandstone_slab",
+		"minecraft:cut_sandstone_slab",
+		"minecraft:petrified_oak_slab",
+		"minecraft:cobblestone_slab",
+		"minecraft:brick_slab",
+		"minecraft:stone_brick_slab",
+		"minecraft:mud_brick_slab",
+		"minecraft:nether_brick_slab",
+		"minecraft:quartz_slab",
+		"minecraft:red_sandstone_slab",
+		"minecraft:cut_red_sandstone_slab",
+		"minecraft:purpur_slab",
+		"minecraft:smooth_stone",
+		"minecraft:smooth_sandstone",
+		"minecraft:smooth_quartz",
+		"minecraft:smooth_red_sandstone",
+		"minecraft:spruce_fence_gate",
+		"minecraft:birch_fence_gate",
+		"minecraft:jungle_fence_gate",
+		"minecraft:acacia_fence_gate",
+		"minecraft:cherry_fence_gate",
+		"minecraft:dark_oak_fence_gate",
+		"minecraft:pale_oak_fence_gate",
+		"minecraft:mangrove_fence_gate",
+		"minecraft:bamboo_fence_gate",
+		"minecraft:spruce_fence",
+		"minecraft:birch_fence",
+		"minecraft:jungle_fence",
+		"minecraft:acacia_fence",
+		"minecraft:cherry_fence",
+		"minecraft:dark_oak_fence",
+		"minecraft:pale_oak_fence",
+		"minecraft:mangrove_fence",
+		"minecraft:bamboo_fence",
+		"minecraft:spruce_door",
+		"minecraft:birch_door",
+		"minecraft:jungle_door",
+		"minecraft:acacia_door",
+		"minecraft:cherry_door",
+		"minecraft:dark_oak_door",
+		"minecraft:pale_oak_door",
+		"minecraft:mangrove_door",
+		"minecraft:bamboo_door",
+		"minecraft:end_rod",
+		"minecraft:chorus_plant",
+		"minecraft:chorus_flower",
+		"minecraft:purpur_block",
+		"minecraft:purpur_pillar",
+		"minecraft:purpur_stairs",
+		"minecraft:end_stone_bricks",
+		"minecraft:torchflower_crop",
+		"minecraft:pitcher_crop",
+		"minecraft:pitcher_plant",
+		"minecraft:beetroots",
+		"minecraft:dirt_path",
+		"minecraft:end_gateway",
+		"minecraft:repeating_command_block",
+		"minecraft:chain_command_block",
+		"minecraft:frosted_ice",
+		"minecraft:magma_block",
+		"minecraft:nether_wart_block",
+		"minecraft:red_nether_bricks",
+		"minecraft:bone_block",
+		"minecraft:structure_void",
+		"minecraft:observer",
+		"minecraft:shulker_box",
+		"minecraft:white_shulker_box",
+		"minecraft:orange_shulker_box",
+		"minecraft:magenta_shulker_box",
+		"minecraft:light_blue_shulker_box",
+		"minecraft:yellow_shulker_box",
+		"minecraft:lime_shulker_box",
+		"minecraft:pink_shulker_box",
+		"minecraft:gray_shulker_box",
+		"minecraft:light_gray_shulker_box",
+		"minecraft:cyan_shulker_box",
+		"minecraft:purple_shulker_box",
+		"minecraft:blue_shulker_box",
+		"minecraft:brown_shulker_box",
+		"minecraft:green_shulker_box",
+		"minecraft:red_shulker_box",
+		"minecraft:black_shulker_box",
+		"minecraft:white_glazed_terracotta",
+		"minecraft:orange_glazed_terracotta",
+		"minecraft:magenta_glazed_terracotta",
+		"minecraft:light_blue_glazed_terracotta",
+		"minecraft:yellow_glazed_terracotta",
+		"minecraft:lime_glazed_terracotta",
+		"minecraft:pink_glazed_terracotta",
+		"minecraft:gray_glazed_terracotta",
+		"minecraft:light_gray_glazed_terracotta",
+		"minecraft:cyan_glazed_terracotta",
+		"minecraft:purple_glazed_terracotta",
+		"minecraft:blue_glazed_terracotta",
+		"minecraft:brown_glazed_terracotta",
+		"minecraft:green_glazed_terracotta",
+		"minecraft:red_glazed_terracotta",
+		"minecraft:black_glazed_terracotta",
+		"minecraft:white_concrete",
+		"minecraft:orange_concrete",
+		"minecraft:magenta_concrete",
+		"minecraft:light_blue_concrete",
+		"minecraft:yellow_concrete",
+		"minecraft:lime_concrete",
+		"minecraft:pink_concrete",
+		"minecraft:gray_concrete",
+		"minecraft:light_gray_concrete",
+		"minecraft:cyan_concrete",
+		"minecraft:purple_concrete",
+		"minecraft:blue_concrete",
+		"minecraft:brown_concrete",
+		"minecraft:green_concrete",
+		"minecraft:red_concrete",
+		"minecraft:black_concrete",
+		"minecraft:white_concrete_powder",
+		"minecraft:orange_concrete_powder",
+		"minecraft:magenta_concrete_powder",
+		"minecraft:light_blue_concrete_powder",
+		"minecraft:yellow_concrete_powder",
+		"minecraft:lime_concrete_powder",
+		"minecraft:pink_concrete_powder",
+		"minecraft:gray_concrete_powder",
+		"minecraft:light_gray_concrete_powder",
+		"minecraft:cyan_concrete_powder",
+		"minecraft:purple_concrete_powder",
+		"minecraft:blue_concrete_powder",
+		"minecraft:brown_concrete_powder",
+		"minecraft:green_concrete_powder",
+		"minecraft:red_concrete_powder",
+		"minecraft:black_concrete_powder",
+		"minecraft:kelp",
+		"minecraft:kelp_plant",
+		"minecraft:dried_kelp_block",
+		"minecraft:turtle_egg",
+		"minecraft:sniffer_egg",
+		"minecraft:dried_ghast",
+		"minecraft:dead_tube_coral_block",
+		"minecraft:dead_brain_coral_block",
+		"minecraft:dead_bubble_coral_block",
+		"minecraft:dead_fire_coral_block",
+		"minecraft:dead_horn_coral_block",
+		"minecraft:tube_coral_block",
+		"minecraft:brain_coral_block",
+		"minecraft:bubble_coral_block",
+		"minecraft:fire_coral_block",
+		"minecraft:horn_coral_block",
+		"minecraft:dead_tube_coral",
+		"minecraft:dead_brain_coral",
+		"minecraft:dead_bubble_coral",
+		"minecraft:dead_fire_coral",
+		"minecraft:dead_horn_coral",
+		"minecraft:tube_coral",
+		"minecraft:brain_coral",
+		"minecraft:bubble_coral",
+		"minecraft:fire_coral",
+		"minecraft:horn_coral",
+		"minecraft:dead_tube_coral_fan",
+		"minecraft:dead_brain_coral_fan",
+		"minecraft:dead_bubble_coral_fan",
+		"minecraft:dead_fire_coral_fan",
+		"minecraft:dead_horn_coral_fan",
+		"minecraft:tube_coral_fan",
+		"minecraft:brain_coral_fan",
+		"minecraft:bubble_coral_fan",
+		"minecraft:fire_coral_fan",
+		"minecraft:horn_coral_fan",
+		"minecraft:dead_tube_coral_wall_fan",
+		"minecraft:dead_brain_coral_wall_fan",
+		"minecraft:dead_bubble_coral_wall_fan",
+		"minecraft:dead_fire_coral_wall_fan",
+		"minecraft:dead_horn_coral_wall_fan",
+		"minecraft:tube_coral_wall_fan",
+		"minecraft:brain_coral_wall_fan",
+		"minecraft:bubble_coral_wall_fan",
+		"minecraft:fire_coral_wall_fan",
+		"minecraft:horn_coral_wall_fan",
+		"minecraft:sea_pickle",
+		"minecraft:blue_ice",
+		"minecraft:conduit",
+		"minecraft:bamboo_sapling",
+		"minecraft:bamboo",
+		"minecraft:potted_bamboo",
+		"minecraft:void_air",
+		"minecraft:cave_air",
+		"minecraft:bubble_column",
+		"minecraft:polished_granite_stairs",
+		"minecraft:smooth_red_sandstone_stairs",
+		"minecraft:mossy_stone_brick_stairs",
+		"minecraft:polished_diorite_stairs",
+		"minecraft:mossy_cobblestone_stairs",
+		"minecraft:end_stone_brick_stairs",
+		"minecraft:stone_stairs",
+		"minecraft:smooth_sandstone_stairs",
+		"minecraft:smooth_quartz_stairs",
+		"minecraft:granite_stairs",
+		"minecraft:andesite_stairs",
+		"minecraft:red_nether_brick_stairs",
+		"minecraft:polished_andesite_stairs",
+		"minecraft:diorite_stairs",
+		"minecraft:polished_granite_slab",
+		"minecraft:smooth_red_sandstone_slab",
+		"minecraft:mossy_stone_brick_slab",
+		"minecraft:polished_diorite_slab",
+		"minecraft:mossy_cobblestone_slab",
+		"minecraft:end_stone_brick_slab",
+		"minecraft:smooth_sandstone_slab",
+		"minecraft:smooth_quartz_slab",
+		"minecraft:granite_slab",
+		"minecraft:andesite_slab",
+		"minecraft:red_nether_brick_slab",
+		"minecraft:polished_andesite_slab",
+		"minecraft:diorite_slab",
+		"minecraft:brick_wall",
+		"minecraft:prismarine_wall",
+		"minecraft:red_sandstone_wall",
+		"minecraft:mossy_stone_brick_wall",
+		"minecraft:granite_wall",
+		"minecraft:stone_brick_wall",
+		"minecraft:mud_brick_wall",
+		"minecraft:nether_brick_wall",
+		"minecraft:andesite_wall",
+		"minecraft:red_nether_brick_wall",
+		"minecraft:sandstone_wall",
+		"minecraft:end_stone_brick_wall",
+		"minecraft:diorite_wall",
+		"minecraft:scaffolding",
+		"minecraft:loom",
+		"minecraft:barrel",
+		"minecraft:smoker",
+		"minecraft:blast_furnace",
+		"minecraft:cartography_table",
+		"minecraft:fletching_table",
+		"minecraft:grindstone",
+		"minecraft:lectern",
+		"minecraft:smithing_table",
+		"minecraft:stonecutter",
+		"minecraft:bell",
+		"minecraft:lantern",
+		"minecraft:soul_lantern",
+		"minecraft:campfire",
+		"minecraft:soul_campfire",
+		"minecraft:sweet_berry_bush",
+		"minecraft:warped_stem",
+		"minecraft:stripped_warped_stem",
+		"minecraft:warped_hyphae",
+		"minecraft:stripped_warped_hyphae",
+		"minecraft:warped_nylium",
+		"minecraft:warped_fungus",
+		"minecraft:warped_wart_block",
+		"minecraft:warped_roots",
+		"minecraft:nether_sprouts",
+		"minecraft:crimson_stem",
+		"minecraft:stripped_crimson_stem",
+		"minecraft:crimson_hyphae",
+		"minecraft:stripped_crimson_hyphae",
+		"minecraft:crimson_nylium",
+		"minecraft:crimson_fungus",
+		"minecraft:shroomlight",
+		"minecraft:weeping_vines",
+		"minecraft:weeping_vines_plant",
+		"minecraft:twisting_vines",
+		"minecraft:twisting_vines_plant",
+		"minecraft:crimson_roots",
+		"minecraft:crimson_planks",
+		"minecraft:warped_planks",
+		"minecraft:crimson_slab",
+		"minecraft:warped_slab",
+		"minecraft:crimson_pressure_plate",
+		"minecraft:warped_pressure_plate",
+		"minecraft:crimson_fence",
+		"minecraft:warped_fence",
+		"minecraft:crimson_trapdoor",
+		"minecraft:warped_trapdoor",
+		"minecraft:crimson_fence_gate",
+		"minecraft:warped_fence_gate",
+		"minecraft:crimson_stairs",
+		"minecraft:warped_stairs",
+		"minecraft:crimson_button",
+		"minecraft:warped_button",
+		"minecraft:crimson_door",
+		"minecraft:warped_door",
+		"minecraft:crimson_sign",
+		"minecraft:warped_sign",
+		"minecraft:crimson_wall_sign",
+		"minecraft:warped_wall_sign",
+		"minecraft:structure_block",
+		"minecraft:jigsaw",
+		"minecraft:test_block",
+		"minecraft:test_instance_block",
+		"minecraft:composter",
+		"minecraft:target",
+		"minecraft:bee_nest",
+		"minecraft:beehive",
+		"minecraft:honey_block",
+		"minecraft:honeycomb_block",
+		"minecraft:netherite_block",
+		"minecraft:ancient_debris",
+		"minecraft:crying_obsidian",
+		"minecraft:respawn_anchor",
+		"minecraft:potted_crimson_fungus",
+		"minecraft:potted_warped_fungus",
+		"minecraft:potted_crimson_roots",
+		"minecraft:potted_warped_roots",
+		"minecraft:lodestone",
+		"minecraft:blackstone",
+		"minecraft:blackstone_stairs",
+		"minecraft:blackstone_wall",
+		"minecraft:blackstone_slab",
+		"minecraft:polished_blackstone",
+		"minecraft:polished_blackstone_bricks",
+		"minecraft:cracked_polished_blackstone_bricks",
+		"minecraft:chiseled_polished_blackstone",
+		"minecraft:polished_blackstone_brick_slab",
+		"minecraft:polished_blackstone_brick_stairs",
+		"minecraft:polished_blackstone_brick_wall",
+		"minecraft:gilded_blackstone",
+		"minecraft:polished_blackstone_stairs",
+		"minecraft:polished_blackstone_slab",
+		"minecraft:polished_blackstone_pressure_plate",
+		"minecraft:polished_blackstone_button",
+		"minecraft:polished_blackstone_wall",
+		"minecraft:chiseled_nether_bricks",
+		"minecraft:cracked_nether_bricks",
+		"minecraft:quartz_bricks",
+		"minecraft:candle",
+		"minecraft:white_candle",
+		"minecraft:orange_candle",
+		"minecraft:magenta_candle",
+		"minecraft:light_blue_candle",
+		"minecraft:yellow_candle",
+		"minecraft:lime_candle",
+		"minecraft:pink_candle",
+		"minecraft:gray_candle",
+		"minecraft:light_gray_candle",
+		"minecraft:cyan_candle",
+		"minecraft:purple_candle",
+		"minecraft:blue_candle",
+		"minecraft:brown_candle",
+		"minecraft:green_candle",
+		"minecraft:red_candle",
+		"minecraft:black_candle",
+		"minecraft:candle_cake",
+		"minecraft:white_candle_cake",
+		"minecraft:orange_candle_cake",
+		"minecraft:magenta_candle_cake",
+		"minecraft:light_blue_candle_cake",
+		"minecraft:yellow_candle_cake",
+		"minecraft:lime_candle_cake",
+		"minecraft:pink_candle_cake",
+		"minecraft:gray_candle_cake",
+		"minecraft:light_gray_candle_cake",
+		"minecraft:cyan_candle_cake",
+		"minecraft:purple_candle_cake",
+		"minecraft:blue_candle_cake",
+		"minecraft:brown_candle_cake",
+		"minecraft:green_candle_cake",
+		"minecraft:red_candle_cake",
+		"minecraft:black_candle_cake",
+		"minecraft:amethyst_block",
+		"minecraft:budding_amethyst",
+		"minecraft:amethyst_cluster",
+		"minecraft:large_amethyst_bud",
+		"minecraft:medium_amethyst_bud",
+		"minecraft:small_amethyst_bud",
+		"minecraft:tuff",
+		"minecraft:tuff_slab",
+		"minecraft:tuff_stairs",
+		"minecraft:tuff_wall",
+		"minecraft:polished_tuff",
+		"minecraft:polished_tuff_slab",
+		"minecraft:polished_tuff_stairs",
+		"minecraft:polished_tuff_wall",
+		"minecraft:chiseled_tuff",
+		"minecraft:tuff_bricks",
+		"minecraft:tuff_brick_slab",
+		"minecraft:tuff_brick_stairs",
+		"minecraft:tuff_brick_wall",
+		"minecraft:chiseled_tuff_bricks",
+		"minecraft:calcite",
+		"minecraft:tinted_glass",
+		"minecraft:powder_snow",
+		"minecraft:sculk_sensor",
+		"minecraft:calibrated_sculk_sensor",
+		"minecraft:sculk",
+		"minecraft:sculk_vein",
+		"minecraft:sculk_catalyst",
+		"minecraft:sculk_shrieker",
+		"minecraft:copper_block",
+		"minecraft:exposed_copper",
+		"minecraft:weathered_copper",
+		"minecraft:oxidized_copper",
+		"minecraft:copper_ore",
+		"minecraft:deepslate_copper_ore",
+		"minecraft:oxidized_cut_copper",
+		"minecraft:weathered_cut_copper",
+		"minecraft:exposed_cut_copper",
+		"minecraft:cut_copper",
+		"minecraft:oxidized_chiseled_copper",
+		"minecraft:weathered_chiseled_copper",
+		"minecraft:exposed_chiseled_copper",
+		"minecraft:chiseled_copper",
+		"minecraft:waxed_oxidized_chiseled_copper",
+		"minecraft:waxed_weathered_chiseled_copper",
+		"minecraft:waxed_exposed_chiseled_copper",
+		"minecraft:waxed_chiseled_copper",
+		"minecraft:oxidized_cut_copper_stairs",
+		"minecraft:weathered_cut_copper_stairs",
+		"minecraft:exposed_cut_copper_stairs",
+		"minecraft:cut_copper_stairs",
+		"minecraft:oxidized_cut_copper_slab",
+		"minecraft:weathered_cut_copper_slab",
+		"minecraft:exposed_cut_copper_slab",
+		"minecraft:cut_copper_slab",
+		"minecraft:waxed_copper_block",
+		"minecraft:waxed_weathered_copper",
+		"minecraft:waxed_exposed_copper",
+		"minecraft:waxed_oxidized_copper",
+		"minecraft:waxed_oxidized_cut_copper",
+		"minecraft:waxed_weathered_cut_copper",
+		"minecraft:waxed_exposed_cut_copper",
+		"minecraft:waxed_cut_copper",
+		"minecraft:waxed_oxidized_cut_copper_stairs",
+		"minecraft:waxed_weathered_cut_copper_stairs",
+		"minecraft:waxed_exposed_cut_copper_stairs",
+		"minecraft:waxed_cut_copper_stairs",
+		"minecraft:waxed_oxidized_cut_copper_slab",
+		"minecraft:waxed_weathered_cut_copper_slab",
+		"minecraft:waxed_exposed_cut_copper_slab",
+		"minecraft:waxed_cut_copper_slab",
+		"minecraft:copper_door",
+		"minecraft:exposed_copper_door",
+		"minecraft:oxidized_copper_door",
+		"minecraft:weathered_copper_door",
+		"minecraft:waxed_copper_door",
+		"minecraft:waxed_exposed_copper_door",
+		"minecraft:waxed_oxidized_copper_door",
+		"minecraft:waxed_weathered_copper_door",
+		"minecraft:copper_trapdoor",
+		"minecraft:exposed_copper_trapdoor",
+		"minecraft:oxidized_copper_trapdoor",
+		"minecraft:weathered_copper_trapdoor",
+		"minecraft:waxed_copper_trapdoor",
+		"minecraft:waxed_exposed_copper_trapdoor",
+		"minecraft:waxed_oxidized_copper_trapdoor",
+		"minecraft:waxed_weathered_copper_trapdoor",
+		"minecraft:copper_grate",
+		"minecraft:exposed_copper_grate",
+		"minecraft:weathered_copper_grate",
+		"minecraft:oxidized_copper_grate",
+		"minecraft:waxed_copper_grate",
+		"minecraft:waxed_exposed_copper_grate",
+		"minecraft:waxed_weathered_copper_grate",
+		"minecraft:waxed_oxidized_copper_grate",
+		"minecraft:copper_bulb",
+		"minecraft:exposed_copper_bulb",
+		"minecraft:weathered_copper_bulb",
+		"minecraft:oxidized_copper_bulb",
+		"minecraft:waxed_copper_bulb",
+		"minecraft:waxed_exposed_copper_bulb",
+		"minecraft:waxed_weathered_copper_bulb",
+		"minecraft:waxed_oxidized_copper_bulb",
+		"minecraft:lightning_rod",
+		"minecraft:pointed_dripstone",
+		"minecraft:dripstone_block",
+		"minecraft:cave_vines",
+		"minecraft:cave_vines_plant",
+		"minecraft:spore_blossom",
+		"minecraft:azalea",
+		"minecraft:flowering_azalea",
+		"minecraft:moss_carpet",
+		"minecraft:pink_petals",
+		"minecraft:wildflowers",
+		"minecraft:leaf_litter",
+		"minecraft:moss_block",
+		"minecraft:big_dripleaf",
+		"minecraft:big_dripleaf_stem",
+		"minecraft:small_dripleaf",
+		"minecraft:hanging_roots",
+		"minecraft:rooted_dirt",
+		"minecraft:mud",
+		"minecraft:deepslate",
+		"minecraft:cobbled_deepslate",
+		"minecraft:cobbled_deepslate_stairs",
+		"minecraft:cobbled_deepslate_slab",
+		"minecraft:cobbled_deepslate_wall",
+		"minecraft:polished_deepslate",
+		"minecraft:polished_deepslate_stairs",
+		"minecraft:polished_deepslate_slab",
+		"minecraft:polished_deepslate_wall",
+		"minecraft:deepslate_tiles",
+		"minecraft:deepslate_tile_stairs",
+		"minecraft:deepslate_tile_slab",
+		"minecraft:deepslate_tile_wall",
+		"minecraft:deepslate_bricks",
+		"minecraft:deepslate_brick_stairs",
+		"minecraft:deepslate_brick_slab",
+		"minecraft:deepslate_brick_wall",
+		"minecraft:chiseled_deepslate",
+		"minecraft:cracked_deepslate_bricks",
+		"minecraft:cracked_deepslate_tiles",
+		"minecraft:infested_deepslate",
+		"minecraft:smooth_basalt",
+		"minecraft:raw_iron_block",
+		"minecraft:raw_copper_block",
+		"minecraft:raw_gold_block",
+		"minecraft:potted_azalea_bush",
+		"minecraft:potted_flowering_azalea_bush",
+		"minecraft:ochre_froglight",
+		"minecraft:verdant_froglight",
+		"minecraft:pearlescent_froglight",
+		"minecraft:frogspawn",
+		"minecraft:reinforced_deepslate",
+		"minecraft:decorated_pot",
+		"minecraft:crafter",
+		"minecraft:trial_spawner",
+		"minecraft:vault",
+		"minecraft:heavy_core",
+		"minecraft:pale_moss_block",
+		"minecraft:pale_moss_carpet",
+		"minecraft:pale_hanging_moss",
+		"minecraft:open_eyeblossom",
+		"minecraft:closed_eyeblossom",
+		"minecraft:potted_open_eyeblossom",
+		"minecraft:potted_closed_eyeblossom",
+		"minecraft:firefly_bush",
+	},
+	"minecraft:block_entity_type": {
+		"minecraft:furnace",
+		"minecraft:chest",
+		"minecraft:trapped_chest",
+		"minecraft:ender_chest",
+		"minecraft:jukebox",
+		"minecraft:dispenser",
+		"minecraft:dropper",
+		"minecraft:sign",
+		"minecraft:hanging_sign",
+		"minecraft:mob_spawner",
+		"minecraft:creaking_heart",
+		"minecraft:piston",
+		"minecraft:brewing_stand",
+		"minecraft:enchanting_table",
+		"minecraft:end_portal",
+		"minecraft:beacon",
+		"minecraft:skull",
+		"minecraft:daylight_detector",
+		"minecraft:hopper",
+		"minecraft:comparator",
+		"minecraft:banner",
+		"minecraft:structure_block",
+		"minecraft:end_gateway",
+		"minecraft:command_block",
+		"minecraft:shulker_box",
+		"minecraft:bed",
+		"minecraft:conduit",
+		"minecraft:barrel",
+		"minecraft:smoker",
+		"minecraft:blast_furnace",
+		"minecraft:lectern",
+		"minecraft:bell",
+		"minecraft:jigsaw",
+		"minecraft:campfire",
+		"minecraft:beehive",
+		"minecraft:sculk_sensor",
+		"minecraft:calibrated_sculk_sensor",
+		"minecraft:sculk_catalyst",
+		"minecraft:sculk_shrieker",
+		"minecraft:chiseled_bookshelf",
+		"minecraft:brushable_block",
+		"minecraft:decorated_pot",
+		"minecraft:crafter",
+		"minecraft:trial_spawner",
+		"minecraft:vault",
+		"minecraft:test_block",
+		"minecraft:test_instance_block",
+	},
+	"minecraft:command_argument_type": {
+		"brigadier:bool",
+		"brigadier:float",
+		"brigadier:double",
+		"brigadier:integer",
+		"brigadier:long",
+		"brigadier:string",
+		"minecraft:entity",
+		"minecraft:game_profile",
+		"minecraft:block_pos",
+		"minecraft:column_pos",
+		"minecraft:vec3",
+		"minecraft:vec2",
+		"minecraft:block_state",
+		"minecraft:block_predicate",
+		"minecraft:item_stack",
+		"minecraft:item_predicate",
+		"minecraft:color",
+		"minecraft:hex_color",
+		"minecraft:component",
+		"minecraft:style",
+		"minecraft:message",
+		"minecraft:nbt_compound_tag",
+		"minecraft:nbt_tag",
+		"minecraft:nbt_path",
+		"minecraft:objective",
+		"minecraft:objective_criteria",
+		"minecraft:operation",
+		"minecraft:particle",
+		"minecraft:angle",
+		"minecraft:rotation",
+		"minecraft:scoreboard_slot",
+		"minecraft:score_holder",
+		"minecraft:swizzle",
+		"minecraft:team",
+		"minecraft:item_slot",
+		"minecraft:item_slots",
+		"minecraft:resource_location",
+		"minecraft:function",
+		"minecraft:entity_anchor",
+		"minecraft:int_range",
+		"minecraft:float_range",
+		"minecraft:dimension",
+		"minecraft:gamemode",
+		"minecraft:time",
+		"minecraft:resource_or_tag",
+		"minecraft:resource_or_tag_key",
+		"minecraft:resource",
+		"minecraft:resource_key",
+		"minecraft:resource_selector",
+		"minecraft:template_mirror",
+		"minecraft:template_rotation",
+		"minecraft:heightmap",
+		"minecraft:loot_table",
+		"minecraft:loot_predicate",
+		"minecraft:loot_modifier",
+		"minecraft:dialog",
+		"minecraft:uuid",
+	},
+	"minecraft:data_component_type": {
+		"minecraft:custom_data",
+		"minecraft:max_stack_size",
+		"minecraft:max_damage",
+		"minecraft:damage",
+		"minecraft:unbreakable",
+		"minecraft:custom_name",
+		"minecraft:item_name",
+		"minecraft:item_model",
+		"minecraft:lore",
+		"minecraft:rarity",
+		"minecraft:enchantments",
+		"minecraft:can_place_on",
+		"minecraft:can_break",
+		"minecraft:attribute_modifiers",
+		"minecraft:custom_model_data",
+		"minecraft:tooltip_display",
+		"minecraft:repair_cost",
+		"minecraft:creative_slot_lock",
+		"minecraft:enchantment_glint_override",
+		"minecraft:intangible_projectile",
+		"minecraft:food",
+		"minecraft:consumable",
+		"minecraft:use_remainder",
+		"minecraft:use_cooldown",
+		"minecraft:damage_resistant",
+		"minecraft:tool",
+		"minecraft:weapon",
+		"minecraft:enchantable",
+		"minecraft:equippable",
+		"minecraft:repairable",
+		"minecraft:glider",
+		"minecraft:tooltip_style",
+		"minecraft:death_protection",
+		"minecraft:blocks_attacks",
+		"minecraft:stored_enchantments",
+		"minecraft:dyed_color",
+		"minecraft:map_color",
+		"minecraft:map_id",
+		"minecraft:map_decorations",
+		"minecraft:map_post_processing",
+		"minecraft:charged_projectiles",
+		"minecraft:bundle_contents",
+		"minecraft:potion_contents",
+		"minecraft:potion_duration_scale",
+		"minecraft:suspicious_stew_effects",
+		"minecraft:writable_book_content",
+		"minecraft:written_book_content",
+		"minecraft:trim",
+		"minecraft:debug_stick_state",
+		"minecraft:entity_data",
+		"minecraft:bucket_entity_data",
+		"minecraft:block_entity_data",
+		"minecraft:instrument",
+		"minecraft:provides_trim_material",
+		"minecraft:ominous_bottle_amplifier",
+		"minecraft:jukebox_playable",
+		"minecraft:provides_banner_patterns",
+		"minecraft:recipes",
+		"minecraft:lodestone_tracker",
+		"minecraft:firework_explosion",
+		"minecraft:fireworks",
+		"minecraft:profile",
+		"minecraft:note_block_sound",
+		"minecraft:banner_patterns",
+		"minecraft:base_color",
+		"minecraft:pot_decorations",
+		"minecraft:container",
+		"minecraft:block_state",
+		"minecraft:bees",
+		"minecraft:lock",
+		"minecraft:container_loot",
+		"minecraft:break_sound",
+		"minecraft:villager/variant",
+		"minecraft:wolf/variant",
+		"minecraft:wolf/sound_variant",
+		"minecraft:wolf/collar",
+		"minecraft:fox/variant",
+		"minecraft:salmon/size",
+		"minecraft:parrot/variant",
+		"minecraft:tropical_fish/pattern",
+		"minecraft:tropical_fish/base_color",
+		"minecraft:tropical_fish/pattern_color",
+		"minecraft:mooshroom/variant",
+		"minecraft:rabbit/variant",
+		"minecraft:pig/variant",
+		"minecraft:cow/variant",
+		"minecraft:chicken/variant",
+		"minecraft:frog/variant",
+		"minecraft:horse/variant",
+		"minecraft:painting/variant",
+		"minecraft:llama/variant",
+		"minecraft:axolotl/variant",
+		"minecraft:cat/variant",
+		"minecraft:cat/collar",
+		"minecraft:sheep/color",
+		"minecraft:shulker/color",
+	},
+	"minecraft:entity_type": {
+		"minecraft:acacia_boat",
+		"minecraft:acacia_chest_boat",
+		"minecraft:allay",
+		"minecraft:area_effect_cloud",
+		"minecraft:armadillo",
+		"minecraft:armor_stand",
+		"minecraft:arrow",
+		"minecraft:axolotl",
+		"minecraft:bamboo_chest_raft",
+		"minecraft:bamboo_raft",
+		"minecraft:bat",
+		"minecraft:bee",
+		"minecraft:birch_boat",
+		"minecraft:birch_chest_boat",
+		"minecraft:blaze",
+		"minecraft:block_display",
+		"minecraft:bogged",
+		"minecraft:breeze",
+		"minecraft:breeze_wind_charge",
+		"minecraft:camel",
+		"minecraft:cat",
+		"minecraft:cave_spider",
+		"minecraft:cherry_boat",
+		"minecraft:cherry_chest_boat",
+		"minecraft:chest_minecart",
+		"minecraft:chicken",
+		"minecraft:cod",
+		"minecraft:command_block_minecart",
+		"minecraft:cow",
+		"minecraft:creaking",
+		"minecraft:creeper",
+		"minecraft:dark_oak_boat",
+		"minecraft:dark_oak_chest_boat",
+		"minecraft:dolphin",
+		"minecraft:donkey",
+		"minecraft:dragon_fireball",
+		"minecraft:drowned",
+		"minecraft:egg",
+		"minecraft:elder_guardian",
+		"minecraft:enderman",
+		"minecraft:endermite",
+		"minecraft:ender_dragon",
+		"minecraft:ender_pearl",
+		"minecraft:end_crystal",
+		"minecraft:evoker",
+		"minecraft:evoker_fangs",
+		"minecraft:experience_bottle",
+		"minecraft:experience_orb",
+		"minecraft:eye_of_ender",
+		"minecraft:falling_block",
+		"minecraft:fireball",
+		"minecraft:firework_rocket",
+		"minecraft:fox",
+		"minecraft:frog",
+		"minecraft:furnace_minecart",
+		"minecraft:ghast",
+		"minecraft:happy_ghast",
+		"minecraft:giant",
+		"minecraft:glow_item_frame",
+		"minecraft:glow_squid",
+		"minecraft:goat",
+		"minecraft:guardian",
+		"minecraft:hoglin",
+		"minecraft:hopper_minecart",
+		"minecraft:horse",
+		"minecraft:husk",
+		"minecraft:illusioner",
+		"minecraft:interaction",
+		"minecraft:iron_golem",
+		"minecraft:item",
+		"minecraft:item_display",
+		"minecraft:item_frame",
+		"minecraft:jungle_boat",
+		"minecraft:jungle_chest_boat",
+		"minecraft:leash_knot",
+		"minecraft:lightning_bolt",
+		"minecraft:llama",
+		"minecraft:llama_spit",
+		"minecraft:magma_cube",
+		"minecraft:mangrove_boat",
+		"minecraft:mangrove_chest_boat",
+		"minecraft:marker",
+		"minecraft:minecart",
+		"minecraft:mooshroom",
+		"minecraft:mule",
+		"minecraft:oak_boat",
+		"minecraft:oak_chest_boat",
+		"minecraft:ocelot",
+		"minecraft:ominous_item_spawner",
+		"minecraft:painting",
+		"minecraft:pale_oak_boat",
+		"minecraft:pale_oak_chest_boat",
+		"minecraft:panda",
+		"minecraft:parrot",
+		"minecraft:phantom",
+		"minecraft:pig",
+		"minecraft:piglin",
+		"minecraft:piglin_brute",
+		"minecraft:pillager",
+		"minecraft:polar_bear",
+		"minecraft:splash_potion",
+		"minecraft:lingering_potion",
+		"minecraft:pufferfish",
+		"minecraft:rabbit",
+		"minecraft:ravager",
+		"minecraft:salmon",
+		"minecraft:sheep",
+		"minecraft:shulker",
+		"minecraft:shulker_bullet",
+		"minecraft:silverfish",
+		"minecraft:skeleton",
+		"minecraft:skeleton_horse",
+		"minecraft:slime",
+		"minecraft:small_fireball",
+		"minecraft:sniffer",
+		"minecraft:snowball",
+		"minecraft:snow_golem",
+		"minecraft:spawner_minecart",
+		"minecraft:spectral_arrow",
+		"minecraft:spider",
+		"minecraft:spruce_boat",
+		"minecraft:spruce_chest_boat",
+		"minecraft:squid",
+		"minecraft:stray",
+		"minecraft:strider",
+		"minecraft:tadpole",
+		"minecraft:text_display",
+		"minecraft:tnt",
+		"minecraft:tnt_minecart",
+		"minecraft:trader_llama",
+		"minecraft:trident",
+		"minecraft:tropical_fish",
+		"minecraft:turtle",
+		"minecraft:vex",
+		"minecraft:villager",
+		"minecraft:vindicator",
+		"minecraft:wandering_trader",
+		"minecraft:warden",
+		"minecraft:wind_charge",
+		"minecraft:witch",
+		"minecraft:wither",
+		"minecraft:wither_skeleton",
+		"minecraft:wither_skull",
+		"minecraft:wolf",
+		"minecraft:zoglin",
+		"minecraft:zombie",
+		"minecraft:zombie_horse",
+		"minecraft:zombie_villager",
+		"minecraft:zombified_piglin",
+		"minecraft:player",
+		"minecraft:fishing_bobber",
+	},
+	"minecraft:item": {
+		"minecraft:air",
+		"minecraft:stone",
+		"minecraft:granite",
+		"minecraft:polished_granite",
+		"minecraft:diorite",
+		"minecraft:polished_diorite",
+		"minecraft:andesite",
+		"minecraft:polished_andesite",
+		"minecraft:deepslate",
+		"minecraft:cobbled_deepslate",
+		"minecraft:polished_deepslate",
+		"minecraft:calcite",
+		"minecraft:tuff",
+		"minecraft:tuff_slab",
+		"minecraft:tuff_stairs",
+		"minecraft:tuff_wall",
+		"minecraft:chiseled_tuff",
+		"minecraft:polished_tuff",
+		"minecraft:polished_tuff_slab",
+		"minecraft:polished_tuff_stairs",
+		"minecraft:polished_tuff_wall",
+		"minecraft:tuff_bricks",
+		"minecraft:tuff_brick_slab",
+		"minecraft:tuff_brick_stairs",
+		"minecraft:tuff_brick_wall",
+		"minecraft:chiseled_tuff_bricks",
+		"minecraft:dripstone_block",
+		"minecraft:grass_block",
+		"minecraft:dirt",
+		"minecraft:coarse_dirt",
+		"minecraft:podzol",
+		"minecraft:rooted_dirt",
+		"minecraft:mud",
+		"minecraft:crimson_nylium",
+		"minecraft:warped_nylium",
+		"minecraft:cobblestone",
+		"minecraft:oak_planks",
+		"minecraft:spruce_planks",
+		"minecraft:birch_planks",
+		"minecraft:jungle_planks",
+		"minecraft:acacia_planks",
+		"minecraft:cherry_planks",
+		"minecraft:dark_oak_planks",
+		"minecraft:pale_oak_planks",
+		"minecraft:mangrove_planks",
+		"minecraft:bamboo_planks",
+		"minecraft:crimson_planks",
+		"minecraft:warped_planks",
+		"minecraft:bamboo_mosaic",
+		"minecraft:oak_sapling",
+		"minecraft:spruce_sapling",
+		"minecraft:birch_sapling",
+		"minecraft:jungle_sapling",
+		"minecraft:acacia_sapling",
+		"minecraft:cherry_sapling",
+		"minecraft:dark_oak_sapling",
+		"minecraft:pale_oak_sapling",
+		"minecraft:mangrove_propagule",
+		"minecraft:bedrock",
+		"minecraft:sand",
+		"minecraft:suspicious_sand",
+		"minecraft:suspicious_gravel",
+		"minecraft:red_sand",
+		"minecraft:gravel",
+		"minecraft:coal_ore",
+		"minecraft:deepslate_coal_ore",
+		"minecraft:iron_ore",
+		"minecraft:deepslate_iron_ore",
+		"minecraft:copper_ore",
+		"minecraft:deepslate_copper_ore",
+		"minecraft:gold_ore",
+		"minecraft:deepslate_gold_ore",
+		"minecraft:redstone_ore",
+		"minecraft:deepslate_redstone_ore",
+		"minecraft:emerald_ore",
+		"minecraft:deepslate_emerald_ore",
+		"minecraft:lapis_ore",
+		"minecraft:deepslate_lapis_ore",
+		"minecraft:diamond_ore",
+		"minecraft:deepslate_diamond_ore",
+		"minecraft:nether_gold_ore",
+		"minecraft:nether_quartz_ore",
+		"minecraft:ancient_debris",
+		"minecraft:coal_block",
+		"minecraft:raw_iron_block",
+		"minecraft:raw_copper_block",
+		"minecraft:raw_gold_block",
+		"minecraft:heavy_core",
+		"minecraft:amethyst_block",
+		"minecraft:budding_amethyst",
+		"minecraft:iron_block",
+		"minecraft:copper_block",
+		"minecraft:gold_block",
+		"minecraft:diamond_block",
+		"minecraft:netherite_block",
+		"minecraft:exposed_copper",
+		"minecraft:weathered_copper",
+		"minecraft:oxidized_copper",
+		"minecraft:chiseled_copper",
+		"minecraft:exposed_chiseled_copper",
+		"minecraft:weathered_chiseled_copper",
+		"minecraft:oxidized_chiseled_copper",
+		"minecraft:cut_copper",
+		"minecraft:exposed_cut_copper",
+		"minecraft:weathered_cut_copper",
+		"minecraft:oxidized_cut_copper",
+		"minecraft:cut_copper_stairs",
+		"minecraft:exposed_cut_copper_stairs",
+		"minecraft:weathered_cut_copper_stairs",
+		"minecraft:oxidized_cut_copper_stairs",
+		"minecraft:cut_copper_slab",
+		"minecraft:exposed_cut_copper_slab",
+		"minecraft:weathered_cut_copper_slab",
+		"minecraft:oxidized_cut_copper_slab",
+		"minecraft:waxed_copper_block",
+		"minecraft:waxed_exposed_copper",
+		"minecraft:waxed_weathered_copper",
+		"minecraft:waxed_oxidized_copper",
+		"minecraft:waxed_chiseled_copper",
+		"minecraft:waxed_exposed_chiseled_copper",
+		"minecraft:waxed_weathered_chiseled_copper",
+		"minecraft:waxed_oxidized_chiseled_copper",
+		"minecraft:waxed_cut_copper",
+		"minecraft:waxed_exposed_cut_copper",
+		"minecraft:waxed_weathered_cut_copper",
+		"minecraft:waxed_oxidized_cut_copper",
+		"minecraft:waxed_cut_copper_stairs",
+		"minecraft:waxed_exposed_cut_copper_stairs",
+		"minecraft:waxed_weathered_cut_copper_stairs",
+		"minecraft:waxed_oxidized_cut_copper_stairs",
+		"minecraft:waxed_cut_copper_slab",
+		"minecraft:waxed_exposed_cut_copper_slab",
+		"minecraft:waxed_weathered_cut_copper_slab",
+		"minecraft:waxed_oxidized_cut_copper_slab",
+		"minecraft:oak_log",
+		"minecraft:spruce_log",
+		"minecraft:birch_log",
+		"minecraft:jungle_log",
+		"minecraft:acacia_log",
+		"minecraft:cherry_log",
+		"minecraft:pale_oak_log",
+		"minecraft:dark_oak_log",
+		"minecraft:mangrove_log",
+		"minecraft:mangrove_roots",
+		"minecraft:muddy_mangrove_roots",
+		"minecraft:crimson_stem",
+		"minecraft:warped_stem",
+		"minecraft:bamboo_block",
+		"minecraft:stripped_oak_log",
+		"minecraft:stripped_spruce_log",
+		"minecraft:stripped_birch_log",
+		"minecraft:stripped_jungle_log",
+		"minecraft:stripped_acacia_log",
+		"minecraft:stripped_cherry_log",
+		"minecraft:stripped_dark_oak_log",
+		"minecraft:stripped_pale_oak_log",
+		"minecraft:stripped_mangrove_log",
+		"minecraft:stripped_crimson_stem",
+		"minecraft:stripped_warped_stem",
+		"minecraft:stripped_oak_wood",
+		"minecraft:stripped_spruce_wood",
+		"minecraft:stripped_birch_wood",
+		"minecraft:stripped_jungle_wood",
+		"minecraft:stripped_acacia_wood",
+		"minecraft:stripped_cherry_wood",
+		"minecraft:stripped_dark_oak_wood",
+		"minecraft:stripped_pale_oak_wood",
+		"minecraft:stripped_mangrove_wood",
+		"minecraft:stripped_crimson_hyphae",
+		"minecraft:stripped_warped_hyphae",
+		"minecraft:stripped_bamboo_block",
+		"minecraft:oak_wood",
+		"minecraft:spruce_wood",
+		"minecraft:birch_wood",
+		"minecraft:jungle_wood",
+		"minecraft:acacia_wood",
+		"minecraft:cherry_wood",
+		"minecraft:pale_oak_wood",
+		"minecraft:dark_oak_wood",
+		"minecraft:mangrove_wood",
+		"minecraft:crimson_hyphae",
+		"minecraft:warped_hyphae",
+		"minecraft:oak_leaves",
+		"minecraft:spruce_leaves",
+		"minecraft:birch_leaves",
+		"minecraft:jungle_leaves",
+		"minecraft:acacia_leaves",
+		"minecraft:cherry_leaves",
+		"minecraft:dark_oak_leaves",
+		"minecraft:pale_oak_leaves",
+		"minecraft:mangrove_leaves",
+		"minecraft:azalea_leaves",
+		"minecraft:flowering_azalea_leaves",
+		"minecraft:sponge",
+		"minecraft:wet_sponge",
+		"minecraft:glass",
+		"minecraft:tinted_glass",
+		"minecraft:lapis_block",
+		"minecraft:sandstone",
+		"minecraft:chiseled_sandstone",
+		"minecraft:cut_sandstone",
+		"minecraft:cobweb",
+		"minecraft:short_grass",
+		"minecraft:fern",
+		"minecraft:bush",
+		"minecraft:azalea",
+		"minecraft:flowering_azalea",
+		"minecraft:dead_bush",
+		"minecraft:firefly_bush",
+		"minecraft:short_dry_grass",
+		"minecraft:tall_dry_grass",
+		"minecraft:seagrass",
+		"minecraft:sea_pickle",
+		"minecraft:white_wool",
+		"minecraft:orange_wool",
+		"minecraft:magenta_wool",
+		"minecraft:light_blue_wool",
+		"minecraft:yellow_wool",
+		"minecraft:lime_wool",
+		"minecraft:pink_wool",
+		"minecraft:gray_wool",
+		"minecraft:light_gray_wool",
+		"minecraft:cyan_wool",
+		"minecraft:purple_wool",
+		"minecraft:blue_wool",
+		"minecraft:brown_wool",
+		"minecraft:green_wool",
+		"minecraft:red_wool",
+		"minecraft:black_wool",
+		"minecraft:dandelion",
+		"minecraft:open_eyeblossom",
+		"minecraft:closed_eyeblossom",
+		"minecraft:poppy",
+		"minecraft:blue_orchid",
+		"minecraft:allium",
+		"minecraft:azure_bluet",
+		"minecraft:red_tulip",
+		"minecraft:orange_tulip",
+		"minecraft:white_tulip",
+		"minecraft:pink_tulip",
+		"minecraft:oxeye_daisy",
+		"minecraft:cornflower",
+		"minecraft:lily_of_the_valley",
+		"minecraft:wither_rose",
+		"minecraft:torchflower",
+		"minecraft:pitcher_plant",
+		"minecraft:spore_blossom",
+		"minecraft:brown_mushroom",
+		"minecraft:red_mushroom",
+		"minecraft:crimson_fungus",
+		"minecraft:warped_fungus",
+		"minecraft:crimson_roots",
+		"minecraft:warped_roots",
+		"minecraft:nether_sprouts",
+		"minecraft:weeping_vines",
+		"minecraft:twisting_vines",
+		"minecraft:sugar_cane",
+		"minecraft:kelp",
+		"minecraft:pink_petals",
+		"minecraft:wildflowers",
+		"minecraft:leaf_litter",
+		"minecraft:moss_carpet",
+		"minecraft:moss_block",
+		"minecraft:pale_moss_carpet",
+		"minecraft:pale_hanging_moss",
+		"minecraft:pale_moss_block",
+		"minecraft:hanging_roots",
+		"minecraft:big_dripleaf",
+		"minecraft:small_dripleaf",
+		"minecraft:bamboo",
+		"minecraft:oak_slab",
+		"minecraft:spruce_slab",
+		"minecraft:birch_slab",
+		"minecraft:jungle_slab",
+		"minecraft:acacia_slab",
+		"minecraft:cherry_slab",
+		"minecraft:dark_oak_slab",
+		"minecraft:pale_oak_slab",
+		"minecraft:mangrove_slab",
+		"minecraft:bamboo_slab",
+		"minecraft:bamboo_mosaic_slab",
+		"minecraft:crimson_slab",
+		"minecraft:warped_slab",
+		"minecraft:stone_slab",
+		"minecraft:smooth_stone_slab",
+		"minecraft:sandstone_slab",
+		"minecraft:cut_sandstone_slab",
+		"minecraft:petrified_oak_slab",
+		"minecraft:cobblestone_slab",
+		"minecraft:brick_slab",
+		"minecraft:stone_brick_slab",
+		"minecraft:mud_brick_slab",
+		"minecraft:nether_brick_slab",
+		"minecraft:quartz_slab",
+		"minecraft:red_sandstone_slab",
+		"minecraft:cut_red_sandstone_slab",
+		"minecraft:purpur_slab",
+		"minecraft:prismarine_slab",
+		"minecraft:prismarine_brick_slab",
+		"minecraft:dark_prismarine_slab",
+		"minecraft:smooth_quartz",
+		"minecraft:smooth_red_sandstone",
+		"minecraft:smooth_sandstone",
+		"minecraft:smooth_stone",
+		"minecraft:bricks",
+		"minecraft:bookshelf",
+		"minecraft:chiseled_bookshelf",
+		"minecraft:decorated_pot",
+		"minecraft:mossy_cobblestone",
+		"minecraft:obsidian",
+		"minecraft:torch",
+		"minecraft:end_rod",
+		"minecraft:chorus_plant",
+		"minecraft:chorus_flower",
+		"minecraft:purpur_block",
+		"minecraft:purpur_pillar",
+		"minecraft:purpur_stairs",
+		"minecraft:spawner",
+		"minecraft:creaking_heart",
+		"minecraft:chest",
+		"minecraft:crafting_table",
+		"minecraft:farmland",
+		"minecraft:furnace",
+		"minecraft:ladder",
+		"minecraft:cobblestone_stairs",
+		"minecraft:snow",
+		"minecraft:ice",
+		"minecraft:snow_block",
+		"minecraft:cactus",
+		"minecraft:cactus_flower",
+		"minecraft:clay",
+		"minecraft:jukebox",
+		"minecraft:oak_fence",
+		"minecraft:spruce_fence",
+		"minecraft:birch_fence",
+		"minecraft:jungle_fence",
+		"minecraft:acacia_fence",
+		"minecraft:cherry_fence",
+		"minecraft:dark_oak_fence",
+		"minecraft:pale_oak_fence",
+		"minecraft:mangrove_fence",
+		"minecraft:bamboo_fence",
+		"minecraft:crimson_fence",
+		"minecraft:warped_fence",
+		"minecraft:pumpkin",
+		"minecraft:carved_pumpkin",
+		"minecraft:jack_o_lantern",
+		"minecraft:netherrack",
+		"minecraft:soul_sand",
+		"minecraft:soul_soil",
+		"minecraft:basalt",
+		"minecraft:polished_basalt",
+		"minecraft:smooth_basalt",
+		"minecraft:soul_torch",
+		"minecraft:glowstone",
+		"minecraft:infested_stone",
+		"minecraft:infested_cobblestone",
+		"minecraft:infested_stone_bricks",
+		"minecraft:infested_mossy_stone_bricks",
+		"minecraft:infested_cracked_stone_bricks",
+		"minecraft:infested_chiseled_stone_bricks",
+		"minecraft:infested_deepslate",
+		"minecraft:stone_bricks",
+		"minecraft:mossy_stone_bricks",
+		"minecraft:cracked_stone_bricks",
+		"minecraft:chiseled_stone_bricks",
+		"minecraft:packed_mud",
+		"minecraft:mud_bricks",
+		"minecraft:deepslate_bricks",
+		"minecraft:cracked_deepslate_bricks",
+		"minecraft:deepslate_tiles",
+		"minecraft:cracked_deepslate_tiles",
+		"minecraft:chiseled_deepslate",
+		"minecraft:reinforced_deepslate",
+		"minecraft:brown_mushroom_block",
+		"minecraft:red_mushroom_block",
+		"minecraft:mushroom_stem",
+		"minecraft:iron_bars",
+		"minecraft:chain",
+		"minecraft:glass_pane",
+		"minecraft:melon",
+		"minecraft:vine",
+		"minecraft:glow_lichen",
+		"minecraft:resin_clump",
+		"minecraft:resin_block",
+		"minecraft:resin_bricks",
+		"minecraft:resin_brick_stairs",
+		"minecraft:resin_brick_slab",
+		"minecraft:resin_brick_wall",
+		"minecraft:chiseled_resin_bricks",
+		"minecraft:brick_stairs",
+		"minecraft:stone_brick_stairs",
+		"minecraft:mud_brick_stairs",
+		"minecraft:mycelium",
+		"minecraft:lily_pad",
+		"minecraft:nether_bricks",
+		"minecraft:cracked_nether_bricks",
+		"minecraft:chiseled_nether_bricks",
+		"minecraft:nether_brick_fence",
+		"minecraft:nether_brick_stairs",
+		"minecraft:sculk",
+		"minecraft:sculk_vein",
+		"minecraft:sculk_catalyst",
+		"minecraft:sculk_shrieker",
+		"minecraft:enchanting_table",
+		"minecraft:end_portal_frame",
+		"minecraft:end_stone",
+		"minecraft:end_stone_bricks",
+		"minecraft:dragon_egg",
+		"minecraft:sandstone_stairs",
+		"minecraft:ender_chest",
+		"minecraft:emerald_block",
+		"minecraft:oak_stairs",
+		"minecraft:spruce_stairs",
+		"minecraft:birch_stairs",
+		"minecraft:jungle_stairs",
+		"minecraft:acacia_stairs",
+		"minecraft:cherry_stairs",
+		"minecraft:dark_oak_stairs",
+		"minecraft:pale_oak_stairs",
+		"minecraft:mangrove_stairs",
+		"minecraft:bamboo_stairs",
+		"minecraft:bamboo_mosaic_stairs",
+		"minecraft:crimson_stairs",
+		"minecraft:warped_stairs",
+		"minecraft:command_block",
+		"minecraft:beacon",
+		"minecraft:cobblestone_wall",
+		"minecraft:mossy_cobblestone_wall",
+		"minecraft:brick_wall",
+		"minecraft:prismarine_wall",
+		"minecraft:red_sandstone_wall",
+		"minecraft:mossy_stone_brick_wall",
+		"minecraft:granite_wall",
+		"minecraft:stone_brick_wall",
+		"minecraft:mud_brick_wall",
+		"minecraft:nether_brick_wall",
+		"minecraft:andesite_wall",
+		"minecraft:red_nether_brick_wall",
+		"minecraft:sandstone_wall",
+		"minecraft:end_stone_brick_wall",
+		"minecraft:diorite_wall",
+		"minecraft:blackstone_wall",
+		"minecraft:polished_blackstone_wall",
+		"minecraft:polished_blackstone_brick_wall",
+		"minecraft:cobbled_deepslate_wall",
+		"minecraft:polished_deepslate_wall",
+		"minecraft:deepslate_brick_wall",
+		"minecraft:deepslate_tile_wall",
+		"minecraft:anvil",
+		"minecraft:chipped_anvil",
+		"minecraft:damaged_anvil",
+		"minecraft:chiseled_quartz_block",
+		"minecraft:quartz_block",
+		"minecraft:quartz_bricks",
+		"minecraft:quartz_pillar",
+		"minecraft:quartz_stairs",
+		"minecraft:white_terracotta",
+		"minecraft:orange_terracotta",
+		"minecraft:magenta_terracotta",
+		"minecraft:light_blue_terracotta",
+		"minecraft:yellow_terracotta",
+		"minecraft:lime_terracotta",
+		"minecraft:pink_terracotta",
+		"minecraft:gray_terracotta",
+		"minecraft:light_gray_terracotta",
+		"minecraft:cyan_terracotta",
+		"minecraft:purple_terracotta",
+		"minecraft:blue_terracotta",
+		"minecraft:brown_terracotta",
+		"minecraft:green_terracotta",
+		"minecraft:red_terracotta",
+		"minecraft:black_terracotta",
+		"minecraft:barrier",
+		"minecraft:light",
+		"minecraft:hay_block",
+		"minecraft:white_carpet",
+		"minecraft:orange_carpet",
+		"minecraft:magenta_carpet",
+		"minecraft:light_blue_carpet",
+		"minecraft:yellow_carpet",
+		"minecraft:lime_carpet",
+		"minecraft:pink_carpet",
+		"minecraft:gray_carpet",
+		"minecraft:light_gray_carpet",
+		"minecraft:cyan_carpet",
+		"minecraft:purple_carpet",
+		"minecraft:blue_carpet",
+		"minecraft:brown_carpet",
+		"minecraft:green_carpet",
+		"minecraft:red_carpet",
+		"minecraft:black_carpet",
+		"minecraft:terracotta",
+		"minecraft:packed_ice",
+		"minecraft:dirt_path",
+		"minecraft:sunflower",
+		"minecraft:lilac",
+		"minecraft:rose_bush",
+		"minecraft:peony",
+		"minecraft:tall_grass",
+		"minecraft:large_fern",
+		"minecraft:white_stained_glass",
+		"minecraft:orange_stained_glass",
+		"minecraft:magenta_stained_glass",
+		"minecraft:light_blue_stained_glass",
+		"minecraft:yellow_stained_glass",
+		"minecraft:lime_stained_glass",
+		"minecraft:pink_stained_glass",
+		"minecraft:gray_stained_glass",
+		"minecraft:light_gray_stained_glass",
+		"minecraft:cyan_stained_glass",
+		"minecraft:purple_stained_glass",
+		"minecraft:blue_stained_glass",
+		"minecraft:brown_stained_glass",
+		"minecraft:green_stained_glass",
+		"minecraft:red_stained_glass",
+		"minecraft:black_stained_glass",
+		"minecraft:white_stained_glass_pane",
+		"minecraft:orange_stained_glass_pane",
+		"minecraft:magenta_stained_glass_pane",
+		"minecraft:light_blue_stained_glass_pane",
+		"minecraft:yellow_stained_glass_pane",
+		"minecraft:lime_stained_glass_pane",
+		"minecraft:pink_stained_glass_pane",
+		"minecraft:gray_stained_glass_pane",
+		"minecraft:light_gray_stained_glass_pane",
+		"minecraft:cyan_stained_glass_pane",
+		"minecraft:purple_stained_glass_pane",
+		"minecraft:blue_stained_glass_pane",
+		"minecraft:brown_stained_glass_pane",
+		"minecraft:green_stained_glass_pane",
+		"minecraft:red_stained_glass_pane",
+		"minecraft:black_stained_glass_pane",
+		"minecraft:prismarine",
+		"minecraft:prismarine_bricks",
+		"minecraft:dark_prismarine",
+		"minecraft:prismarine_stairs",
+		"minecraft:prismarine_brick_stairs",
+		"minecraft:dark_prismarine_stairs",
+		"minecraft:sea_lantern",
+		"minecraft:red_sandstone",
+		"minecraft:chiseled_red_sandstone",
+		"minecraft:cut_red_sandstone",
+		"minecraft:red_sandstone_stairs",
+		"minecraft:repeating_command_block",
+		"minecraft:chain_command_block",
+		"minecraft:magma_block",
+		"minecraft:nether_wart_block",
+		"minecraft:warped_wart_block",
+		"minecraft:red_nether_bricks",
+		"minecraft:bone_block",
+		"minecraft:structure_void",
+		"minecraft:shulker_box",
+		"minecraft:white_shulker_box",
+		"minecraft:orange_shulker_box",
+		"minecraft:magenta_shulker_box",
+		"minecraft:light_blue_shulker_box",
+		"minecraft:yellow_shulker_box",
+		"minecraft:lime_shulker_box",
+		"minecraft:pink_shulker_box",
+		"minecraft:gray_shulker_box",
+		"minecraft:light_gray_shulker_box",
+		"minecraft:cyan_shulker_box",
+		"minecraft:purple_shulker_box",
+		"minecraft:blue_shulker_box",
+		"minecraft:brown_shulker_box",
+		"minecraft:green_shulker_box",
+		"minecraft:red_shulker_box",
+		"minecraft:black_shulker_box",
+		"minecraft:white_glazed_terracotta",
+		"minecraft:orange_glazed_terracotta",
+		"minecraft:magenta_glazed_terracotta",
+		"minecraft:light_blue_glazed_terracotta",
+		"minecraft:yellow_glazed_terracotta",
+		"minecraft:lime_glazed_terracotta",
+		"minecraft:pink_glazed_terracotta",
+		"minecraft:gray_glazed_terracotta",
+		"minecraft:light_gray_glazed_terracotta",
+		"minecraft:cyan_glazed_terracotta",
+		"minecraft:purple_glazed_terracotta",
+		"minecraft:blue_glazed_terracotta",
+		"minecraft:brown_glazed_terracotta",
+		"minecraft:green_glazed_terracotta",
+		"minecraft:red_glazed_terracotta",
+		"minecraft:black_glazed_terracotta",
+		"minecraft:white_concrete",
+		"minecraft:orange_concrete",
+		"minecraft:magenta_concrete",
+		"minecraft:light_blue_concrete",
+		"minecraft:yellow_concrete",
+		"minecraft:lime_concrete",
+		"minecraft:pink_concrete",
+		"minecraft:gray_concrete",
+		"minecraft:light_gray_concrete",
+		"minecraft:cyan_concrete",
+		"minecraft:purple_concrete",
+		"minecraft:blue_concrete",
+		"minecraft:brown_concrete",
+		"minecraft:green_concrete",
+		"minecraft:red_concrete",
+		"minecraft:black_concrete",
+		"minecraft:white_concrete_powder",
+		"minecraft:orange_concrete_powder",
+		"minecraft:magenta_concrete_powder",
+		"minecraft:light_blue_concrete_powder",
+		"minecraft:yellow_concrete_powder",
+		"minecraft:lime_concrete_powder",
+		"minecraft:pink_concrete_powder",
+		"minecraft:gray_concrete_powder",
+		"minecraft:light_gray_concrete_powder",
+		"minecraft:cyan_concrete_powder",
+		"minecraft:purple_concrete_powder",
+		"minecraft:blue_concrete_powder",
+		"minecraft:brown_concrete_powder",
+		"minecraft:green_concrete_powder",
+		"minecraft:red_concrete_powder",
+		"minecraft:black_concrete_powder",
+		"minecraft:turtle_egg",
+		"minecraft:sniffer_egg",
+		"minecraft:dried_ghast",
+		"minecraft:dead_tube_coral_block",
+		"minecraft:dead_brain_coral_block",
+		"minecraft:dead_bubble_coral_block",
+		"minecraft:dead_fire_coral_block",
+		"minecraft:dead_horn_coral_block",
+		"minecraft:tube_coral_block",
+		"minecraft:brain_coral_block",
+		"minecraft:bubble_coral_block",
+		"minecraft:fire_coral_block",
+		"minecraft:horn_coral_block",
+		"minecraft:tube_coral",
+		"minecraft:brain_coral",
+		"minecraft:bubble_coral",
+		"minecraft:fire_coral",
+		"minecraft:horn_coral",
+		"minecraft:dead_brain_coral",
+		"minecraft:dead_bubble_coral",
+		"minecraft:dead_fire_coral",
+		"minecraft:dead_horn_coral",
+		"minecraft:dead_tube_coral",
+		"minecraft:tube_coral_fan",
+		"minecraft:brain_coral_fan",
+		"minecraft:bubble_coral_fan",
+		"minecraft:fire_coral_fan",
+		"minecraft:horn_coral_fan",
+		"minecraft:dead_tube_coral_fan",
+		"minecraft:dead_brain_coral_fan",
+		"minecraft:dead_bubble_coral_fan",
+		"minecraft:dead_fire_coral_fan",
+		"minecraft:dead_horn_coral_fan",
+		"minecraft:blue_ice",
+		"minecraft:conduit",
+		"minecraft:polished_granite_stairs",
+		"minecraft:smooth_red_sandstone_stairs",
+		"minecraft:mossy_stone_brick_stairs",
+		"minecraft:polished_diorite_stairs",
+		"minecraft:mossy_cobblestone_stairs",
+		"minecraft:end_stone_brick_stairs",
+		"minecraft:stone_stairs",
+		"minecraft:smooth_sandstone_stairs",
+		"minecraft:smooth_quartz_stairs",
+		"minecraft:granite_stairs",
+		"minecraft:andesite_stairs",
+		"minecraft:red_nether_brick_stairs",
+		"minecraft:polished_andesite_stairs",
+		"minecraft:diorite_stairs",
+		"minecraft:cobbled_deepslate_stairs",
+		"minecraft:polished_deepslate_stairs",
+		"minecraft:deepslate_brick_stairs",
+		"minecraft:deepslate_tile_stairs",
+		"minecraft:polished_granite_slab",
+		"minecraft:smooth_red_sandstone_slab",
+		"minecraft:mossy_stone_brick_slab",
+		"minecraft:polished_diorite_slab",
+		"minecraft:mossy_cobblestone_slab",
+		"minecraft:end_stone_brick_slab",
+		"minecraft:smooth_sandstone_slab",
+		"minecraft:smooth_quartz_slab",
+		"minecraft:granite_slab",
+		"minecraft:andesite_slab",
+		"minecraft:red_nether_brick_slab",
+		"minecraft:polished_andesite_slab",
+		"minecraft:diorite_slab",
+		"minecraft:cobbled_deepslate_slab",
+		"minecraft:polished_deepslate_slab",
+		"minecraft:deepslate_brick_slab",
+		"minecraft:deepslate_tile_slab",
+		"minecraft:scaffolding",
+		"minecraft:redstone",
+		"minecraft:redstone_torch",
+		"minecraft:redstone_block",
+		"minecraft:repeater",
+		"minecraft:comparator",
+		"minecraft:piston",
+		"minecraft:sticky_piston",
+		"minecraft:slime_block",
+		"minecraft:honey_block",
+		"minecraft:observer",
+		"minecraft:hopper",
+		"minecraft:dispenser",
+		"minecraft:dropper",
+		"minecraft:lectern",
+		"minecraft:target",
+		"minecraft:lever",
+		"minecraft:lightning_rod",
+		"minecraft:daylight_detector",
+		"minecraft:sculk_sensor",
+		"minecraft:calibrated_sculk_sensor",
+		"minecraft:tripwire_hook",
+		"minecraft:trapped_chest",
+		"minecraft:tnt",
+		"minecraft:redstone_lamp",
+		"minecraft:note_block",
+		"minecraft:stone_button",
+		"minecraft:polished_blackstone_button",
+		"minecraft:oak_button",
+		"minecraft:spruce_button",
+		"minecraft:birch_button",
+		"minecraft:jungle_button",
+		"minecraft:acacia_button",
+		"minecraft:cherry_button",
+		"minecraft:dark_oak_button",
+		"minecraft:pale_oak_button",
+		"minecraft:mangrove_button",
+		"minecraft:bamboo_button",
+		"minecraft:crimson_button",
+		"minecraft:warped_button",
+		"minecraft:stone_pressure_plate",
+		"minecraft:polished_blackstone_pressure_plate",
+		"minecraft:light_weighted_pressure_plate",
+		"minecraft:heavy_weighted_pressure_plate",
+		"minecraft:oak_pressure_plate",
+		"minecraft:spruce_pressure_plate",
+		"minecraft:birch_pressure_plate",
+		"minecraft:jungle_pressure_plate",
+		"minecraft:acacia_pressure_plate",
+		"minecraft:cherry_pressure_plate",
+		"minecraft:dark_oak_pressure_plate",
+		"minecraft:pale_oak_pressure_plate",
+		"minecraft:mangrove_pressure_plate",
+		"minecraft:bamboo_pressure_plate",
+		"minecraft:crimson_pressure_plate",
+		"minecraft:warped_pressure_plate",
+		"minecraft:iron_door",
+		"minecraft:oak_door",
+		"minecraft:spruce_door",
+		"minecraft:birch_door",
+		"minecraft:jungle_door",
+		"minecraft:acacia_door",
+		"minecraft:cherry_door",
+		"minecraft:dark_oak_door",
+		"minecraft:pale_oak_door",
+		"minecraft:mangrove_door",
+		"minecraft:bamboo_door",
+		"minecraft:crimson_door",
+		"minecraft:warped_door",
+		"minecraft:copper_door",
+		"minecraft:exposed_copper_door",
+		"minecraft:weathered_copper_door",
+		"minecraft:oxidized_copper_door",
+		"minecraft:waxed_copper_door",
+		"minecraft:waxed_exposed_copper_door",
+		"minecraft:waxed_weathered_copper_door",
+		"minecraft:waxed_oxidized_copper_door",
+		"minecraft:iron_trapdoor",
+		"minecraft:oak_trapdoor",
+		"minecraft:spruce_trapdoor",
+		"minecraft:birch_trapdoor",
+		"minecraft:jungle_trapdoor",
+		"minecraft:acacia_trapdoor",
+		"minecraft:cherry_trapdoor",
+		"minecraft:dark_oak_trapdoor",
+		"minecraft:pale_oak_trapdoor",
+		"minecraft:mangrove_trapdoor",
+		"minecraft:bamboo_trapdoor",
+		"minecraft:crimson_trapdoor",
+		"minecraft:warped_trapdoor",
+		"minecraft:copper_trapdoor",
+		"minecraft:exposed_copper_trapdoor",
+		"minecraft:weathered_copper_trapdoor",
+		"minecraft:oxidized_copper_trapdoor",
+		"minecraft:waxed_copper_trapdoor",
+		"minecraft:waxed_exposed_copper_trapdoor",
+		"minecraft:waxed_weathered_copper_trapdoor",
+		"minecraft:waxed_oxidized_copper_trapdoor",
+		"minecraft:oak_fence_gate",
+		"minecraft:spruce_fence_gate",
+		"minecraft:birch_fence_gate",
+		"minecraft:jungle_fence_gate",
+		"minecraft:acacia_fence_gate",
+		"minecraft:cherry_fence_gate",
+		"minecraft:dark_oak_fence_gate",
+		"minecraft:pale_oak_fence_gate",
+		"minecraft:mangrove_fence_gate",
+		"minecraft:bamboo_fence_gate",
+		"minecraft:crimson_fence_gate",
+		"minecraft:warped_fence_gate",
+		"minecraft:powered_rail",
+		"minecraft:detector_rail",
+		"minecraft:rail",
+		"minecraft:activator_rail",
+		"minecraft:saddle",
+		"minecraft:white_harness",
+		"minecraft:orange_harness",
+		"minecraft:magenta_harness",
+		"minecraft:light_blue_harness",
+		"minecraft:yellow_harness",
+		"minecraft:lime_harness",
+		"minecraft:pink_harness",
+		"minecraft:gray_harness",
+		"minecraft:light_gray_harness",
+		"minecraft:cyan_harness",
+		"minecraft:purple_harness",
+		"minecraft:blue_harness",
+		"minecraft:brown_harness",
+		"minecraft:green_harness",
+		"minecraft:red_harness",
+		"minecraft:black_harness",
+		"minecraft:minecart",
+		"minecraft:chest_minecart",
+		"minecraft:furnace_minecart",
+		"minecraft:tnt_minecart",
+		"minecraft:hopper_minecart",
+		"minecraft:carrot_on_a_stick",
+		"minecraft:warped_fungus_on_a_stick",
+		"minecraft:phantom_membrane",
+		"minecraft:elytra",
+		"minecraft:oak_boat",
+		"minecraft:oak_chest_boat",
+		"minecraft:spruce_boat",
+		"minecraft:spruce_chest_boat",
+		"minecraft:birch_boat",
+		"minecraft:birch_chest_boat",
+		"minecraft:jungle_boat",
+		"minecraft:jungle_chest_boat",
+		"minecraft:acacia_boat",
+		"minecraft:acacia_chest_boat",
+		"minecraft:cherry_boat",
+		"minecraft:cherry_chest_boat",
+		"minecraft:dark_oak_boat",
+		"minecraft:dark_oak_chest_boat",
+		"minecraft:pale_oak_boat",
+		"minecraft:pale_oak_chest_boat",
+		"minecraft:mangrove_boat",
+		"minecraft:mangrove_chest_boat",
+		"minecraft:bamboo_raft",
+		"minecraft:bamboo_chest_raft",
+		"minecraft:structure_block",
+		"minecraft:jigsaw",
+		"minecraft:test_block",
+		"minecraft:test_instance_block",
+		"minecraft:turtle_helmet",
+		"minecraft:turtle_scute",
+		"minecraft:armadillo_scute",
+		"minecraft:wolf_armor",
+		"minecraft:flint_and_steel",
+		"minecraft:bowl",
+		"minecraft:apple",
+		"minecraft:bow",
+		"minecraft:arrow",
+		"minecraft:coal",
+		"minecraft:charcoal",
+		"minecraft:diamond",
+		"minecraft:emerald",
+		"minecraft:lapis_lazuli",
+		"minecraft:quartz",
+		"minecraft:amethyst_shard",
+		"minecraft:raw_iron",
+		"minecraft:iron_ingot",
+		"minecraft:raw_copper",
+		"minecraft:copper_ingot",
+		"minecraft:raw_gold",
+		"minecraft:gold_ingot",
+		"minecraft:netherite_ingot",
+		"minecraft:netherite_scrap",
+		"minecraft:wooden_sword",
+		"minecraft:wooden_shovel",
+		"minecraft:wooden_pickaxe",
+		"minecraft:wooden_axe",
+		"minecraft:wooden_hoe",
+		"minecraft:stone_sword",
+		"minecraft:stone_shovel",
+		"minecraft:stone_pickaxe",
+		"minecraft:stone_axe",
+		"minecraft:stone_hoe",
+		"minecraft:golden_sword",
+		"minecraft:golden_shovel",
+		"minecraft:golden_pickaxe",
+		"minecraft:golden_axe",
+		"minecraft:golden_hoe",
+		"minecraft:iron_sword",
+		"minecraft:iron_shovel",
+		"minecraft:iron_pickaxe",
+		"minecraft:iron_axe",
+		"minecraft:iron_hoe",
+		"minecraft:diamond_sword",
+		"minecraft:diamond_shovel",
+		"minecraft:diamond_pickaxe",
+		"minecraft:diamond_axe",
+		"minecraft:diamond_hoe",
+		"minecraft:netherite_sword",
+		"minecraft:netherite_shovel",
+		"minecraft:netherite_pickaxe",
+		"minecraft:netherite_axe",
+		"minecraft:netherite_hoe",
+		"minecraft:stick",
+		"minecraft:mushroom_stew",
+		"minecraft:string",
+		"minecraft:feather",
+		"minecraft:gunpowder",
+		"minecraft:wheat_seeds",
+		"minecraft:wheat",
+		"minecraft:bread",
+		"minecraft:leather_helmet",
+		"minecraft:leather_chestplate",
+		"minecraft:leather_leggings",
+		"minecraft:leather_boots",
+		"minecraft:chainmail_helmet",
+		"minecraft:chainmail_chestplate",
+		"minecraft:chainmail_leggings",
+		"minecraft:chainmail_boots",
+		"minecraft:iron_helmet",
+		"minecraft:iron_chestplate",
+		"minecraft:iron_leggings",
+		"minecraft:iron_boots",
+		"minecraft:diamond_helmet",
+		"minecraft:diamond_chestplate",
+		"minecraft:diamond_leggings",
+		"minecraft:diamond_boots",
+		"minecraft:golden_helmet",
+		"minecraft:golden_chestplate",
+		"minecraft:golden_leggings",
+		"minecraft:golden_boots",
+		"minecraft:netherite_helmet",
+		"minecraft:netherite_chestplate",
+		"minecraft:netherite_leggings",
+		"minecraft:netherite_boots",
+		"minecraft:flint",
+		"minecraft:porkchop",
+		"minecraft:cooked_porkchop",
+		"minecraft:painting",
+		"minecraft:golden_apple",
+		"minecraft:enchanted_golden_apple",
+		"minecraft:oak_sign",
+		"minecraft:spruce_sign",
+		"minecraft:birch_sign",
+		"minecraft:jungle_sign",
+		"minecraft:acacia_sign",
+		"minecraft:cherry_sign",
+		"minecraft:dark_oak_sign",
+		"minecraft:pale_oak_sign",
+		"minecraft:mangrove_sign",
+		"minecraft:bamboo_sign",
+		"minecraft:crimson_sign",
+		"minecraft:warped_sign",
+		"minecraft:oak_hanging_sign",
+		"minecraft:spruce_hanging_sign",
+		"minecraft:birch_hanging_sign",
+		"minecraft:jungle_hanging_sign",
+		"minecraft:acacia_hanging_sign",
+		"minecraft:cherry_hanging_sign",
+		"minecraft:dark_oak_hanging_sign",
+		"minecraft:pale_oak_hanging_sign",
+		"minecraft:mangrove_hanging_sign",
+		"minecraft:bamboo_hanging_sign",
+		"minecraft:crimson_hanging_sign",
+		"minecraft:warped_hanging_sign",
+		"minecraft:bucket",
+		"minecraft:water_bucket",
+		"minecraft:lava_bucket",
+		"minecraft:powder_snow_bucket",
+		"minecraft:snowball",
+		"minecraft:leather",
+		"minecraft:milk_bucket",
+		"minecraft:pufferfish_bucket",
+		"minecraft:salmon_bucket",
+		"minecraft:cod_bucket",
+		"minecraft:tropical_fish_bucket",
+		"minecraft:axolotl_bucket",
+		"minecraft:tadpole_bucket",
+		"minecraft:brick",
+		"minecraft:clay_ball",
+		"minecraft:dried_kelp_block",
+		"minecraft:paper",
+		"minecraft:book",
+		"minecraft:slime_ball",
+		"minecraft:egg",
+		"minecraft:blue_egg",
+		"minecraft:brown_egg",
+		"minecraft:compass",
+		"minecraft:recovery_compass",
+		"minecraft:bundle",
+		"minecraft:white_bundle",
+		"minecraft:orange_bundle",
+		"minecraft:magenta_bundle",
+		"minecraft:light_blue_bundle",
+		"minecraft:yellow_bundle",
+		"minecraft:lime_bundle",
+		"minecraft:pink_bundle",
+		"minecraft:gray_bundle",
+		"minecraft:light_gray_bundle",
+		"minecraft:cyan_bundle",
+		"minecraft:purple_bundle",
+		"minecraft:blue_bundle",
+		"minecraft:brown_bundle",
+		"minecraft:green_bundle",
+		"minecraft:red_bundle",
+		"minecraft:black_bundle",
+		"minecraft:fishing_rod",
+		"minecraft:clock",
+		"minecraft:spyglass",
+		"minecraft:glowstone_dust",
+		"minecraft:cod",
+		"minecraft:salmon",
+		"minecraft:tropical_fish",
+		"minecraft:pufferfish",
+		"minecraft:cooked_cod",
+		"minecraft:cooked_salmon",
+		"minecraft:ink_sac",
+		"minecraft:glow_ink_sac",
+		"minecraft:cocoa_beans",
+		"minecraft:white_dye",
+		"minecraft:orange_dye",
+		"minecraft:magenta_dye",
+		"minecraft:light_blue_dye",
+		"minecraft:yellow_dye",
+		"minecraft:lime_dye",
+		"minecraft:pink_dye",
+		"minecraft:gray_dye",
+		"minecraft:light_gray_dye",
+		"minecraft:cyan_dye",
+		"minecraft:purple_dye",
+		"minecraft:blue_dye",
+		"minecraft:brown_dye",
+		"minecraft:green_dye",
+		"minecraft:red_dye",
+		"minecraft:black_dye",
+		"minecraft:bone_meal",
+		"minecraft:bone",
+		"minecraft:sugar",
+		"minecraft:cake",
+		"minecraft:white_bed",
+		"minecraft:orange_bed",
+		"minecraft:magenta_bed",
+		"minecraft:light_blue_bed",
+		"minecraft:yellow_bed",
+		"minecraft:lime_bed",
+		"minecraft:pink_bed",
+		"minecraft:gray_bed",
+		"minecraft:light_gray_bed",
+		"minecraft:cyan_bed",
+		"minecraft:purple_bed",
+		"minecraft:blue_bed",
+		"minecraft:brown_bed",
+		"minecraft:green_bed",
+		"minecraft:red_bed",
+		"minecraft:black_bed",
+		"minecraft:cookie",
+		"minecraft:crafter",
+		"minecraft:filled_map",
+		"minecraft:shears",
+		"minecraft:melon_slice",
+		"minecraft:dried_kelp",
+		"minecraft:pumpkin_seeds",
+		"minecraft:melon_seeds",
+		"minecraft:beef",
+		"minecraft:cooked_beef",
+		"minecraft:chicken",
+		"minecraft:cooked_chicken",
+		"minecraft:rotten_flesh",
+		"minecraft:ender_pearl",
+		"minecraft:blaze_rod",
+		"minecraft:ghast_tear",
+		"minecraft:gold_nugget",
+		"minecraft:nether_wart",
+		"minecraft:glass_bottle",
+		"minecraft:potion",
+		"minecraft:spider_eye",
+		"minecraft:fermented_spider_eye",
+		"minecraft:blaze_powder",
+		"minecraft:magma_cream",
+		"minecraft:brewing_stand",
+		"minecraft:cauldron",
+		"minecraft:ender_eye",
+		"minecraft:glistering_melon_slice",
+		"minecraft:armadillo_spawn_egg",
+		"minecraft:allay_spawn_egg",
+		"minecraft:axolotl_spawn_egg",
+		"minecraft:bat_spawn_egg",
+		"minecraft:bee_spawn_egg",
+		"minecraft:blaze_spawn_egg",
+		"minecraft:bogged_spawn_egg",
+		"minecraft:breeze_spawn_egg",
+		"minecraft:cat_spawn_egg",
+		"minecraft:camel_spawn_egg",
+		"minecraft:cave_spider_spawn_egg",
+		"minecraft:chicken_spawn_egg",
+		"minecraft:cod_spawn_egg",
+		"minecraft:cow_spawn_egg",
+		"minecraft:creeper_spawn_egg",
+		"minecraft:dolphin_spawn_egg",
+		"minecraft:donkey_spawn_egg",
+		"minecraft:drowned_spawn_egg",
+		"minecraft:elder_guardian_spawn_egg",
+		"minecraft:ender_dragon_spawn_egg",
+		"minecraft:enderman_spawn_egg",
+		"minecraft:endermite_spawn_egg",
+		"minecraft:evoker_spawn_egg",
+		"minecraft:fox_spawn_egg",
+		"minecraft:frog_spawn_egg",
+		"minecraft:ghast_spawn_egg",
+		"minecraft:happy_ghast_spawn_egg",
+		"minecraft:glow_squid_spawn_egg",
+		"minecraft:goat_spawn_egg",
+		"minecraft:guardian_spawn_egg",
+		"minecraft:hoglin_spawn_egg",
+		"minecraft:horse_spawn_egg",
+		"minecraft:husk_spawn_egg",
+		"minecraft:iron_golem_spawn_egg",
+		"minecraft:llama_spawn_egg",
+		"minecraft:magma_cube_spawn_egg",
+		"minecraft:mooshroom_spawn_egg",
+		"minecraft:mule_spawn_egg",
+		"minecraft:ocelot_spawn_egg",
+		"minecraft:panda_spawn_egg",
+		"minecraft:parrot_spawn_egg",
+		"minecraft:phantom_spawn_egg",
+		"minecraft:pig_spawn_egg",
+		"minecraft:piglin_spawn_egg",
+		"minecraft:piglin_brute_spawn_egg",
+		"minecraft:pillager_spawn_egg",
+		"minecraft:polar_bear_spawn_egg",
+		"minecraft:pufferfish_spawn_egg",
+		"minecraft:rabbit_spawn_egg",
+		"minecraft:ravager_spawn_egg",
+		"minecraft:salmon_spawn_egg",
+		"minecraft:sheep_spawn_egg",
+		"minecraft:shulker_spawn_egg",
+		"minecraft:silverfish_spawn_egg",
+		"minecraft:skeleton_spawn_egg",
+		"minecraft:skeleton_horse_spawn_egg",
+		"minecraft:slime_spawn_egg",
+		"minecraft:sniffer_spawn_egg",
+		"minecraft:snow_golem_spawn_egg",
+		"minecraft:spider_spawn_egg",
+		"minecraft:squid_spawn_egg",
+		"minecraft:stray_spawn_egg",
+		"minecraft:strider_spawn_egg",
+		"minecraft:tadpole_spawn_egg",
+		"minecraft:trader_llama_spawn_egg",
+		"minecraft:tropical_fish_spawn_egg",
+		"minecraft:turtle_spawn_egg",
+		"minecraft:vex_spawn_egg",
+		"minecraft:villager_spawn_egg",
+		"minecraft:vindicator_spawn_egg",
+		"minecraft:wandering_trader_spawn_egg",
+		"minecraft:warden_spawn_egg",
+		"minecraft:witch_spawn_egg",
+		"minecraft:wither_spawn_egg",
+		"minecraft:wither_skeleton_spawn_egg",
+		"minecraft:wolf_spawn_egg",
+		"minecraft:zoglin_spawn_egg",
+		"minecraft:creaking_spawn_egg",
+		"minecraft:zombie_spawn_egg",
+		"minecraft:zombie_horse_spawn_egg",
+		"minecraft:zombie_villager_spawn_egg",
+		"minecraft:zombified_piglin_spawn_egg",
+		"minecraft:experience_bottle",
+		"minecraft:fire_charge",
+		"minecraft:wind_charge",
+		"minecraft:writable_book",
+		"minecraft:written_book",
+		"minecraft:breeze_rod",
+		"minecraft:mace",
+		"minecraft:item_frame",
+		"minecraft:glow_item_frame",
+		"minecraft:flower_pot",
+		"minecraft:carrot",
+		"minecraft:potato",
+		"minecraft:baked_potato",
+		"minecraft:poisonous_potato",
+		"minecraft:map",
+		"minecraft:golden_carrot",
+		"minecraft:skeleton_skull",
+		"minecraft:wither_skeleton_skull",
+		"minecraft:player_head",
+		"minecraft:zombie_head",
+		"minecraft:creeper_head",
+		"minecraft:dragon_head",
+		"minecraft:piglin_head",
+		"minecraft:nether_star",
+		"minecraft:pumpkin_pie",
+		"minecraft:firework_rocket",
+		"minecraft:firework_star",
+		"minecraft:enchanted_book",
+		"minecraft:nether_brick",
+		"minecraft:resin_brick",
+		"minecraft:prismarine_shard",
+		"minecraft:prismarine_crystals",
+		"minecraft:rabbit",
+		"minecraft:cooked_rabbit",
+		"minecraft:rabbit_stew",
+		"minecraft:rabbit_foot",
+		"minecraft:rabbit_hide",
+		"minecraft:armor_stand",
+		"minecraft:iron_horse_armor",
+		"minecraft:golden_horse_armor",
+		"minecraft:diamond_horse_armor",
+		"minecraft:leather_horse_armor",
+		"minecraft:lead",
+		"minecraft:name_tag",
+		"minecraft:command_block_minecart",
+		"minecraft:mutton",
+		"minecraft:cooked_mutton",
+		"minecraft:white_banner",
+		"minecraft:orange_banner",
+		"minecraft:magenta_banner",
+		"minecraft:light_blue_banner",
+		"minecraft:yellow_banner",
+		"minecraft:lime_banner",
+		"minecraft:pink_banner",
+		"minecraft:gray_banner",
+		"minecraft:light_gray_banner",
+		"minecraft:cyan_banner",
+		"minecraft:purple_banner",
+		"minecraft:blue_banner",
+		"minecraft:brown_banner",
+		"minecraft:green_banner",
+		"minecraft:red_banner",
+		"minecraft:black_banner",
+		"minecraft:end_crystal",
+		"minecraft:chorus_fruit",
+		"minecraft:popped_chorus_fruit",
+		"minecraft:torchflower_seeds",
+		"minecraft:pitcher_pod",
+		"minecraft:beetroot",
+		"minecraft:beetroot_seeds",
+		"minecraft:beetroot_soup",
+		"minecraft:dragon_breath",
+		"minecraft:splash_potion",
+		"minecraft:spectral_arrow",
+		"minecraft:tipped_arrow",
+		"minecraft:lingering_potion",
+		"minecraft:shield",
+		"minecraft:totem_of_undying",
+		"minecraft:shulker_shell",
+		"minecraft:iron_nugget",
+		"minecraft:knowledge_book",
+		"minecraft:debug_stick",
+		"minecraft:music_disc_13",
+		"minecraft:music_disc_cat",
+		"minecraft:music_disc_blocks",
+		"minecraft:music_disc_chirp",
+		"minecraft:music_disc_creator",
+		"minecraft:music_disc_creator_music_box",
+		"minecraft:music_disc_far",
+		"minecraft:music_disc_lava_chicken",
+		"minecraft:music_disc_mall",
+		"minecraft:music_disc_mellohi",
+		"minecraft:music_disc_stal",
+		"minecraft:music_disc_strad",
+		"minecraft:music_disc_ward",
+		"minecraft:music_disc_11",
+		"minecraft:music_disc_wait",
+		"minecraft:music_disc_otherside",
+		"minecraft:music_disc_relic",
+		"minecraft:music_disc_5",
+		"minecraft:music_disc_pigstep",
+		"minecraft:music_disc_precipice",
+		"minecraft:music_disc_tears",
+		"minecraft:disc_fragment_5",
+		"minecraft:trident",
+		"minecraft:nautilus_shell",
+		"minecraft:heart_of_the_sea",
+		"minecraft:crossbow",
+		"minecraft:suspicious_stew",
+		"minecraft:loom",
+		"minecraft:flower_banner_pattern",
+		"minecraft:creeper_banner_pattern",
+		"minecraft:skull_banner_pattern",
+		"minecraft:mojang_banner_pattern",
+		"minecraft:globe_banner_pattern",
+		"minecraft:piglin_banner_pattern",
+		"minecraft:flow_banner_pattern",
+		"minecraft:guster_banner_pattern",
+		"minecraft:field_masoned_banner_pattern",
+		"minecraft:bordure_indented_banner_pattern",
+		"minecraft:goat_horn",
+		"minecraft:composter",
+		"minecraft:barrel",
+		"minecraft:smoker",
+		"minecraft:blast_furnace",
+		"minecraft:cartography_table",
+		"minecraft:fletching_table",
+		"minecraft:grindstone",
+		"minecraft:smithing_table",
+		"minecraft:stonecutter",
+		"minecraft:bell",
+		"minecraft:lantern",
+		"minecraft:soul_lantern",
+		"minecraft:sweet_berries",
+		"minecraft:glow_berries",
+		"minecraft:campfire",
+		"minecraft:soul_campfire",
+		"minecraft:shroomlight",
+		"minecraft:honeycomb",
+		"minecraft:bee_nest",
+		"minecraft:beehive",
+		"minecraft:honey_bottle",
+		"minecraft:honeycomb_block",
+		"minecraft:lodestone",
+		"minecraft:crying_obsidian",
+		"minecraft:blackstone",
+		"minecraft:blackstone_slab",
+		"minecraft:blackstone_stairs",
+		"minecraft:gilded_blackstone",
+		"minecraft:polished_blackstone",
+		"minecraft:polished_blackstone_slab",
+		"minecraft:polished_blackstone_stairs",
+		"minecraft:chiseled_polished_blackstone",
+		"minecraft:polished_blackstone_bricks",
+		"minecraft:polished_blackstone_brick_slab",
+		"minecraft:polished_blackstone_brick_stairs",
+		"minecraft:cracked_polished_blackstone_bricks",
+		"minecraft:respawn_anchor",
+		"minecraft:candle",
+		"minecraft:white_candle",
+		"minecraft:orange_candle",
+		"minecraft:magenta_candle",
+		"minecraft:light_blue_candle",
+		"minecraft:yellow_candle",
+		"minecraft:lime_candle",
+		"minecraft:pink_candle",
+		"minecraft:gray_candle",
+		"minecraft:light_gray_candle",
+		"minecraft:cyan_candle",
+		"minecraft:purple_candle",
+		"minecraft:blue_candle",
+		"minecraft:brown_candle",
+		"minecraft:green_candle",
+		"minecraft:red_candle",
+		"minecraft:black_candle",
+		"minecraft:small_amethyst_bud",
+		"minecraft:medium_amethyst_bud",
+		"minecraft:large_amethyst_bud",
+		"minecraft:amethyst_cluster",
+		"minecraft:pointed_dripstone",
+		"minecraft:ochre_froglight",
+		"minecraft:verdant_froglight",
+		"minecraft:pearlescent_froglight",
+		"minecraft:frogspawn",
+		"minecraft:echo_shard",
+		"minecraft:brush",
+		"minecraft:netherite_upgrade_smithing_template",
+		"minecraft:sentry_armor_trim_smithing_template",
+		"minecraft:dune_armor_trim_smithing_template",
+		"minecraft:coast_armor_trim_smithing_template",
+		"minecraft:wild_armor_trim_smithing_template",
+		"minecraft:ward_armor_trim_smithing_template",
+		"minecraft:eye_armor_trim_smithing_template",
+		"minecraft:vex_armor_trim_smithing_template",
+		"minecraft:tide_armor_trim_smithing_template",
+		"minecraft:snout_armor_trim_smithing_template",
+		"minecraft:rib_armor_trim_smithing_template",
+		"minecraft:spire_armor_trim_smithing_template",
+		"minecraft:wayfinder_armor_trim_smithing_template",
+		"minecraft:shaper_armor_trim_smithing_template",
+		"minecraft:silence_armor_trim_smithing_template",
+		"minecraft:raiser_armor_trim_smithing_template",
+		"minecraft:host_armor_trim_smithing_template",
+		"minecraft:flow_armor_trim_smithing_template",
+		"minecraft:bolt_armor_trim_smithing_template",
+		"minecraft:angler_pottery_sherd",
+		"minecraft:archer_pottery_sherd",
+		"minecraft:arms_up_pottery_sherd",
+		"minecraft:blade_pottery_sherd",
+		"minecraft:brewer_pottery_sherd",
+		"minecraft:burn_pottery_sherd",
+		"minecraft:danger_pottery_sherd",
+		"minecraft:explorer_pottery_sherd",
+		"minecraft:flow_pottery_sherd",
+		"minecraft:friend_pottery_sherd",
+		"minecraft:guster_pottery_sherd",
+		"minecraft:heart_pottery_sherd",
+		"minecraft:heartbreak_pottery_sherd",
+		"minecraft:howl_pottery_sherd",
+		"minecraft:miner_pottery_sherd",
+		"minecraft:mourner_pottery_sherd",
+		"minecraft:plenty_pottery_sherd",
+		"minecraft:prize_pottery_sherd",
+		"minecraft:scrape_pottery_sherd",
+		"minecraft:sheaf_pottery_sherd",
+		"minecraft:shelter_pottery_sherd",
+		"minecraft:skull_pottery_sherd",
+		"minecraft:snort_pottery_sherd",
+		"minecraft:copper_grate",
+		"minecraft:exposed_copper_grate",
+		"minecraft:weathered_copper_grate",
+		"minecraft:oxidized_copper_grate",
+		"minecraft:waxed_copper_grate",
+		"minecraft:waxed_exposed_copper_grate",
+		"minecraft:waxed_weathered_copper_grate",
+		"minecraft:waxed_oxidized_copper_grate",
+		"minecraft:copper_bulb",
+		"minecraft:exposed_copper_bulb",
+		"minecraft:weathered_copper_bulb",
+		"minecraft:oxidized_copper_bulb",
+		"minecraft:waxed_copper_bulb",
+		"minecraft:waxed_exposed_copper_bulb",
+		"minecraft:waxed_weathered_copper_bulb",
+		"minecraft:waxed_oxidized_copper_bulb",
+		"minecraft:trial_spawner",
+		"minecraft:trial_key",
+		"minecraft:ominous_trial_key",
+		"minecraft:vault",
+		"minecraft:ominous_bottle",
+	},
+}
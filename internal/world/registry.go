@@ -0,0 +1,63 @@
+package world
+
+//go:generate go run ../../cmd/gendata -reports ../../generated/reports -minecraft-data ../../generated/minecraft-data/data/pc/1.21.8
+
+// registryIDs maps the entries of the registries of registries_gen.go to
+// their protocol ID.
+var registryIDs = make(map[string]map[string]int)
+
+func init() {
+	for name, entries := range registries {
+		ids := make(map[string]int, len(entries))
+		for id, entry := range entries {
+			ids[entry] = id
+		}
+
+		registryIDs[name] = ids
+	}
+}
+
+// Registry returns the entries of a built-in registry, such as
+// minecraft:sound_event, in protocol ID order.
+func Registry(name string) []string {
+	return registries[name]
+}
+
+// RegistryID returns the protocol ID of an entry of a built-in registry.
+func RegistryID(registry string, entry string) (int, bool) {
+	id, ok := registryIDs[registry][entry]
+	return id, ok
+}
+
+// RegistryEntry returns the entry behind a protocol ID of a built-in
+// registry.
+func RegistryEntry(registry string, id int) (string, bool) {
+	entries := registries[registry]
+	if id < 0 || id >= len(entries) {
+		return "", false
+	}
+
+	return entries[id], true
+}
+
+func ItemID(name string) (int, bool) {
+	return RegistryID("minecraft:item", name)
+}
+
+func ItemName(id int) (string, bool) {
+	return RegistryEntry("minecraft:item", id)
+}
+
+// MaxStackSize returns how many of an item fit in a slot, without the
+// components of a stack changing it.
+func MaxStackSize(item string) int {
+	if size, ok := stackSizes[item]; ok {
+		return size
+	}
+
+	return 64
+}
+
+func EntityTypeID(name string) (int, bool) {
+	return RegistryID("minecraft:entity_type", name)
+}
@@ -0,0 +1,131 @@
+package world
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/beito123/nbt"
+)
+
+// WorldStore keeps the chunks, the entities and the metadata of a world
+// between two runs of the server.
+type WorldStore interface {
+	LoadLevel() (Level, error)
+	SaveLevel(level Level) error
+	// LoadChunk returns nil when the chunk was never saved
+	LoadChunk(x int32, z int32) (*Chunk, error)
+	SaveChunk(chunk *Chunk) error
+	// LoadEntities returns the entities of a chunk, as saved by the game.
+	// The server doesn't run them, leaving them untouched in the store
+	LoadEntities(x int32, z int32) ([]nbt.Tag, error)
+	// LoadPlayer returns nil when the player never joined the world
+	LoadPlayer(id string) (*PlayerData, error)
+	SavePlayer(id string, player PlayerData) error
+	Close() error
+}
+
+// ErrReadOnly is returned when saving to a store that can't be written,
+// the changes then only living in memory.
+var ErrReadOnly = errors.New("World store is read only")
+
+// MemoryStore keeps a world in memory only, for tests and minigames. Saved
+// chunks are encoded like in the region files, so later changes to a chunk
+// don't alter what was saved.
+type MemoryStore struct {
+	minY    int
+	height  int
+	lock    sync.Mutex
+	level   Level
+	chunks  map[[2]int32][]byte
+	players map[string]PlayerData
+}
+
+func NewMemoryStore(level Level, minY int, height int) *MemoryStore {
+	return &MemoryStore{
+		minY:    minY,
+		height:  height,
+		level:   level,
+		chunks:  make(map[[2]int32][]byte),
+		players: make(map[string]PlayerData),
+	}
+}
+
+func (self *MemoryStore) LoadLevel() (Level, error) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	return self.level, nil
+}
+
+func (self *MemoryStore) SaveLevel(level Level) error {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	self.level = level
+	return nil
+}
+
+func (self *MemoryStore) LoadChunk(x int32, z int32) (*Chunk, error) {
+	self.lock.Lock()
+	data, ok := self.chunks[[2]int32{x, z}]
+	self.lock.Unlock()
+
+	if !ok {
+		return nil, nil
+	}
+
+	tag, err := readNBT(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeChunk(tag, x, z, self.minY, self.height)
+}
+
+func (self *MemoryStore) SaveChunk(chunk *Chunk) error {
+	tag, err := encodeChunk(chunk)
+	if err != nil {
+		return err
+	}
+
+	data, err := writeNBT(tag)
+	if err != nil {
+		return err
+	}
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	self.chunks[[2]int32{chunk.X, chunk.Z}] = data
+	return nil
+}
+
+// LoadEntities returns no entities, worlds kept in memory starting without
+// any.
+func (self *MemoryStore) LoadEntities(x int32, z int32) ([]nbt.Tag, error) {
+	return []nbt.Tag{}, nil
+}
+
+func (self *MemoryStore) LoadPlayer(id string) (*PlayerData, error) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	player, ok := self.players[id]
+	if !ok {
+		return nil, nil
+	}
+
+	return &player, nil
+}
+
+func (self *MemoryStore) SavePlayer(id string, player PlayerData) error {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	self.players[id] = player
+	return nil
+}
+
+func (self *MemoryStore) Close() error {
+	return nil
+}
@@ -0,0 +1,429 @@
+package world
+
+import (
+	"math"
+)
+
+const seaLevel = 63
+
+// climate holds the noises the multi-noise biome source picks biomes from,
+// each roughly within [-1, 1].
+type climate struct {
+	temperature     float64
+	humidity        float64
+	continentalness float64
+	erosion         float64
+	weirdness       float64
+}
+
+type biomePoint struct {
+	biome uint16
+	climate
+}
+
+// biomePoints places every biome in the climate space, a column getting the
+// biome of the closest point.
+var biomePoints = []biomePoint{
+	{mustBiome("minecraft:deep_ocean"), climate{0, 0, -0.9, 0, 0}},
+	{mustBiome("minecraft:ocean"), climate{0, 0, -0.55, 0, 0}},
+	{mustBiome("minecraft:frozen_ocean"), climate{-0.8, 0, -0.55, 0, 0}},
+	{mustBiome("minecraft:warm_ocean"), climate{0.8, 0, -0.55, 0, 0}},
+	{mustBiome("minecraft:beach"), climate{0.2, 0, -0.15, 0.3, 0}},
+	{mustBiome("minecraft:snowy_beach"), climate{-0.8, 0, -0.15, 0.3, 0}},
+	{mustBiome("minecraft:plains"), climate{0.1, -0.2, 0.2, 0.3, 0}},
+	{mustBiome("minecraft:sunflower_plains"), climate{0.1, -0.2, 0.2, 0.3, 0.7}},
+	{mustBiome("minecraft:forest"), climate{0.1, 0.3, 0.3, 0.2, 0}},
+	{mustBiome("minecraft:birch_forest"), climate{0.2, 0.4, 0.3, 0.1, -0.5}},
+	{mustBiome("minecraft:dark_forest"), climate{0.3, 0.7, 0.35, 0.2, 0.3}},
+	{mustBiome("minecraft:taiga"), climate{-0.4, 0.3, 0.3, 0.2, 0}},
+	{mustBiome("minecraft:snowy_plains"), climate{-0.8, -0.2, 0.3, 0.3, 0}},
+	{mustBiome("minecraft:desert"), climate{0.8, -0.7, 0.3, 0.3, 0}},
+	{mustBiome("minecraft:savanna"), climate{0.6, -0.3, 0.3, 0.2, 0}},
+	{mustBiome("minecraft:jungle"), climate{0.7, 0.7, 0.3, 0.1, 0}},
+	{mustBiome("minecraft:swamp"), climate{0.3, 0.6, 0.15, 0.8, 0}},
+	{mustBiome("minecraft:meadow"), climate{0, 0.2, 0.6, -0.3, -0.4}},
+	{mustBiome("minecraft:windswept_hills"), climate{-0.2, 0, 0.6, -0.6, 0.5}},
+	{mustBiome("minecraft:snowy_slopes"), climate{-0.7, 0, 0.8, -0.6, 0.6}},
+	{mustBiome("minecraft:stony_peaks"), climate{0.3, 0, 0.8, -0.8, 0.8}},
+}
+
+func (self climate) distance(other climate) float64 {
+	dt := self.temperature - other.temperature
+	dh := self.humidity - other.humidity
+	dc := self.continentalness - other.continentalness
+	de := self.erosion - other.erosion
+	dw := self.weirdness - other.weirdness
+
+	return dt*dt + dh*dh + 2*dc*dc + de*de + dw*dw
+}
+
+// Noise generates vanilla-like terrain from a seed: a density noise shaped
+// by the continentalness, erosion and weirdness decides where the ground
+// is, then surface rules, caves and ores are applied.
+type Noise struct {
+	seed   int64
+	minY   int
+	height int
+
+	temperature     *octaves
+	humidity        *octaves
+	continentalness *octaves
+	erosion         *octaves
+	weirdness       *octaves
+	density         *octaves
+	cheese          *octaves
+	spaghettiA      *octaves
+	spaghettiB      *octaves
+
+	stone, water, lava, bedrock              uint16
+	grass, dirt, sand, gravel                uint16
+	coal, iron, gold                         uint16
+	river, desert, beach, snowyBeach, peaks  uint16
+	ocean, deepOcean, frozenOcean, warmOcean uint16
+}
+
+func NewNoise(seed int64, minY int, height int) *Noise {
+	r := newRandom(seed)
+
+	return &Noise{
+		seed:   seed,
+		minY:   minY,
+		height: height,
+
+		temperature:     newOctaves(r, 3, 1200),
+		humidity:        newOctaves(r, 3, 1000),
+		continentalness: newOctaves(r, 5, 900),
+		erosion:         newOctaves(r, 4, 500),
+		weirdness:       newOctaves(r, 4, 400),
+		density:         newOctaves(r, 4, 90),
+		cheese:          newOctaves(r, 2, 60),
+		spaghettiA:      newOctaves(r, 2, 50),
+		spaghettiB:      newOctaves(r, 2, 50),
+
+		stone:   mustState("minecraft:stone"),
+		water:   mustState("minecraft:water"),
+		lava:    mustState("minecraft:lava"),
+		bedrock: mustState("minecraft:bedrock"),
+		grass:   mustState("minecraft:grass_block"),
+		dirt:    mustState("minecraft:dirt"),
+		sand:    mustState("minecraft:sand"),
+		gravel:  mustState("minecraft:gravel"),
+		coal:    mustState("minecraft:coal_ore"),
+		iron:    mustState("minecraft:iron_ore"),
+		gold:    mustState("minecraft:gold_ore"),
+
+		river:       mustBiome("minecraft:river"),
+		desert:      mustBiome("minecraft:desert"),
+		beach:       mustBiome("minecraft:beach"),
+		snowyBeach:  mustBiome("minecraft:snowy_beach"),
+		peaks:       mustBiome("minecraft:stony_peaks"),
+		ocean:       mustBiome("minecraft:ocean"),
+		deepOcean:   mustBiome("minecraft:deep_ocean"),
+		frozenOcean: mustBiome("minecraft:frozen_ocean"),
+		warmOcean:   mustBiome("minecraft:warm_ocean"),
+	}
+}
+
+func clamp(v float64, low float64, high float64) float64 {
+	return math.Max(low, math.Min(high, v))
+}
+
+func smoothstep(low float64, high float64, v float64) float64 {
+	t := clamp((v-low)/(high-low), 0, 1)
+	return t * t * (3 - 2*t)
+}
+
+func (self *Noise) climate(x float64, z float64) climate {
+	// The octaves rarely go past half their range
+	return climate{
+		temperature:     clamp(self.temperature.sample2D(x, z)*2, -1, 1),
+		humidity:        clamp(self.humidity.sample2D(x, z)*2, -1, 1),
+		continentalness: clamp(self.continentalness.sample2D(x, z)*2, -1, 1),
+		erosion:         clamp(self.erosion.sample2D(x, z)*2, -1, 1),
+		weirdness:       clamp(self.weirdness.sample2D(x, z)*2, -1, 1),
+	}
+}
+
+// riverFactor is 1 in the middle of a river, where the weirdness crosses
+// zero inland, and 0 away from it.
+func riverFactor(c climate) float64 {
+	if c.continentalness < -0.2 {
+		return 0
+	}
+
+	return clamp(1-math.Abs(c.weirdness)/0.06, 0, 1) * smoothstep(-0.2, -0.05, c.continentalness)
+}
+
+// terrainHeight returns the height the ground is shaped around.
+func terrainHeight(c climate) float64 {
+	h := seaLevel + c.continentalness*35
+
+	// Peaks rise where the weirdness is strong and the erosion low
+	peaks := 1 - math.Abs(3*math.Abs(c.weirdness)-2)
+	h += math.Max(0, peaks) * math.Max(0, 0.3-c.erosion) * 120 * smoothstep(-0.1, 0.4, c.continentalness)
+
+	return lerp(riverFactor(c), h, seaLevel-4)
+}
+
+func (self *Noise) pickBiome(c climate) uint16 {
+	if riverFactor(c) > 0.5 {
+		return self.river
+	}
+
+	best := biomePoints[0]
+	for _, p := range biomePoints[1:] {
+		if c.distance(p.climate) < c.distance(best.climate) {
+			best = p
+		}
+	}
+
+	return best.biome
+}
+
+// Density is sampled every cellWidth blocks horizontally and cellHeight
+// blocks vertically, the blocks in between being interpolated.
+const (
+	cellWidth  = 4
+	cellHeight = 8
+)
+
+func (self *Noise) Generate(x int32, z int32) *Chunk {
+	chunk := NewChunk(x, z, self.minY, self.height)
+	baseX, baseZ := int(x)*SectionSize, int(z)*SectionSize
+
+	self.shape(chunk, baseX, baseZ)
+	surface := self.biomes(chunk, baseX, baseZ)
+	self.carve(chunk, baseX, baseZ, surface)
+	self.surface(chunk, surface)
+	self.ores(chunk)
+	self.floor(chunk)
+
+	return chunk
+}
+
+// shape fills the chunk with stone where the density is positive and
+// with water below the sea level elsewhere.
+func (self *Noise) shape(chunk *Chunk, baseX int, baseZ int) {
+	cells := SectionSize/cellWidth + 1
+	layers := self.height/cellHeight + 1
+
+	density := make([]float64, cells*cells*layers)
+	at := func(cx int, cz int, cy int) *float64 {
+		return &density[(cx*cells+cz)*layers+cy]
+	}
+
+	for cx := range cells {
+		for cz := range cells {
+			wx, wz := float64(baseX+cx*cellWidth), float64(baseZ+cz*cellWidth)
+
+			c := self.climate(wx, wz)
+			h := terrainHeight(c)
+			detail := 0.4 + 0.6*smoothstep(0.3, 0.8, c.continentalness)
+
+			for cy := range layers {
+				wy := float64(self.minY + cy*cellHeight)
+				*at(cx, cz, cy) = (h-wy)/18 + self.density.sample(wx, wy, wz)*detail
+			}
+		}
+	}
+
+	for lx := range SectionSize {
+		for lz := range SectionSize {
+			cx, fx := lx/cellWidth, float64(lx%cellWidth)/cellWidth
+			cz, fz := lz/cellWidth, float64(lz%cellWidth)/cellWidth
+
+			for ly := range self.height {
+				cy, fy := ly/cellHeight, float64(ly%cellHeight)/cellHeight
+
+				d := lerp(fy,
+					lerp(fz,
+						lerp(fx, *at(cx, cz, cy), *at(cx+1, cz, cy)),
+						lerp(fx, *at(cx, cz+1, cy), *at(cx+1, cz+1, cy)),
+					),
+					lerp(fz,
+						lerp(fx, *at(cx, cz, cy+1), *at(cx+1, cz, cy+1)),
+						lerp(fx, *at(cx, cz+1, cy+1), *at(cx+1, cz+1, cy+1)),
+					),
+				)
+
+				y := self.minY + ly
+				if d > 0 {
+					chunk.SetBlock(lx, y, lz, self.stone)
+				} else if y <= seaLevel {
+					chunk.SetBlock(lx, y, lz, self.water)
+				}
+			}
+		}
+	}
+}
+
+// biomes sets the biome of every 4x4 column of the chunk and returns the
+// height of the highest stone of every block column.
+func (self *Noise) biomes(chunk *Chunk, baseX int, baseZ int) []int {
+	for bx := 0; bx < SectionSize; bx += BiomeSize {
+		for bz := 0; bz < SectionSize; bz += BiomeSize {
+			c := self.climate(float64(baseX+bx+2), float64(baseZ+bz+2))
+			biome := self.pickBiome(c)
+
+			for y := self.minY; y < self.minY+self.height; y += BiomeSize {
+				chunk.SetBiome(bx, y, bz, biome)
+			}
+		}
+	}
+
+	surface := make([]int, SectionSize*SectionSize)
+	for i := range surface {
+		surface[i] = self.minY - 1
+
+		for y := self.minY + self.height - 1; y >= self.minY; y-- {
+			if chunk.Block(i&15, y, i>>4) == self.stone {
+				surface[i] = y
+				break
+			}
+		}
+	}
+
+	return surface
+}
+
+// carve digs the cheese caves, large pockets where a noise is high, and the
+// spaghetti caves, tunnels where two noises are both close to zero. They
+// stay under the surface so they don't drain the oceans.
+func (self *Noise) carve(chunk *Chunk, baseX int, baseZ int, surface []int) {
+	for lx := range SectionSize {
+		for lz := range SectionSize {
+			top := surface[lz<<4|lx] - 8
+
+			for y := self.minY + 5; y < top; y++ {
+				wx, wy, wz := float64(baseX+lx), float64(y), float64(baseZ+lz)
+
+				cheese := self.cheese.sample(wx, wy*1.6, wz) > 0.32
+				spaghetti := math.Abs(self.spaghettiA.sample(wx, wy, wz)) < 0.03 &&
+					math.Abs(self.spaghettiB.sample(wx, wy, wz)) < 0.03
+
+				if !cheese && !spaghetti {
+					continue
+				}
+
+				if y < self.minY+10 {
+					chunk.SetBlock(lx, y, lz, self.lava)
+				} else {
+					chunk.SetBlock(lx, y, lz, Air)
+				}
+			}
+		}
+	}
+}
+
+// surface replaces the top stone of every column according to its biome:
+// sand for deserts and beaches, sand or gravel under water and grass over
+// dirt elsewhere.
+func (self *Noise) surface(chunk *Chunk, surface []int) {
+	for i, top := range surface {
+		x, z := i&15, i>>4
+		if top < self.minY || chunk.Block(x, top, z) != self.stone {
+			continue
+		}
+
+		biome := chunk.Biome(x, top, z)
+		underwater := top < seaLevel && chunk.Block(x, top+1, z) == self.water
+
+		var block, filler uint16
+		depth := 3
+
+		switch {
+		case biome == self.peaks:
+			continue
+		case biome == self.desert || biome == self.beach || biome == self.snowyBeach:
+			block, filler, depth = self.sand, self.sand, 4
+		case underwater && top < seaLevel-12:
+			block, filler = self.gravel, self.gravel
+		case underwater:
+			block, filler = self.sand, self.sand
+		default:
+			block, filler = self.grass, self.dirt
+		}
+
+		chunk.SetBlock(x, top, z, block)
+		for y := top - 1; y >= top-depth && chunk.Block(x, y, z) == self.stone; y-- {
+			chunk.SetBlock(x, y, z, filler)
+		}
+	}
+}
+
+type oreVein struct {
+	count      int
+	size       int
+	minY, maxY int
+}
+
+// ores places veins of ore in the stone, walking randomly from a start
+// drawn in the height range of each ore.
+func (self *Noise) ores(chunk *Chunk) {
+	veins := []struct {
+		state uint16
+		oreVein
+	}{
+		{self.coal, oreVein{count: 20, size: 10, minY: 0, maxY: 128}},
+		{self.iron, oreVein{count: 10, size: 7, minY: -24, maxY: 56}},
+		{self.gold, oreVein{count: 4, size: 7, minY: -64, maxY: 32}},
+	}
+
+	for salt, vein := range veins {
+		r := chunkRandom(self.seed, chunk.X, chunk.Z, int64(salt+1))
+
+		for range vein.count {
+			x := r.intn(SectionSize)
+			y := vein.minY + r.intn(vein.maxY-vein.minY)
+			z := r.intn(SectionSize)
+
+			for range vein.size {
+				if chunk.Block(x, y, z) == self.stone {
+					chunk.SetBlock(x, y, z, vein.state)
+				}
+
+				switch r.intn(6) {
+				case 0:
+					x = min(x+1, SectionSize-1)
+				case 1:
+					x = max(x-1, 0)
+				case 2:
+					y += 1
+				case 3:
+					y -= 1
+				case 4:
+					z = min(z+1, SectionSize-1)
+				case 5:
+					z = max(z-1, 0)
+				}
+			}
+		}
+	}
+}
+
+// floor lays the bedrock, solid at the bottom and thinning over 4 blocks.
+func (self *Noise) floor(chunk *Chunk) {
+	r := chunkRandom(self.seed, chunk.X, chunk.Z, 0)
+
+	for x := range SectionSize {
+		for z := range SectionSize {
+			for dy := range 5 {
+				if dy == 0 || r.intn(5) >= dy {
+					chunk.SetBlock(x, self.minY+dy, z, self.bedrock)
+				}
+			}
+		}
+	}
+}
+
+func (self *Noise) SpawnHeight(x int, z int) int {
+	chunk := self.Generate(int32(x>>4), int32(z>>4))
+
+	for y := self.minY + self.height - 1; y >= self.minY; y-- {
+		if !IsAir(chunk.Block(x&15, y, z&15)) {
+			return y + 1
+		}
+	}
+
+	return seaLevel + 1
+}
@@ -0,0 +1,36 @@
+package world
+
+import "fmt"
+
+// Void generates empty chunks of a single biome, for the dimensions whose
+// content comes from a map.
+type Void struct {
+	minY   int
+	height int
+	biome  uint16
+}
+
+func NewVoid(biome string, minY int, height int) (*Void, error) {
+	id, ok := BiomeID(biome)
+	if !ok {
+		return nil, fmt.Errorf("Unknown biome %s", biome)
+	}
+
+	return &Void{minY: minY, height: height, biome: uint16(id)}, nil
+}
+
+func (self *Void) Generate(x int32, z int32) *Chunk {
+	chunk := NewChunk(x, z, self.minY, self.height)
+
+	for _, s := range chunk.Sections {
+		for i := range s.Biomes {
+			s.Biomes[i] = self.biome
+		}
+	}
+
+	return chunk
+}
+
+func (self *Void) SpawnHeight(x int, z int) int {
+	return max(self.minY, 64)
+}
@@ -0,0 +1,291 @@
+package world
+
+import (
+	"errors"
+	"io"
+	"sync"
+)
+
+// World keeps the chunks in use in memory, loading them from its store and
+// writing back the modified ones when saved. Chunks missing from the store
+// are made by the generator, when there is one.
+type World struct {
+	Level     Level
+	MinY      int
+	Height    int
+	Generator Generator
+
+	store  WorldStore
+	lock   sync.Mutex
+	chunks map[[2]int32]*Chunk
+	// saveLock is held while the chunks are written, so none is unloaded
+	// before it reached the store
+	saveLock sync.Mutex
+
+	// lightLock is held while the light spreads across the chunks
+	lightLock      sync.Mutex
+	lightListeners []func(x int32, z int32)
+}
+
+// New returns a world kept in memory only, all its chunks coming from gen.
+func New(name string, minY int, height int, gen Generator) *World {
+	level := Level{
+		Name:        name,
+		DataVersion: DataVersion,
+		SpawnY:      gen.SpawnHeight(0, 0),
+	}
+
+	w := NewWithStore(NewMemoryStore(level, minY, height), level, minY, height)
+	w.Generator = gen
+
+	return w
+}
+
+// Open opens the vanilla world folder dir, with the dimension height given.
+func Open(dir string, minY int, height int) (*World, error) {
+	anvil, err := OpenAnvil(dir, minY, height)
+	if err != nil {
+		return nil, err
+	}
+
+	return OpenStore(anvil, minY, height)
+}
+
+// OpenStore opens the world kept in store, reading its level.
+func OpenStore(store WorldStore, minY int, height int) (*World, error) {
+	level, err := store.LoadLevel()
+	if err != nil {
+		store.Close()
+		return nil, err
+	}
+
+	return NewWithStore(store, level, minY, height), nil
+}
+
+func NewWithStore(store WorldStore, level Level, minY int, height int) *World {
+	return &World{
+		Level:  level,
+		MinY:   minY,
+		Height: height,
+		store:  store,
+		chunks: make(map[[2]int32]*Chunk),
+	}
+}
+
+// Chunk returns the chunk at x, z, or nil when it wasn't generated and
+// there is no generator.
+func (self *World) Chunk(x int32, z int32) (*Chunk, error) {
+	key := [2]int32{x, z}
+
+	self.lock.Lock()
+	chunk, ok := self.chunks[key]
+	self.lock.Unlock()
+
+	if ok {
+		// Wait for the light of a chunk still being lit
+		if !chunk.lit.Load() {
+			self.lightLock.Lock()
+			self.lightLock.Unlock()
+		}
+
+		return chunk, nil
+	}
+
+	chunk, err := self.load(x, z)
+	if err != nil || chunk == nil {
+		return nil, err
+	}
+
+	self.lightLock.Lock()
+
+	self.lock.Lock()
+	// Another goroutine may have loaded it meanwhile
+	if other, ok := self.chunks[key]; ok {
+		self.lock.Unlock()
+		self.lightLock.Unlock()
+
+		return other, nil
+	}
+
+	self.chunks[key] = chunk
+	self.lock.Unlock()
+
+	changed := make(map[[2]int32]bool)
+	if !chunk.hasLight() {
+		self.lightChunk(chunk, changed)
+	}
+
+	chunk.lit.Store(true)
+	self.lightLock.Unlock()
+
+	// The chunk itself is new to the players
+	delete(changed, key)
+	self.notifyLight(changed)
+
+	return chunk, nil
+}
+
+func (self *World) load(x int32, z int32) (*Chunk, error) {
+	chunk, err := self.store.LoadChunk(x, z)
+	if err != nil || chunk != nil {
+		return chunk, err
+	}
+
+	if self.Generator == nil {
+		return nil, nil
+	}
+
+	// Generated chunks are new to the store
+	chunk = self.Generator.Generate(x, z)
+	chunk.MarkDirty()
+
+	return chunk, nil
+}
+
+func (self *World) Block(x int, y int, z int) (uint16, error) {
+	chunk, err := self.Chunk(int32(x>>4), int32(z>>4))
+	if err != nil || chunk == nil {
+		return Air, err
+	}
+
+	return chunk.Block(x&15, y, z&15), nil
+}
+
+func (self *World) SetBlock(x int, y int, z int, state uint16) error {
+	chunk, err := self.Chunk(int32(x>>4), int32(z>>4))
+	if err != nil {
+		return err
+	}
+
+	if chunk == nil {
+		return errors.New("Chunk isn't generated")
+	}
+
+	self.lightLock.Lock()
+
+	// The chunk may have been unloaded since, the change being lost
+	if !self.loaded(chunk) {
+		self.lightLock.Unlock()
+		return self.SetBlock(x, y, z, state)
+	}
+
+	old := chunk.Block(x&15, y, z&15)
+	chunk.SetBlock(x&15, y, z&15, state)
+
+	changed := make(map[[2]int32]bool)
+	if lightOf(old) != lightOf(state) {
+		self.relight(x, y, z, changed)
+	}
+	self.lightLock.Unlock()
+
+	self.notifyLight(changed)
+	return nil
+}
+
+// Snapshot returns a copy of a chunk of the world, to be read while its
+// blocks and light keep changing.
+func (self *World) Snapshot(chunk *Chunk) *Chunk {
+	self.lightLock.Lock()
+	defer self.lightLock.Unlock()
+
+	return chunk.clone()
+}
+
+// loaded reports whether chunk is the one the world keeps at its position.
+func (self *World) loaded(chunk *Chunk) bool {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	return self.chunks[[2]int32{chunk.X, chunk.Z}] == chunk
+}
+
+// Unload drops the chunks saved since they last changed, except the ones
+// inUse keeps, and returns how many were dropped. They are read back from
+// the store when needed again.
+func (self *World) Unload(inUse func(x int32, z int32) bool) int {
+	// A save in progress leaves the chunks for the next time
+	if !self.saveLock.TryLock() {
+		return 0
+	}
+	defer self.saveLock.Unlock()
+
+	// Spreading light changes the chunks around the one lit
+	self.lightLock.Lock()
+	defer self.lightLock.Unlock()
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	count := 0
+	for key, chunk := range self.chunks {
+		if chunk.Dirty() || inUse(key[0], key[1]) {
+			continue
+		}
+
+		delete(self.chunks, key)
+		count += 1
+	}
+
+	return count
+}
+
+// Store returns where the world is kept.
+func (self *World) Store() WorldStore {
+	return self.store
+}
+
+// Save writes the level and every modified chunk to the store. Nothing is
+// written to read-only stores, the chunks being marked saved all the same:
+// their changes stay in memory until they are unloaded.
+func (self *World) Save() error {
+	err := self.store.SaveLevel(self.Level)
+	readOnly := errors.Is(err, ErrReadOnly)
+	if err != nil && !readOnly {
+		return err
+	}
+
+	self.saveLock.Lock()
+	defer self.saveLock.Unlock()
+
+	self.lock.Lock()
+	dirty := make([]*Chunk, 0)
+	for _, chunk := range self.chunks {
+		if chunk.Dirty() {
+			dirty = append(dirty, chunk)
+		}
+	}
+	self.lock.Unlock()
+
+	var errs []error
+
+	for _, chunk := range dirty {
+		if readOnly {
+			chunk.dirty.Store(false)
+			continue
+		}
+
+		// The chunk is cleared under the light lock, so a change made while
+		// its copy is written marks it again
+		self.lightLock.Lock()
+		chunk.dirty.Store(false)
+		snapshot := chunk.clone()
+		self.lightLock.Unlock()
+
+		if err := self.store.SaveChunk(snapshot); err != nil {
+			chunk.dirty.Store(true)
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Close closes the store of the world, and its generator when it runs
+// goroutines of its own.
+func (self *World) Close() error {
+	if closer, ok := self.Generator.(io.Closer); ok {
+		closer.Close()
+	}
+
+	return self.store.Close()
+}
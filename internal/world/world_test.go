@@ -0,0 +1,186 @@
+package world
+
+import (
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestUnload(t *testing.T) {
+	gen, err := NewFlat(DefaultFlatPreset, -64, 384)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w := New("minecraft:overworld", -64, 384, gen)
+	defer w.Close()
+
+	stone, _ := DefaultState("minecraft:stone")
+	for x := range 4 {
+		if _, err := w.Chunk(int32(x), 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Generated chunks wait for their first save
+	if count := w.Unload(func(x int32, z int32) bool { return false }); count != 0 {
+		t.Fatalf("Unloaded %d unsaved chunks", count)
+	}
+
+	if err := w.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := w.SetBlock(16, 0, 0, stone); err != nil {
+		t.Fatal(err)
+	}
+
+	count := w.Unload(func(x int32, z int32) bool { return x == 0 })
+	if count != 2 {
+		t.Fatalf("Unloaded %d chunks instead of 2", count)
+	}
+
+	if err := w.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	if count := w.Unload(func(x int32, z int32) bool { return false }); count != 2 {
+		t.Fatalf("Unloaded %d chunks instead of 2", count)
+	}
+
+	state, err := w.Block(16, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if state != stone {
+		t.Fatalf("Block is %d after reloading instead of %d", state, stone)
+	}
+}
+
+func TestSaveWhileEditing(t *testing.T) {
+	gen, err := NewFlat(DefaultFlatPreset, -64, 384)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w := New("minecraft:overworld", -64, 384, gen)
+	defer w.Close()
+
+	chunk, err := w.Chunk(0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	torch, _ := DefaultState("minecraft:torch")
+
+	// Saving and sending copies of the chunk never see it half changed,
+	// which the race detector checks
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		for i := range 64 {
+			state := torch
+			if i%2 == 1 {
+				state = Air
+			}
+
+			if err := w.SetBlock(i%16, -60, 0, state); err != nil {
+				t.Error(err)
+				return
+			}
+		}
+	}()
+
+	for range 16 {
+		if err := w.Save(); err != nil {
+			t.Fatal(err)
+		}
+
+		w.Snapshot(chunk)
+	}
+
+	<-done
+
+	if err := w.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	if chunk.Dirty() {
+		t.Fatal("Chunk is dirty once saved")
+	}
+}
+
+func TestHeightmaps(t *testing.T) {
+	chunk := NewChunk(0, 0, -64, 384)
+
+	stone := mustState("minecraft:stone")
+	for x := range 4 {
+		chunk.SetBlock(x, -64, 0, stone)
+	}
+
+	chunk.SetBlock(0, -63, 0, mustState("minecraft:short_grass"))
+	chunk.SetBlock(1, -54, 0, mustState("minecraft:oak_leaves"))
+	chunk.SetBlock(2, -60, 0, mustState("minecraft:water"))
+	chunk.SetBlock(3, 100, 0, mustState("minecraft:cave_air"))
+
+	cases := map[HeightmapKind][]int{
+		WorldSurface:           {2, 11, 5, 1},
+		MotionBlocking:         {1, 11, 5, 1},
+		MotionBlockingNoLeaves: {1, 1, 5, 1},
+	}
+
+	for kind, want := range cases {
+		if got := chunk.Heightmap(kind)[:4]; !slices.Equal(got, want) {
+			t.Fatalf("Heightmap %d is %v instead of %v", kind, got, want)
+		}
+	}
+
+	if count := chunk.Sections[10].BlockCount(); count != 0 {
+		t.Fatalf("Cave air counts as %d blocks", count)
+	}
+}
+
+func TestSaveReadOnly(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "world.mcw")
+
+	memory := NewMemoryStore(Level{Name: "compact"}, -64, 384)
+	if err := memory.SaveChunk(NewChunk(0, 0, -64, 384)); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := WriteCompact(path, memory, [][2]int32{{0, 0}}); err != nil {
+		t.Fatal(err)
+	}
+
+	compact, err := OpenCompact(path, -64, 384)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w, err := OpenStore(compact, -64, 384)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+
+	stone := mustState("minecraft:stone")
+	if err := w.SetBlock(0, 0, 0, stone); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := w.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	// The change is dropped along with the chunk, which comes back as it
+	// is in the file
+	if count := w.Unload(func(x int32, z int32) bool { return false }); count != 1 {
+		t.Fatalf("Unloaded %d chunks instead of 1", count)
+	}
+
+	if state, err := w.Block(0, 0, 0); err != nil || state != Air {
+		t.Fatalf("Block is %d, %v after reloading", state, err)
+	}
+}